@@ -2,17 +2,23 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
+	// Recurring feeding schedules need time zones even where the system has no zoneinfo
+	_ "time/tzdata"
 
 	"github.com/gin-gonic/gin"
 	"github.com/maklybae/ddd-zoo/internal/application/services"
+	"github.com/maklybae/ddd-zoo/internal/domain"
 	"github.com/maklybae/ddd-zoo/internal/infrastructure/persistence/inmemory"
+	sqlpersistence "github.com/maklybae/ddd-zoo/internal/infrastructure/persistence/sql"
 	httpserver "github.com/maklybae/ddd-zoo/internal/presentation/http"
 	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
 	"github.com/maklybae/ddd-zoo/pkg/events"
@@ -22,29 +28,141 @@ import (
 )
 
 func main() {
+	feedingInterval := flag.Duration("feeding-interval", time.Minute, "interval between background feeding runs (0 disables)")
+	outboxInterval := flag.Duration("outbox-interval", time.Second, "interval between outbox relay runs")
+	outboxMaxAttempts := flag.Int("outbox-max-attempts", 10, "outbox relay runs per message before it is dead-lettered")
+	eventWorkers := flag.Int("event-workers", 1, "number of workers per event handler")
+	eventQueueSize := flag.Int("event-queue-size", 100, "capacity of the event queue of every handler")
+	eventMaxAttempts := flag.Int("event-max-attempts", 5, "delivery attempts per webhook delivery or redriven event before it is dead-lettered")
+	eventStorePath := flag.String("event-store-path", "ddd_zoo_events.jsonl", "path to the append-only event log")
+	eventStreamBuffer := flag.Int("event-stream-buffer", 1000, "number of recent events kept for resuming event streams")
+	webhookTimeout := flag.Duration("webhook-timeout", 10*time.Second, "timeout of a single webhook delivery attempt")
+	webhookWorkers := flag.Int("webhook-workers", 4, "number of webhook deliveries made at the same time")
+	storage := flag.String("storage", "memory", "storage backend: memory or sqlite")
+	sqlitePath := flag.String("sqlite-path", "ddd_zoo.db", "path to the SQLite database file (with -storage=sqlite)")
+	flag.Parse()
+
 	// Initialize repositories
-	animalRepo := inmemory.NewAnimalRepository()
-	enclosureRepo := inmemory.NewEnclosureRepository()
-	feedingScheduleRepo := inmemory.NewFeedingScheduleRepository()
+	repos, err := newRepositories(context.Background(), *storage, *sqlitePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize storage: %v", err)
+	}
+	defer repos.close()
+
+	animalRepo := repos.animals
+	enclosureRepo := repos.enclosures
+	feedingScheduleRepo := repos.feedingSchedules
 
-	// Initialize events dispatcher
-	eventsDispatcher := events.NewEventDispatcher()
+	// Initialize registry of persisted events and events dispatcher
+	eventsRegistry := events.NewRegistry()
+	domain.RegisterEvents(eventsRegistry)
+
+	handlerOptions := events.DefaultHandlerOptions()
+	handlerOptions.Workers = *eventWorkers
+	handlerOptions.QueueSize = *eventQueueSize
+	handlerOptions.RetryPolicy.MaxAttempts = *eventMaxAttempts
+
+	eventsDispatcher := events.NewAsyncDispatcher(repos.deadLetters, eventsRegistry, handlerOptions)
+
+	// Record every dispatched event in the event store
+	eventStore, err := events.OpenFileStore(*eventStorePath)
+	if err != nil {
+		log.Fatalf("Failed to open event store: %v", err)
+	}
+	defer eventStore.Close()
+
+	// Broadcast every recorded event to live stream subscribers
+	eventsBroadcaster := events.NewBroadcaster(eventStore, *eventStreamBuffer)
+	eventsDispatcher.RegisterHandler(events.AllEvents, events.NewRecorder(eventStore, eventsBroadcaster))
+	eventsReplayer := events.NewReplayer(eventStore, eventsRegistry, eventsDispatcher)
 
 	// Initialize services
 	timeProvider := services.NewRealTimeProvider()
-	animalTransferSvc := services.NewAnimalTransfer(animalRepo, enclosureRepo, eventsDispatcher, timeProvider)
-	feedingOrganizationSvc := services.NewFeedingOrganization(animalRepo, feedingScheduleRepo, eventsDispatcher, timeProvider)
+	animalTransferSvc := services.NewAnimalTransfer(repos.unitOfWork, timeProvider)
+	feedingOrganizationSvc := services.NewFeedingOrganization(repos.unitOfWork, timeProvider)
+	medicalCareSvc := services.NewMedicalCare(repos.unitOfWork, timeProvider)
+	quarantineSvc := services.NewQuarantines(repos.unitOfWork, animalTransferSvc, timeProvider)
+	placementSvc := services.NewPlacementPlanner(repos.unitOfWork, animalTransferSvc)
+	foodInventorySvc := services.NewFoodInventory(repos.unitOfWork, timeProvider)
+	foodForecastSvc := services.NewFoodForecast(repos.unitOfWork)
+	weightTrackingSvc := services.NewWeightTracking(repos.unitOfWork, timeProvider)
+	vetSchedulingSvc := services.NewVetScheduling(repos.unitOfWork, timeProvider)
+	medicationDosingSvc := services.NewMedicationDosing(repos.unitOfWork, timeProvider)
+	staffSchedulingSvc := services.NewStaffScheduling(repos.unitOfWork)
+	keeperTasksSvc := services.NewKeeperTasks(repos.unitOfWork, timeProvider)
 	statisticsSvc := services.NewZooStatistics(animalRepo, enclosureRepo, feedingScheduleRepo)
 
+	// Deliver events to registered webhooks through a queue of their own: every webhook
+	// is retried independently, so a failing webhook does not hold up the others
+	services.RegisterWebhookDelivery(eventsRegistry)
+
+	webhookOptions := handlerOptions
+	webhookOptions.Workers = *webhookWorkers
+	webhookDeliveries := events.NewAsyncDispatcher(repos.deadLetters, eventsRegistry, webhookOptions)
+	webhookDeliveries.RegisterHandler(
+		services.WebhookDeliveryEventName,
+		services.NewWebhookDeliverer(repos.unitOfWork, &http.Client{Timeout: *webhookTimeout}, timeProvider),
+	)
+	eventsDispatcher.RegisterHandler(events.AllEvents, services.NewWebhookNotifier(repos.unitOfWork, webhookDeliveries))
+
+	// Start background workers
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	var workersWG sync.WaitGroup
+
+	outboxRelay := events.NewOutboxRelay(
+		repos.outbox,
+		eventsDispatcher,
+		eventsRegistry,
+		repos.deadLetters,
+		*outboxInterval,
+		*outboxMaxAttempts,
+	)
+
+	workersWG.Add(1)
+
+	go func() {
+		defer workersWG.Done()
+
+		log.Printf("Starting outbox relay with interval %s", *outboxInterval)
+		outboxRelay.Run(workersCtx)
+	}()
+
+	if *feedingInterval > 0 {
+		feedingScheduler := services.NewFeedingScheduler(feedingOrganizationSvc, timeProvider, *feedingInterval)
+
+		workersWG.Add(1)
+
+		go func() {
+			defer workersWG.Done()
+
+			log.Printf("Starting feeding scheduler with interval %s", *feedingInterval)
+			feedingScheduler.Run(workersCtx)
+		}()
+	}
+
 	// Initialize HTTP server
 	server := httpserver.NewServer(
-		animalRepo,
-		enclosureRepo,
-		feedingScheduleRepo,
+		repos.unitOfWork,
 		animalTransferSvc,
 		feedingOrganizationSvc,
+		medicalCareSvc,
+		quarantineSvc,
+		placementSvc,
+		foodInventorySvc,
+		foodForecastSvc,
+		weightTrackingSvc,
+		vetSchedulingSvc,
+		medicationDosingSvc,
+		staffSchedulingSvc,
+		keeperTasksSvc,
 		statisticsSvc,
 		timeProvider,
+		events.DeadLetterQueues{eventsDispatcher, webhookDeliveries},
+		eventStore,
+		eventsReplayer,
+		eventsBroadcaster,
 	)
 
 	// Initialize Gin router
@@ -54,7 +172,9 @@ func main() {
 	router.StaticFile("/api/openapi.yaml", "./api/openapi/v1/ddd_zoo.yaml")
 
 	// Register OpenAPI handlers
-	v1.RegisterHandlers(router, server)
+	v1.RegisterHandlersWithOptions(router, server, v1.GinServerOptions{
+		ErrorHandler: server.SendParameterErrorResponse,
+	})
 
 	// Setup Swagger UI using our OpenAPI specification
 	url := ginSwagger.URL("/api/openapi.yaml") // The URL pointing to API definition
@@ -66,6 +186,9 @@ func main() {
 		Handler: router,
 	}
 
+	// End event streams on shutdown, otherwise they keep their connections busy
+	srv.RegisterOnShutdown(eventsBroadcaster.Close)
+
 	// Start HTTP server in a goroutine
 	go func() {
 		log.Println("Starting HTTP server on :8080")
@@ -85,11 +208,105 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	// Attempt graceful shutdown
+	// Attempt graceful shutdown; background workers are stopped even if it fails
 	if err := srv.Shutdown(ctx); err != nil {
-		fmt.Printf("Server forced to shutdown: %v\n", err)
-		return
+		log.Printf("Server forced to shutdown: %v", err)
+	}
+
+	// Stop background workers
+	stopWorkers()
+	workersWG.Wait()
+
+	if err := eventsDispatcher.Shutdown(ctx); err != nil {
+		log.Printf("Failed to stop event dispatcher: %v", err)
+	}
+
+	if err := webhookDeliveries.Shutdown(ctx); err != nil {
+		log.Printf("Failed to stop webhook deliveries: %v", err)
 	}
 
 	log.Println("Server exited")
 }
+
+type repositories struct {
+	animals          domain.AnimalRepository
+	enclosures       domain.EnclosureRepository
+	feedingSchedules domain.FeedingScheduleRepository
+	outbox           events.OutboxStore
+	deadLetters      events.DeadLetterStore
+	unitOfWork       domain.UnitOfWork
+	close            func()
+}
+
+// newRepositories creates the storage. Every change goes through the unit of work; the
+// repositories kept aside are only read by the statistics and drained by the outbox relay.
+func newRepositories(ctx context.Context, storage, sqlitePath string) (*repositories, error) {
+	switch storage {
+	case "memory":
+		animals := inmemory.NewAnimalRepository()
+		enclosures := inmemory.NewEnclosureRepository()
+		feedingSchedules := inmemory.NewFeedingScheduleRepository()
+		outbox := inmemory.NewOutboxRepository()
+
+		unitOfWork := inmemory.NewUnitOfWork(
+			animals,
+			enclosures,
+			feedingSchedules,
+			inmemory.NewMedicalRecordRepository(),
+			inmemory.NewQuarantineRepository(),
+			inmemory.NewSpeciesRepository(),
+			inmemory.NewFoodStockRepository(),
+			inmemory.NewWeightRecordRepository(),
+			inmemory.NewVetAppointmentRepository(),
+			inmemory.NewDrugRepository(),
+			inmemory.NewKeeperRepository(),
+			inmemory.NewShiftRepository(),
+			inmemory.NewCareTaskRepository(),
+			inmemory.NewWebhookRepository(),
+			outbox,
+		)
+
+		return &repositories{
+			animals:          animals,
+			enclosures:       enclosures,
+			feedingSchedules: feedingSchedules,
+			outbox:           outbox,
+			deadLetters:      inmemory.NewDeadLetterRepository(),
+			unitOfWork:       unitOfWork,
+			close:            func() {},
+		}, nil
+	case "sqlite":
+		db, err := sqlpersistence.Open(ctx, sqlitePath)
+		if err != nil {
+			return nil, err
+		}
+
+		readDB, err := sqlpersistence.OpenReadOnly(ctx, sqlitePath)
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+
+		log.Printf("Using SQLite storage at %s", sqlitePath)
+
+		return &repositories{
+			animals:          sqlpersistence.NewAnimalRepository(db),
+			enclosures:       sqlpersistence.NewEnclosureRepository(db),
+			feedingSchedules: sqlpersistence.NewFeedingScheduleRepository(db),
+			outbox:           sqlpersistence.NewOutboxRepository(db),
+			deadLetters:      sqlpersistence.NewDeadLetterRepository(db),
+			unitOfWork:       sqlpersistence.NewUnitOfWork(db, readDB),
+			close: func() {
+				if err := readDB.Close(); err != nil {
+					log.Printf("Failed to close database: %v", err)
+				}
+
+				if err := db.Close(); err != nil {
+					log.Printf("Failed to close database: %v", err)
+				}
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", storage)
+	}
+}
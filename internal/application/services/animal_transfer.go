@@ -4,80 +4,348 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/maklybae/ddd-zoo/internal/domain"
-	"github.com/maklybae/ddd-zoo/pkg/events"
 )
 
 type AnimalTransferService interface {
 	TransferAnimal(ctx context.Context, animalID domain.AnimalID, toEnclosureID domain.EnclosureID) error
+	// TransferAnimals carries out all the transfers or none of them. Animals can swap places between
+	// full enclosures; transfers that keep an animal in its enclosure are ignored.
+	TransferAnimals(ctx context.Context, requests []TransferRequest) (*domain.TransferBatch, error)
+	// SuitableEnclosures returns the enclosures the animal can be transferred to, the best fit first.
+	SuitableEnclosures(ctx context.Context, animalID domain.AnimalID) ([]domain.EnclosureSuitability, error)
+	// RemoveAnimal takes the animal out of its enclosure and out of the zoo together with its feeding
+	// schedules; its scheduled vet appointments are cancelled. Quarantined animals cannot be removed.
+	RemoveAnimal(ctx context.Context, animalID domain.AnimalID) error
+}
+
+// TransferRequest asks to move an animal into an enclosure.
+type TransferRequest struct {
+	AnimalID      domain.AnimalID
+	ToEnclosureID domain.EnclosureID
 }
 
 type AnimalTransfer struct {
-	animalRepository    domain.AnimalRepository
-	enclosureRepository domain.EnclosureRepository
-	eventDispatcher     events.Dispatcher
-	timeProvider        TimeProvider
+	unitOfWork   domain.UnitOfWork
+	timeProvider TimeProvider
 }
 
 func NewAnimalTransfer(
-	animalRepository domain.AnimalRepository,
-	enclosureRepository domain.EnclosureRepository,
-	eventDispatcher events.Dispatcher,
+	unitOfWork domain.UnitOfWork,
 	timeProvider TimeProvider,
 ) *AnimalTransfer {
 	return &AnimalTransfer{
-		animalRepository:    animalRepository,
-		enclosureRepository: enclosureRepository,
-		eventDispatcher:     eventDispatcher,
-		timeProvider:        timeProvider,
+		unitOfWork:   unitOfWork,
+		timeProvider: timeProvider,
 	}
 }
 
 func (at *AnimalTransfer) TransferAnimal(ctx context.Context, animalID domain.AnimalID, toEnclosureID domain.EnclosureID) error {
-	animal, err := at.animalRepository.GetAnimal(ctx, animalID)
-	if err != nil {
-		return fmt.Errorf("getting animal: %w", err)
+	return at.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		animal, err := repos.Animals().GetAnimal(ctx, animalID)
+		if err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
+
+		toEnclosure, err := repos.Enclosures().GetEnclosure(ctx, toEnclosureID)
+		if err != nil {
+			return fmt.Errorf("getting enclosure: %w", err)
+		}
+
+		catalog, err := domain.LoadSpeciesCatalog(ctx, repos.Species())
+		if err != nil {
+			return err
+		}
+
+		keepers, err := repos.Keepers().GetAllKeepers(ctx)
+		if err != nil {
+			return fmt.Errorf("getting keepers: %w", err)
+		}
+
+		if err := domain.CheckKeepersQualified(animal, toEnclosure.ID, keepers, catalog); err != nil {
+			return err
+		}
+
+		// Store the old enclosure for the event
+		fromEnclosure := animal.Enclosure
+
+		if fromEnclosure != nil {
+			if err := fromEnclosure.RemoveAnimal(animal); err != nil {
+				return fmt.Errorf("removing animal from enclosure: %w", err)
+			}
+		}
+
+		if err := toEnclosure.AddAnimal(animal, catalog); err != nil {
+			return fmt.Errorf("adding animal to enclosure: %w", err)
+		}
+
+		if err := animal.MoveToEnclosure(toEnclosure); err != nil {
+			return fmt.Errorf("moving animal to enclosure: %w", err)
+		}
+
+		if err := repos.Animals().UpdateAnimal(ctx, animal); err != nil {
+			return fmt.Errorf("updating animal: %w", err)
+		}
+
+		if fromEnclosure != nil {
+			if err := repos.Enclosures().UpdateEnclosure(ctx, fromEnclosure); err != nil {
+				return fmt.Errorf("updating old enclosure: %w", err)
+			}
+		}
+
+		if err := repos.Enclosures().UpdateEnclosure(ctx, toEnclosure); err != nil {
+			return fmt.Errorf("updating new enclosure: %w", err)
+		}
+
+		now := at.timeProvider.Now()
+
+		movedEvent := &domain.AnimalMovedEvent{
+			AnimalID:      animal.ID,
+			AnimalName:    animal.Name,
+			AnimalSpecies: animal.Species,
+			ToEnclosureID: toEnclosure.ID,
+			Timestamp:     now,
+		}
+
+		if fromEnclosure != nil {
+			movedEvent.FromEnclosureID = fromEnclosure.ID
+		}
+
+		// Record the AnimalMovedEvent together with the transfer
+		if err := repos.Outbox().Record(ctx, movedEvent, now); err != nil {
+			return fmt.Errorf("recording animal moved event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (at *AnimalTransfer) TransferAnimals(ctx context.Context, requests []TransferRequest) (*domain.TransferBatch, error) {
+	if len(requests) == 0 {
+		return nil, domain.ErrEmptyTransferBatch
 	}
 
-	toEnclosure, err := at.enclosureRepository.GetEnclosure(ctx, toEnclosureID)
+	batch := &domain.TransferBatch{ID: domain.TransferBatchID(uuid.New())}
+
+	err := at.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		// Animals and enclosures come from a single graph, so that moving an animal out
+		// of an enclosure frees the place another animal of the batch takes
+		enclosures, err := repos.Enclosures().GetAllEnclosures(ctx)
+		if err != nil {
+			return fmt.Errorf("getting enclosures: %w", err)
+		}
+
+		enclosuresByID := make(map[domain.EnclosureID]*domain.Enclosure, len(enclosures))
+		residents := make(map[domain.AnimalID]*domain.Animal)
+
+		for _, enclosure := range enclosures {
+			enclosuresByID[enclosure.ID] = enclosure
+
+			for animal := range enclosure.Occupancy.Animals {
+				residents[animal.ID] = animal
+			}
+		}
+
+		transfers := make([]domain.Transfer, 0, len(requests))
+		seen := make(map[domain.AnimalID]struct{}, len(requests))
+
+		for _, request := range requests {
+			if _, ok := seen[request.AnimalID]; ok {
+				return fmt.Errorf("%w: animal %s", domain.ErrDuplicateTransfer, request.AnimalID)
+			}
+
+			seen[request.AnimalID] = struct{}{}
+
+			animal, ok := residents[request.AnimalID]
+			if !ok {
+				if animal, err = repos.Animals().GetAnimal(ctx, request.AnimalID); err != nil {
+					return fmt.Errorf("getting animal: %w", err)
+				}
+			}
+
+			toEnclosure, ok := enclosuresByID[request.ToEnclosureID]
+			if !ok {
+				return fmt.Errorf("%w: id %s", domain.ErrEnclosureNotFound, request.ToEnclosureID)
+			}
+
+			if animal.Enclosure != nil && animal.Enclosure.ID == toEnclosure.ID {
+				continue
+			}
+
+			transfers = append(transfers, domain.Transfer{Animal: animal, To: toEnclosure})
+		}
+
+		batch.TransferredAt = at.timeProvider.Now()
+
+		// Nothing to do if every animal is already where it has to be
+		if len(transfers) == 0 {
+			return nil
+		}
+
+		catalog, err := domain.LoadSpeciesCatalog(ctx, repos.Species())
+		if err != nil {
+			return err
+		}
+
+		keepers, err := repos.Keepers().GetAllKeepers(ctx)
+		if err != nil {
+			return fmt.Errorf("getting keepers: %w", err)
+		}
+
+		for _, transfer := range transfers {
+			if err := domain.CheckKeepersQualified(transfer.Animal, transfer.To.ID, keepers, catalog); err != nil {
+				return err
+			}
+		}
+
+		if batch.Transfers, err = catalog.TransferAnimals(transfers); err != nil {
+			return err
+		}
+
+		touched := make(map[domain.EnclosureID]*domain.Enclosure)
+
+		for _, transfer := range transfers {
+			if err := repos.Animals().UpdateAnimal(ctx, transfer.Animal); err != nil {
+				return fmt.Errorf("updating animal: %w", err)
+			}
+
+			touched[transfer.To.ID] = transfer.To
+		}
+
+		for _, record := range batch.Transfers {
+			if from, ok := enclosuresByID[record.FromEnclosureID]; ok {
+				touched[from.ID] = from
+			}
+		}
+
+		for _, enclosure := range touched {
+			if err := repos.Enclosures().UpdateEnclosure(ctx, enclosure); err != nil {
+				return fmt.Errorf("updating enclosure: %w", err)
+			}
+		}
+
+		for i, record := range batch.Transfers {
+			movedEvent := &domain.AnimalMovedEvent{
+				AnimalID:        record.AnimalID,
+				AnimalName:      transfers[i].Animal.Name,
+				AnimalSpecies:   transfers[i].Animal.Species,
+				FromEnclosureID: record.FromEnclosureID,
+				ToEnclosureID:   record.ToEnclosureID,
+				Timestamp:       batch.TransferredAt,
+			}
+
+			if err := repos.Outbox().Record(ctx, movedEvent, batch.TransferredAt); err != nil {
+				return fmt.Errorf("recording animal moved event: %w", err)
+			}
+		}
+
+		transferredEvent := &domain.AnimalsTransferredEvent{
+			BatchID:   batch.ID,
+			Transfers: batch.Transfers,
+			Timestamp: batch.TransferredAt,
+		}
+
+		if err := repos.Outbox().Record(ctx, transferredEvent, batch.TransferredAt); err != nil {
+			return fmt.Errorf("recording animals transferred event: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("getting enclosure: %w", err)
+		return nil, err
 	}
 
-	// Store the old enclosure for the event
-	fromEnclosure := animal.Enclosure
+	return batch, nil
+}
 
-	if err = animal.Enclosure.RemoveAnimal(animal); err != nil {
-		return fmt.Errorf("removing animal from enclosure: %w", err)
-	}
+func (at *AnimalTransfer) SuitableEnclosures(ctx context.Context, animalID domain.AnimalID) ([]domain.EnclosureSuitability, error) {
+	var suitable []domain.EnclosureSuitability
 
-	if err := toEnclosure.AddAnimal(animal); err != nil {
-		return fmt.Errorf("adding animal to enclosure: %w", err)
-	}
+	err := at.unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		animal, err := repos.Animals().GetAnimal(ctx, animalID)
+		if err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
 
-	if err := animal.MoveToEnclosure(toEnclosure); err != nil {
-		return fmt.Errorf("moving animal to enclosure: %w", err)
-	}
+		enclosures, err := repos.Enclosures().GetAllEnclosures(ctx)
+		if err != nil {
+			return fmt.Errorf("getting enclosures: %w", err)
+		}
 
-	if err := at.animalRepository.UpdateAnimal(ctx, animal); err != nil {
-		return fmt.Errorf("updating animal: %w", err)
-	}
+		catalog, err := domain.LoadSpeciesCatalog(ctx, repos.Species())
+		if err != nil {
+			return err
+		}
 
-	if err := at.enclosureRepository.UpdateEnclosure(ctx, toEnclosure); err != nil {
-		return err
-	}
+		suitable = catalog.RankEnclosures(animal, enclosures)
 
-	// Publish the AnimalMovedEvent
-	movedEvent := domain.AnimalMovedEvent{
-		AnimalID:      animal.ID,
-		AnimalName:    animal.Name,
-		AnimalSpecies: animal.Species,
-		FromEnclosure: fromEnclosure,
-		ToEnclosure:   toEnclosure,
-		Timestamp:     at.timeProvider.Now(),
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	at.eventDispatcher.Dispatch(ctx, &movedEvent)
+	return suitable, nil
+}
+
+func (at *AnimalTransfer) RemoveAnimal(ctx context.Context, animalID domain.AnimalID) error {
+	return at.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		animal, err := repos.Animals().GetAnimal(ctx, animalID)
+		if err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
+
+		// The quarantine has to be cleared by a vet first
+		if animal.Status == domain.AnimalStatusQuarantined {
+			return domain.ErrAnimalQuarantined
+		}
+
+		if animal.Enclosure != nil {
+			if err := animal.Enclosure.RemoveAnimal(animal); err != nil {
+				return fmt.Errorf("removing animal from enclosure: %w", err)
+			}
+
+			if err := repos.Enclosures().UpdateEnclosure(ctx, animal.Enclosure); err != nil {
+				return fmt.Errorf("updating enclosure: %w", err)
+			}
+		}
+
+		// Feeding tasks of the keepers come from the schedules, so they go away with them
+		schedules, err := repos.FeedingSchedules().GetFeedingSchedulesForAnimal(ctx, animal.ID)
+		if err != nil {
+			return fmt.Errorf("getting feeding schedules: %w", err)
+		}
+
+		for _, schedule := range schedules {
+			if err := repos.FeedingSchedules().DeleteFeedingSchedule(ctx, schedule.ID); err != nil {
+				return fmt.Errorf("deleting feeding schedule: %w", err)
+			}
+		}
+
+		appointments, err := repos.VetAppointments().GetAllVetAppointments(ctx)
+		if err != nil {
+			return fmt.Errorf("getting vet appointments: %w", err)
+		}
+
+		for _, appointment := range appointments {
+			if appointment.AnimalID != animal.ID || appointment.Status != domain.VetAppointmentStatusScheduled {
+				continue
+			}
+
+			if err := appointment.Cancel(); err != nil {
+				return fmt.Errorf("cancelling vet appointment: %w", err)
+			}
+
+			if err := repos.VetAppointments().UpdateVetAppointment(ctx, appointment); err != nil {
+				return fmt.Errorf("updating vet appointment: %w", err)
+			}
+		}
+
+		if err := repos.Animals().DeleteAnimal(ctx, animal.ID); err != nil {
+			return fmt.Errorf("deleting animal: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
@@ -0,0 +1,153 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/application/services"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// placeNewAnimal adds a new animal into the enclosure the way POST /animals does.
+func placeNewAnimal(ctx context.Context, unitOfWork domain.UnitOfWork, enclosureID domain.EnclosureID, name string) (*domain.Animal, error) {
+	animal := &domain.Animal{
+		ID:           domain.AnimalID(uuid.New()),
+		Species:      "Zebra",
+		Name:         domain.AnimalName(name),
+		Gender:       domain.Female,
+		FavoriteFood: "Hay",
+		Status:       domain.AnimalStatusHealthy,
+	}
+
+	err := unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		enclosure, err := repos.Enclosures().GetEnclosure(ctx, enclosureID)
+		if err != nil {
+			return err
+		}
+
+		if err := enclosure.AddAnimal(animal, domain.SpeciesCatalog{}); err != nil {
+			return err
+		}
+
+		if err := animal.MoveToEnclosure(enclosure); err != nil {
+			return err
+		}
+
+		if err := repos.Animals().AddAnimal(ctx, animal); err != nil {
+			return err
+		}
+
+		return repos.Enclosures().UpdateEnclosure(ctx, enclosure)
+	})
+
+	return animal, err
+}
+
+func TestRemoveAnimalFreesItsPlace(t *testing.T) {
+	ctx := context.Background()
+	unitOfWork := newTestUnitOfWork()
+
+	enclosure := &domain.Enclosure{
+		ID:   domain.EnclosureID(uuid.New()),
+		Type: domain.EnclosureTypeSavanna,
+		Size: 100,
+		Occupancy: domain.EnclosureOccupancy{
+			Capacity: 2,
+			Animals:  make(map[*domain.Animal]struct{}),
+		},
+	}
+
+	err := unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Enclosures().AddEnclosure(ctx, enclosure)
+	})
+	require.NoError(t, err)
+
+	removed, err := placeNewAnimal(ctx, unitOfWork, enclosure.ID, "Zed")
+	require.NoError(t, err)
+	_, err = placeNewAnimal(ctx, unitOfWork, enclosure.ID, "Zoe")
+	require.NoError(t, err)
+
+	schedule := &domain.FeedingSchedule{
+		ID:     domain.FeedingScheduleID(uuid.New()),
+		Animal: removed,
+		Food:   "Hay",
+		Time:   domain.FeedingScheduleTime(time.Now().Add(time.Hour)),
+		Status: domain.FeedingStatusNotDone,
+	}
+
+	slot, err := domain.NewTimeSlot(time.Now().Add(2*time.Hour), time.Now().Add(3*time.Hour))
+	require.NoError(t, err)
+	appointment, err := domain.NewVetAppointment(removed.ID, "Dr. Watson", slot, "Checkup", false)
+	require.NoError(t, err)
+
+	err = unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		if err := repos.FeedingSchedules().AddFeedingSchedule(ctx, schedule); err != nil {
+			return err
+		}
+
+		return repos.VetAppointments().AddVetAppointment(ctx, appointment)
+	})
+	require.NoError(t, err)
+
+	_, err = placeNewAnimal(ctx, unitOfWork, enclosure.ID, "Zack")
+	require.ErrorIs(t, err, domain.ErrEnclosureFull)
+
+	transfer := services.NewAnimalTransfer(unitOfWork, services.NewRealTimeProvider())
+	require.NoError(t, transfer.RemoveAnimal(ctx, removed.ID))
+
+	// The place of the removed animal can be taken, and then the enclosure is full again
+	_, err = placeNewAnimal(ctx, unitOfWork, enclosure.ID, "Zack")
+	require.NoError(t, err)
+	_, err = placeNewAnimal(ctx, unitOfWork, enclosure.ID, "Zara")
+	require.ErrorIs(t, err, domain.ErrEnclosureFull)
+
+	err = unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		_, err := repos.Animals().GetAnimal(ctx, removed.ID)
+		assert.ErrorIs(t, err, domain.ErrAnimalNotFound)
+
+		schedules, err := repos.FeedingSchedules().GetFeedingSchedulesForAnimal(ctx, removed.ID)
+		require.NoError(t, err)
+		assert.Empty(t, schedules)
+
+		storedAppointment, err := repos.VetAppointments().GetVetAppointment(ctx, appointment.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.VetAppointmentStatusCancelled, storedAppointment.Status)
+
+		storedEnclosure, err := repos.Enclosures().GetEnclosure(ctx, enclosure.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, storedEnclosure.Occupancy.CountAnimals())
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestRemoveAnimalRejectsQuarantinedAnimal(t *testing.T) {
+	ctx := context.Background()
+	unitOfWork := newTestUnitOfWork()
+
+	animal := &domain.Animal{
+		ID:      domain.AnimalID(uuid.New()),
+		Species: "Zebra",
+		Name:    "Zed",
+		Status:  domain.AnimalStatusQuarantined,
+	}
+
+	err := unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Animals().AddAnimal(ctx, animal)
+	})
+	require.NoError(t, err)
+
+	transfer := services.NewAnimalTransfer(unitOfWork, services.NewRealTimeProvider())
+	require.ErrorIs(t, transfer.RemoveAnimal(ctx, animal.ID), domain.ErrAnimalQuarantined)
+
+	err = unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		_, err := repos.Animals().GetAnimal(ctx, animal.ID)
+		return err
+	})
+	require.NoError(t, err)
+}
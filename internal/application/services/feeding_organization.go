@@ -2,74 +2,228 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/maklybae/ddd-zoo/internal/domain"
-	"github.com/maklybae/ddd-zoo/pkg/events"
 )
 
 type FeedingOrganizationService interface {
-	FeedAll(ctx context.Context, now time.Time) error
+	// FeedAll feeds every animal whose feedings are due at now and returns the number of feedings processed.
+	// A recurring schedule is fed once, for its latest due occurrence, and the earlier due ones are missed.
+	// Feedings with a portion consume food from the inventory; a feeding the stock cannot cover stays pending
+	// and raises a shortage alert. A food missing from the inventory is out of stock.
+	FeedAll(ctx context.Context, now time.Time) (processed int, err error)
 }
 
 type FeedingOrganization struct {
-	animalRepository          domain.AnimalRepository
-	feedingScheduleRepository domain.FeedingScheduleRepository
-	eventDispatcher           events.Dispatcher
-	timeProvider              TimeProvider
+	unitOfWork   domain.UnitOfWork
+	timeProvider TimeProvider
 }
 
 func NewFeedingOrganization(
-	animalRepository domain.AnimalRepository,
-	feedingScheduleRepository domain.FeedingScheduleRepository,
-	eventDispatcher events.Dispatcher,
+	unitOfWork domain.UnitOfWork,
 	timeProvider TimeProvider,
 ) *FeedingOrganization {
 	return &FeedingOrganization{
-		animalRepository:          animalRepository,
-		feedingScheduleRepository: feedingScheduleRepository,
-		eventDispatcher:           eventDispatcher,
-		timeProvider:              timeProvider,
+		unitOfWork:   unitOfWork,
+		timeProvider: timeProvider,
 	}
 }
 
-func (fo *FeedingOrganization) FeedAll(ctx context.Context, now time.Time) error {
-	feedingSchedules, err := fo.feedingScheduleRepository.GetAllFeedingSchedules(ctx)
-	if err != nil {
-		return fmt.Errorf("getting all feeding schedules: %w", err)
-	}
+func (fo *FeedingOrganization) FeedAll(ctx context.Context, now time.Time) (int, error) {
+	var processed int
+
+	err := fo.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		processed = 0
+
+		feedingSchedules, err := repos.FeedingSchedules().GetDueFeedingSchedules(ctx, now)
+		if err != nil {
+			return fmt.Errorf("getting due feeding schedules: %w", err)
+		}
+
+		stocks, err := repos.FoodStocks().GetAllFoodStocks(ctx)
+		if err != nil {
+			return fmt.Errorf("getting food stocks: %w", err)
+		}
 
-	for _, feedingSchedule := range feedingSchedules {
-		if feedingSchedule.IsReady(now) {
-			// Publish FeedingTimeEvent before feeding the animal
-			feedingEvent := domain.FeedingTimeEvent{
-				ScheduleID:    feedingSchedule.ID,
-				AnimalID:      feedingSchedule.Animal.ID,
-				AnimalName:    feedingSchedule.Animal.Name,
-				AnimalSpecies: feedingSchedule.Animal.Species,
-				Food:          feedingSchedule.Food,
-				FeedingTime:   time.Time(feedingSchedule.Time),
-				Timestamp:     fo.timeProvider.Now(),
+		inventory := make(map[domain.Food]*domain.FoodStock, len(stocks))
+		for _, stock := range stocks {
+			inventory[stock.Food] = stock
+		}
+
+		touchedStocks := make(map[domain.Food]*domain.FoodStock)
+		addedStocks := make(map[domain.Food]*domain.FoodStock)
+
+		for _, feedingSchedule := range feedingSchedules {
+			// Feedings missed while the service was down are not made up: only the latest due one is fed
+			due := feedingSchedule.DueOccurrences(now)
+			if len(due) == 0 {
+				continue
 			}
 
-			fo.eventDispatcher.Dispatch(ctx, &feedingEvent)
+			occurrence := due[len(due)-1]
+
+			var stock *domain.FoodStock
 
-			if err := feedingSchedule.Animal.Feed(feedingSchedule.Food); err != nil {
-				return fmt.Errorf("feeding animal: %w", err)
+			if !feedingSchedule.Portion.IsZero() {
+				var ok bool
+
+				stock, ok = inventory[feedingSchedule.Food]
+				if !ok {
+					// A food missing from the inventory is out of stock: it gets an empty stock to remember the shortage
+					stock, err = domain.NewFoodStock(feedingSchedule.Food, feedingSchedule.Portion.Unit, 0)
+					if err != nil {
+						return fmt.Errorf("creating food stock: %w", err)
+					}
+
+					inventory[stock.Food] = stock
+					addedStocks[stock.Food] = stock
+				} else {
+					touchedStocks[stock.Food] = stock
+				}
 			}
 
-			if err := feedingSchedule.Done(); err != nil {
-				return fmt.Errorf("marking feeding schedule as done: %w", err)
+			err := feedOccurrence(ctx, repos, feedingSchedule, stock, occurrence, nil, fo.timeProvider.Now())
+			if errors.Is(err, domain.ErrInsufficientFoodStock) {
+				if err := fo.reportShortage(ctx, repos, stock, feedingSchedule); err != nil {
+					return err
+				}
+
+				// The feeding stays pending until the food is delivered
+				continue
 			}
+
+			if err != nil {
+				return err
+			}
+
+			processed++
 		}
 
-		if err := fo.animalRepository.UpdateAnimal(ctx, feedingSchedule.Animal); err != nil {
-			return fmt.Errorf("updating animal: %w", err)
+		for _, stock := range touchedStocks {
+			if err := repos.FoodStocks().UpdateFoodStock(ctx, stock); err != nil {
+				return fmt.Errorf("updating food stock: %w", err)
+			}
+		}
+
+		for _, stock := range addedStocks {
+			if err := repos.FoodStocks().AddFoodStock(ctx, stock); err != nil {
+				return fmt.Errorf("adding food stock: %w", err)
+			}
 		}
 
-		if err := fo.feedingScheduleRepository.UpdateFeedingSchedule(ctx, feedingSchedule); err != nil {
-			return fmt.Errorf("updating feeding schedule: %w", err)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return processed, nil
+}
+
+// reportShortage records a shortage alert unless the stock has already run out since the last delivery.
+func (fo *FeedingOrganization) reportShortage(
+	ctx context.Context,
+	repos domain.Repositories,
+	stock *domain.FoodStock,
+	feedingSchedule *domain.FeedingSchedule,
+) error {
+	if !stock.ReportShortage() {
+		return nil
+	}
+
+	timestamp := fo.timeProvider.Now()
+
+	if err := repos.Outbox().Record(ctx, &domain.FoodShortageEvent{
+		Food:       stock.Food,
+		Required:   feedingSchedule.Portion,
+		InStock:    stock.Quantity,
+		ScheduleID: feedingSchedule.ID,
+		AnimalID:   feedingSchedule.Animal.ID,
+		Timestamp:  timestamp,
+	}, timestamp); err != nil {
+		return fmt.Errorf("recording food shortage event: %w", err)
+	}
+
+	return nil
+}
+
+// feedOccurrence feeds the animal of the schedule for the occurrence and marks it as done. The portion is taken
+// out of the stock first, which is nil if the schedule has no portion and is saved by the caller; a stock that
+// cannot cover the portion fails with domain.ErrInsufficientFoodStock before anything is fed.
+func feedOccurrence(
+	ctx context.Context,
+	repos domain.Repositories,
+	feedingSchedule *domain.FeedingSchedule,
+	stock *domain.FoodStock,
+	occurrence time.Time,
+	fedBy *domain.KeeperID,
+	timestamp time.Time,
+) error {
+	if stock != nil {
+		if err := takeFood(ctx, repos, stock, feedingSchedule, timestamp); err != nil {
+			return err
+		}
+	}
+
+	if err := feedingSchedule.CompleteOccurrence(occurrence); err != nil {
+		return err
+	}
+
+	if err := feedingSchedule.Animal.Feed(feedingSchedule.Food); err != nil {
+		return fmt.Errorf("feeding animal: %w", err)
+	}
+
+	if err := repos.Animals().UpdateAnimal(ctx, feedingSchedule.Animal); err != nil {
+		return fmt.Errorf("updating animal: %w", err)
+	}
+
+	if err := repos.FeedingSchedules().UpdateFeedingSchedule(ctx, feedingSchedule); err != nil {
+		return fmt.Errorf("updating feeding schedule: %w", err)
+	}
+
+	// Record the FeedingTimeEvent together with the feeding
+	if err := repos.Outbox().Record(ctx, &domain.FeedingTimeEvent{
+		ScheduleID:    feedingSchedule.ID,
+		AnimalID:      feedingSchedule.Animal.ID,
+		AnimalName:    feedingSchedule.Animal.Name,
+		AnimalSpecies: feedingSchedule.Animal.Species,
+		Food:          feedingSchedule.Food,
+		Portion:       feedingSchedule.Portion,
+		FeedingTime:   occurrence,
+		FedBy:         fedBy,
+		Timestamp:     timestamp,
+	}, timestamp); err != nil {
+		return fmt.Errorf("recording feeding time event: %w", err)
+	}
+
+	return nil
+}
+
+// takeFood takes a portion of the schedule out of the stock. Crossing the low stock threshold is recorded as an alert.
+func takeFood(
+	ctx context.Context,
+	repos domain.Repositories,
+	stock *domain.FoodStock,
+	feedingSchedule *domain.FeedingSchedule,
+	timestamp time.Time,
+) error {
+	wasLow := stock.IsLow()
+
+	if err := stock.Consume(feedingSchedule.Portion); err != nil {
+		return fmt.Errorf("consuming food of feeding schedule %s: %w", feedingSchedule.ID, err)
+	}
+
+	if !wasLow && stock.IsLow() {
+		if err := repos.Outbox().Record(ctx, &domain.FoodStockLowEvent{
+			Food:              stock.Food,
+			InStock:           stock.Quantity,
+			LowStockThreshold: stock.LowStockThreshold,
+			Timestamp:         timestamp,
+		}, timestamp); err != nil {
+			return fmt.Errorf("recording food stock low event: %w", err)
 		}
 	}
 
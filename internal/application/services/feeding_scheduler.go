@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// FeedingScheduler periodically runs FeedingOrganizationService.FeedAll in the background.
+type FeedingScheduler struct {
+	feedingOrganization FeedingOrganizationService
+	timeProvider        TimeProvider
+	interval            time.Duration
+}
+
+func NewFeedingScheduler(
+	feedingOrganization FeedingOrganizationService,
+	timeProvider TimeProvider,
+	interval time.Duration,
+) *FeedingScheduler {
+	return &FeedingScheduler{
+		feedingOrganization: feedingOrganization,
+		timeProvider:        timeProvider,
+		interval:            interval,
+	}
+}
+
+// Run feeds due animals on every tick until ctx is cancelled.
+func (fs *FeedingScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(fs.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fs.runOnce(ctx)
+		}
+	}
+}
+
+func (fs *FeedingScheduler) runOnce(ctx context.Context) {
+	processed, err := fs.feedingOrganization.FeedAll(ctx, fs.timeProvider.Now())
+	if err != nil {
+		log.Printf("Scheduled feeding failed: %v", err)
+		return
+	}
+
+	log.Printf("Scheduled feeding processed %d schedules", processed)
+}
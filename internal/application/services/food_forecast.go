@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+type FoodForecastService interface {
+	// ForecastFoodDemand projects the consumption of every food by the feedings scheduled within
+	// [from, from+horizon) and compares it against the current inventory.
+	ForecastFoodDemand(ctx context.Context, from time.Time, horizon time.Duration) (*domain.FoodForecast, error)
+}
+
+type FoodForecast struct {
+	unitOfWork domain.UnitOfWork
+}
+
+func NewFoodForecast(unitOfWork domain.UnitOfWork) *FoodForecast {
+	return &FoodForecast{
+		unitOfWork: unitOfWork,
+	}
+}
+
+func (ff *FoodForecast) ForecastFoodDemand(ctx context.Context, from time.Time, horizon time.Duration) (*domain.FoodForecast, error) {
+	var forecast *domain.FoodForecast
+
+	to := from.Add(horizon)
+
+	// Schedules and stocks are read in one transaction so that a feeding run cannot fall in between
+	err := ff.unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		schedules, err := repos.FeedingSchedules().GetFeedingSchedulesForTimeRange(ctx, from, to)
+		if err != nil {
+			return fmt.Errorf("getting feeding schedules: %w", err)
+		}
+
+		stocks, err := repos.FoodStocks().GetAllFoodStocks(ctx)
+		if err != nil {
+			return fmt.Errorf("getting food stocks: %w", err)
+		}
+
+		forecast = domain.ForecastFoodDemand(schedules, stocks, from, to)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return forecast, nil
+}
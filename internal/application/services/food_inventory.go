@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+type FoodInventoryService interface {
+	// DeliverFood adds the delivered quantity to the stock of the food and returns the updated stock.
+	DeliverFood(ctx context.Context, food domain.Food, delivered domain.FoodQuantity) (*domain.FoodStock, error)
+	// LowStockReport returns the stocks at or below their low stock threshold ordered by food name.
+	LowStockReport(ctx context.Context) ([]*domain.FoodStock, error)
+}
+
+type FoodInventory struct {
+	unitOfWork   domain.UnitOfWork
+	timeProvider TimeProvider
+}
+
+func NewFoodInventory(
+	unitOfWork domain.UnitOfWork,
+	timeProvider TimeProvider,
+) *FoodInventory {
+	return &FoodInventory{
+		unitOfWork:   unitOfWork,
+		timeProvider: timeProvider,
+	}
+}
+
+func (fi *FoodInventory) DeliverFood(ctx context.Context, food domain.Food, delivered domain.FoodQuantity) (*domain.FoodStock, error) {
+	var stock *domain.FoodStock
+
+	err := fi.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		stock, err = repos.FoodStocks().GetFoodStock(ctx, food)
+		if err != nil {
+			return fmt.Errorf("getting food stock: %w", err)
+		}
+
+		if err := stock.Deliver(delivered); err != nil {
+			return err
+		}
+
+		if err := repos.FoodStocks().UpdateFoodStock(ctx, stock); err != nil {
+			return fmt.Errorf("updating food stock: %w", err)
+		}
+
+		now := fi.timeProvider.Now()
+
+		if err := repos.Outbox().Record(ctx, &domain.FoodDeliveredEvent{
+			Food:      stock.Food,
+			Delivered: delivered,
+			InStock:   stock.Quantity,
+			Timestamp: now,
+		}, now); err != nil {
+			return fmt.Errorf("recording food delivered event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stock, nil
+}
+
+func (fi *FoodInventory) LowStockReport(ctx context.Context) ([]*domain.FoodStock, error) {
+	var low []*domain.FoodStock
+
+	err := fi.unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		stocks, err := repos.FoodStocks().GetAllFoodStocks(ctx)
+		if err != nil {
+			return fmt.Errorf("getting food stocks: %w", err)
+		}
+
+		low = make([]*domain.FoodStock, 0)
+
+		for _, stock := range stocks {
+			if stock.IsLow() {
+				low = append(low, stock)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return low, nil
+}
@@ -0,0 +1,342 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+type KeeperTasksService interface {
+	// TaskList returns the feedings, cleanings and checkups within the period falling to the keeper, ordered by time.
+	// A task falls to its assignee or, without one, to the keeper responsible for its enclosure at the time.
+	TaskList(ctx context.Context, keeperID domain.KeeperID, period domain.TimeSlot) ([]domain.KeeperTask, error)
+	// CompleteTask marks the occurrence of the task at the time as done by the keeper it falls to.
+	// An occurrence in the future cannot be completed.
+	// Completing a feeding feeds the animal and takes the portion from the inventory like a scheduled
+	// feeding does, completing a cleaning cleans the enclosure.
+	CompleteTask(
+		ctx context.Context,
+		keeperID domain.KeeperID,
+		kind domain.TaskKind,
+		taskID uuid.UUID,
+		at time.Time,
+	) (domain.KeeperTask, error)
+	// AssignFeeding assigns the feedings of the schedule to the keeper, or to the keeper on duty if keeperID is nil.
+	AssignFeeding(ctx context.Context, scheduleID domain.FeedingScheduleID, keeperID *domain.KeeperID) (*domain.FeedingSchedule, error)
+	// AssignCareTask assigns the care task to the keeper, or to the keeper on duty if keeperID is nil.
+	AssignCareTask(ctx context.Context, taskID domain.CareTaskID, keeperID *domain.KeeperID) (*domain.CareTask, error)
+}
+
+type KeeperTasks struct {
+	unitOfWork   domain.UnitOfWork
+	timeProvider TimeProvider
+}
+
+func NewKeeperTasks(unitOfWork domain.UnitOfWork, timeProvider TimeProvider) *KeeperTasks {
+	return &KeeperTasks{
+		unitOfWork:   unitOfWork,
+		timeProvider: timeProvider,
+	}
+}
+
+func (kt *KeeperTasks) TaskList(ctx context.Context, keeperID domain.KeeperID, period domain.TimeSlot) ([]domain.KeeperTask, error) {
+	var tasks []domain.KeeperTask
+
+	err := kt.unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		if _, err := repos.Keepers().GetKeeper(ctx, keeperID); err != nil {
+			return fmt.Errorf("getting keeper: %w", err)
+		}
+
+		keepers, shifts, err := staff(ctx, repos)
+		if err != nil {
+			return err
+		}
+
+		schedules, err := repos.FeedingSchedules().GetFeedingSchedulesForTimeRange(ctx, period.Start, period.End)
+		if err != nil {
+			return fmt.Errorf("getting feeding schedules: %w", err)
+		}
+
+		careTasks, err := repos.CareTasks().GetAllCareTasks(ctx)
+		if err != nil {
+			return fmt.Errorf("getting care tasks: %w", err)
+		}
+
+		tasks = domain.KeeperTasks(keeperID, period, schedules, careTasks, keepers, shifts)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (kt *KeeperTasks) CompleteTask(
+	ctx context.Context,
+	keeperID domain.KeeperID,
+	kind domain.TaskKind,
+	taskID uuid.UUID,
+	at time.Time,
+) (domain.KeeperTask, error) {
+	if at.After(kt.timeProvider.Now()) {
+		return domain.KeeperTask{}, fmt.Errorf("%w: %s", domain.ErrTaskInFuture, at.Format(time.RFC3339))
+	}
+
+	var task domain.KeeperTask
+
+	err := kt.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		if _, err := repos.Keepers().GetKeeper(ctx, keeperID); err != nil {
+			return fmt.Errorf("getting keeper: %w", err)
+		}
+
+		var err error
+
+		switch kind {
+		case domain.TaskKindFeeding:
+			task, err = kt.completeFeeding(ctx, repos, keeperID, domain.FeedingScheduleID(taskID), at)
+		case domain.TaskKindCleaning, domain.TaskKindCheckup:
+			task, err = kt.completeCareTask(ctx, repos, keeperID, kind, domain.CareTaskID(taskID), at)
+		default:
+			err = fmt.Errorf("%w: %q", domain.ErrUnknownTaskKind, kind)
+		}
+
+		return err
+	})
+	if err != nil {
+		return domain.KeeperTask{}, err
+	}
+
+	return task, nil
+}
+
+func (kt *KeeperTasks) completeFeeding(
+	ctx context.Context,
+	repos domain.Repositories,
+	keeperID domain.KeeperID,
+	scheduleID domain.FeedingScheduleID,
+	at time.Time,
+) (domain.KeeperTask, error) {
+	schedule, err := repos.FeedingSchedules().GetFeedingSchedule(ctx, scheduleID)
+	if err != nil {
+		return domain.KeeperTask{}, fmt.Errorf("getting feeding schedule: %w", err)
+	}
+
+	explicit, err := checkAssignee(ctx, repos, keeperID, schedule.Assignee, schedule.EnclosureID(), at)
+	if err != nil {
+		return domain.KeeperTask{}, err
+	}
+
+	var stock *domain.FoodStock
+
+	if !schedule.Portion.IsZero() {
+		stock, err = repos.FoodStocks().GetFoodStock(ctx, schedule.Food)
+
+		switch {
+		case errors.Is(err, domain.ErrFoodStockNotFound):
+			return domain.KeeperTask{}, fmt.Errorf("%w: %s is not in the inventory", domain.ErrInsufficientFoodStock, schedule.Food)
+		case err != nil:
+			return domain.KeeperTask{}, fmt.Errorf("getting food stock: %w", err)
+		}
+	}
+
+	if err := feedOccurrence(ctx, repos, schedule, stock, at, &keeperID, kt.timeProvider.Now()); err != nil {
+		return domain.KeeperTask{}, err
+	}
+
+	if stock != nil {
+		if err := repos.FoodStocks().UpdateFoodStock(ctx, stock); err != nil {
+			return domain.KeeperTask{}, fmt.Errorf("updating food stock: %w", err)
+		}
+	}
+
+	return schedule.Task(domain.FeedingOccurrence{Time: at, Status: domain.FeedingOccurrenceStatusDone}, explicit), nil
+}
+
+func (kt *KeeperTasks) completeCareTask(
+	ctx context.Context,
+	repos domain.Repositories,
+	keeperID domain.KeeperID,
+	kind domain.TaskKind,
+	taskID domain.CareTaskID,
+	at time.Time,
+) (domain.KeeperTask, error) {
+	careTask, err := repos.CareTasks().GetCareTask(ctx, taskID)
+	if err != nil {
+		return domain.KeeperTask{}, fmt.Errorf("getting care task: %w", err)
+	}
+
+	if careTask.Kind != kind {
+		return domain.KeeperTask{}, fmt.Errorf("%w: %s is a %s task", domain.ErrCareTaskNotFound, taskID, careTask.Kind)
+	}
+
+	if err := careTask.CompleteOccurrence(at); err != nil {
+		return domain.KeeperTask{}, err
+	}
+
+	explicit, err := checkAssignee(ctx, repos, keeperID, careTask.Assignee, &careTask.EnclosureID, at)
+	if err != nil {
+		return domain.KeeperTask{}, err
+	}
+
+	if careTask.Kind == domain.TaskKindCleaning {
+		enclosure, err := repos.Enclosures().GetEnclosure(ctx, careTask.EnclosureID)
+		if err != nil {
+			return domain.KeeperTask{}, fmt.Errorf("getting enclosure: %w", err)
+		}
+
+		if err := enclosure.Clean(); err != nil {
+			return domain.KeeperTask{}, fmt.Errorf("cleaning enclosure: %w", err)
+		}
+
+		if err := repos.Enclosures().UpdateEnclosure(ctx, enclosure); err != nil {
+			return domain.KeeperTask{}, fmt.Errorf("updating enclosure: %w", err)
+		}
+	}
+
+	if err := repos.CareTasks().UpdateCareTask(ctx, careTask); err != nil {
+		return domain.KeeperTask{}, fmt.Errorf("updating care task: %w", err)
+	}
+
+	timestamp := kt.timeProvider.Now()
+
+	if err := repos.Outbox().Record(ctx, &domain.CareTaskCompletedEvent{
+		TaskID:      careTask.ID,
+		Kind:        careTask.Kind,
+		EnclosureID: careTask.EnclosureID,
+		KeeperID:    keeperID,
+		ScheduledAt: at,
+		Timestamp:   timestamp,
+	}, timestamp); err != nil {
+		return domain.KeeperTask{}, fmt.Errorf("recording care task completed event: %w", err)
+	}
+
+	return careTask.Task(domain.TaskOccurrence{Time: at, Status: domain.TaskStatusDone}, explicit), nil
+}
+
+func (kt *KeeperTasks) AssignFeeding(
+	ctx context.Context,
+	scheduleID domain.FeedingScheduleID,
+	keeperID *domain.KeeperID,
+) (*domain.FeedingSchedule, error) {
+	var schedule *domain.FeedingSchedule
+
+	err := kt.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		schedule, err = repos.FeedingSchedules().GetFeedingSchedule(ctx, scheduleID)
+		if err != nil {
+			return fmt.Errorf("getting feeding schedule: %w", err)
+		}
+
+		if err := checkKeeper(ctx, repos, keeperID); err != nil {
+			return err
+		}
+
+		schedule.Assignee = keeperID
+
+		if err := repos.FeedingSchedules().UpdateFeedingSchedule(ctx, schedule); err != nil {
+			return fmt.Errorf("updating feeding schedule: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (kt *KeeperTasks) AssignCareTask(
+	ctx context.Context,
+	taskID domain.CareTaskID,
+	keeperID *domain.KeeperID,
+) (*domain.CareTask, error) {
+	var careTask *domain.CareTask
+
+	err := kt.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		careTask, err = repos.CareTasks().GetCareTask(ctx, taskID)
+		if err != nil {
+			return fmt.Errorf("getting care task: %w", err)
+		}
+
+		if err := checkKeeper(ctx, repos, keeperID); err != nil {
+			return err
+		}
+
+		careTask.Assignee = keeperID
+
+		if err := repos.CareTasks().UpdateCareTask(ctx, careTask); err != nil {
+			return fmt.Errorf("updating care task: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return careTask, nil
+}
+
+// checkAssignee returns an error unless the task in the enclosure at the moment falls to the keeper,
+// and reports whether it is assigned to them explicitly.
+func checkAssignee(
+	ctx context.Context,
+	repos domain.Repositories,
+	keeperID domain.KeeperID,
+	assignee *domain.KeeperID,
+	enclosureID *domain.EnclosureID,
+	at time.Time,
+) (bool, error) {
+	keepers, shifts, err := staff(ctx, repos)
+	if err != nil {
+		return false, err
+	}
+
+	keeper, explicit := domain.TaskAssignee(assignee, enclosureID, keepers, shifts, at)
+
+	switch {
+	case keeper == nil:
+		return false, fmt.Errorf("%w: nobody is on duty at %s", domain.ErrTaskNotAssigned, at.Format(time.RFC3339))
+	case keeper.ID != keeperID:
+		return false, fmt.Errorf("%w: it falls to %s", domain.ErrTaskNotAssigned, keeper.Name)
+	default:
+		return explicit, nil
+	}
+}
+
+// checkKeeper returns an error if the keeper a task is assigned to does not exist. A nil keeperID is valid.
+func checkKeeper(ctx context.Context, repos domain.Repositories, keeperID *domain.KeeperID) error {
+	if keeperID == nil {
+		return nil
+	}
+
+	if _, err := repos.Keepers().GetKeeper(ctx, *keeperID); err != nil {
+		return fmt.Errorf("getting keeper: %w", err)
+	}
+
+	return nil
+}
+
+func staff(ctx context.Context, repos domain.Repositories) ([]*domain.Keeper, []*domain.Shift, error) {
+	keepers, err := repos.Keepers().GetAllKeepers(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting keepers: %w", err)
+	}
+
+	shifts, err := repos.Shifts().GetAllShifts(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting shifts: %w", err)
+	}
+
+	return keepers, shifts, nil
+}
@@ -0,0 +1,120 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/application/services"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedTime is a time provider stopped at a moment.
+type fixedTime time.Time
+
+func (t fixedTime) Now() time.Time {
+	return time.Time(t)
+}
+
+func TestKeeperTasksCompleteCareTask(t *testing.T) {
+	ctx := context.Background()
+	unitOfWork := newTestUnitOfWork()
+
+	dayStart := time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC)
+	day := domain.TimeSlot{Start: dayStart, End: dayStart.Add(24 * time.Hour)}
+	cleaningAt := dayStart.Add(9 * time.Hour)
+
+	enclosure := &domain.Enclosure{
+		ID:        domain.EnclosureID(uuid.New()),
+		Type:      domain.EnclosureTypeSavanna,
+		Size:      100,
+		Occupancy: domain.EnclosureOccupancy{Capacity: 2, Animals: make(map[*domain.Animal]struct{})},
+	}
+
+	dayShift, err := domain.NewShift("Day", 8*time.Hour, 16*time.Hour, nil, time.UTC)
+	require.NoError(t, err)
+
+	ann, err := domain.NewKeeper("Ann", nil, 1)
+	require.NoError(t, err)
+	ann.Assignments = []domain.KeeperAssignment{{EnclosureID: enclosure.ID, Shift: dayShift.Name}}
+
+	bob, err := domain.NewKeeper("Bob", nil, 1)
+	require.NoError(t, err)
+
+	cleaning, err := domain.NewCareTask(domain.TaskKindCleaning, enclosure.ID, cleaningAt, nil)
+	require.NoError(t, err)
+
+	err = unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		if err := repos.Enclosures().AddEnclosure(ctx, enclosure); err != nil {
+			return err
+		}
+
+		if err := repos.Shifts().AddShift(ctx, dayShift); err != nil {
+			return err
+		}
+
+		if err := repos.Keepers().AddKeeper(ctx, ann); err != nil {
+			return err
+		}
+
+		if err := repos.Keepers().AddKeeper(ctx, bob); err != nil {
+			return err
+		}
+
+		return repos.CareTasks().AddCareTask(ctx, cleaning)
+	})
+	require.NoError(t, err)
+
+	keeperTasks := services.NewKeeperTasks(unitOfWork, fixedTime(dayStart.Add(12*time.Hour)))
+	taskID := cleaning.ID.UUID()
+
+	_, err = keeperTasks.CompleteTask(ctx, bob.ID, domain.TaskKindCleaning, taskID, cleaningAt)
+	require.ErrorIs(t, err, domain.ErrTaskNotAssigned)
+
+	_, err = keeperTasks.CompleteTask(ctx, ann.ID, domain.TaskKindCheckup, taskID, cleaningAt)
+	require.ErrorIs(t, err, domain.ErrCareTaskNotFound)
+
+	_, err = keeperTasks.CompleteTask(ctx, ann.ID, domain.TaskKindCleaning, taskID, dayStart.Add(13*time.Hour))
+	require.ErrorIs(t, err, domain.ErrTaskInFuture)
+
+	_, err = keeperTasks.CompleteTask(ctx, ann.ID, domain.TaskKindCleaning, taskID, cleaningAt.Add(time.Hour))
+	require.ErrorIs(t, err, domain.ErrUnknownCareTaskOccurrence)
+
+	task, err := keeperTasks.CompleteTask(ctx, ann.ID, domain.TaskKindCleaning, taskID, cleaningAt)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TaskStatusDone, task.Status)
+	assert.False(t, task.Explicit)
+
+	_, err = keeperTasks.CompleteTask(ctx, ann.ID, domain.TaskKindCleaning, taskID, cleaningAt)
+	require.ErrorIs(t, err, domain.ErrCareTaskDone)
+
+	tasks, err := keeperTasks.TaskList(ctx, ann.ID, day)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, taskID, tasks[0].TaskID)
+	assert.Equal(t, domain.TaskStatusDone, tasks[0].Status)
+
+	// Assigning the task to Bob takes it off the list of the keeper on duty
+	_, err = keeperTasks.AssignCareTask(ctx, cleaning.ID, &bob.ID)
+	require.NoError(t, err)
+
+	tasks, err = keeperTasks.TaskList(ctx, ann.ID, day)
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+
+	tasks, err = keeperTasks.TaskList(ctx, bob.ID, day)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.True(t, tasks[0].Explicit)
+
+	missing := domain.KeeperID(uuid.New())
+
+	_, err = keeperTasks.AssignCareTask(ctx, cleaning.ID, &missing)
+	require.ErrorIs(t, err, domain.ErrKeeperNotFound)
+
+	_, err = keeperTasks.TaskList(ctx, missing, day)
+	require.ErrorIs(t, err, domain.ErrKeeperNotFound)
+}
@@ -0,0 +1,341 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+type MedicalCareService interface {
+	// ReportIllness marks the animal sick and adds the illness to its medical record.
+	ReportIllness(ctx context.Context, animalID domain.AnimalID, report IllnessReport) (*domain.Animal, *domain.Illness, error)
+	// ChangeAnimalStatus moves the animal to another health status if the transition is allowed.
+	ChangeAnimalStatus(ctx context.Context, animalID domain.AnimalID, status domain.AnimalStatus) (*domain.Animal, error)
+	// DiagnoseAnimal adds a diagnosis to the animal's medical record, opening the record if needed.
+	DiagnoseAnimal(ctx context.Context, animalID domain.AnimalID, description domain.DiagnosisDescription, vet domain.VetName) (*domain.Diagnosis, error)
+	// TreatAnimal treats a sick animal and adds the treatment to its medical record.
+	TreatAnimal(ctx context.Context, animalID domain.AnimalID, input TreatmentInput) (*domain.Animal, *domain.Treatment, error)
+	// GetMedicalRecord returns the animal's medical record, an empty one if nothing has been recorded yet.
+	GetMedicalRecord(ctx context.Context, animalID domain.AnimalID) (*domain.MedicalRecord, error)
+}
+
+// IllnessReport describes an illness noticed by a keeper or a vet.
+type IllnessReport struct {
+	Diagnosis  domain.DiagnosisDescription
+	Severity   domain.IllnessSeverity
+	ReportedBy domain.ReporterName
+}
+
+// TreatmentInput describes a treatment to be given to an animal.
+type TreatmentInput struct {
+	Description domain.TreatmentDescription
+	Vet         domain.VetName
+	Medications []domain.Medication
+	DiagnosisID *domain.DiagnosisID
+}
+
+type MedicalCare struct {
+	unitOfWork   domain.UnitOfWork
+	timeProvider TimeProvider
+}
+
+func NewMedicalCare(
+	unitOfWork domain.UnitOfWork,
+	timeProvider TimeProvider,
+) *MedicalCare {
+	return &MedicalCare{
+		unitOfWork:   unitOfWork,
+		timeProvider: timeProvider,
+	}
+}
+
+func (mc *MedicalCare) ReportIllness(
+	ctx context.Context,
+	animalID domain.AnimalID,
+	report IllnessReport,
+) (*domain.Animal, *domain.Illness, error) {
+	var (
+		animal  *domain.Animal
+		illness domain.Illness
+	)
+
+	err := mc.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		animal, err = repos.Animals().GetAnimal(ctx, animalID)
+		if err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
+
+		now := mc.timeProvider.Now()
+
+		illness = domain.Illness{
+			ID:         domain.IllnessID(uuid.New()),
+			Diagnosis:  report.Diagnosis,
+			Severity:   report.Severity,
+			ReportedBy: report.ReportedBy,
+			ReportedAt: now,
+		}
+
+		previousStatus := animal.Status
+
+		if err := animal.MarkSick(illness); err != nil {
+			return err
+		}
+
+		record, isNew, err := mc.medicalRecord(ctx, repos, animalID)
+		if err != nil {
+			return err
+		}
+
+		if err := record.AddIllness(illness); err != nil {
+			return err
+		}
+
+		if err := repos.Animals().UpdateAnimal(ctx, animal); err != nil {
+			return fmt.Errorf("updating animal: %w", err)
+		}
+
+		if err := mc.saveMedicalRecord(ctx, repos, record, isNew); err != nil {
+			return err
+		}
+
+		fellIllEvent := &domain.AnimalFellIllEvent{
+			AnimalID:       animal.ID,
+			AnimalName:     animal.Name,
+			AnimalSpecies:  animal.Species,
+			IllnessID:      illness.ID,
+			Diagnosis:      illness.Diagnosis,
+			Severity:       illness.Severity,
+			ReportedBy:     illness.ReportedBy,
+			PreviousStatus: previousStatus,
+			Status:         animal.Status,
+			Timestamp:      now,
+		}
+
+		// Record the AnimalFellIllEvent together with the report
+		if err := repos.Outbox().Record(ctx, fellIllEvent, now); err != nil {
+			return fmt.Errorf("recording animal fell ill event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return animal, &illness, nil
+}
+
+func (mc *MedicalCare) ChangeAnimalStatus(ctx context.Context, animalID domain.AnimalID, status domain.AnimalStatus) (*domain.Animal, error) {
+	var animal *domain.Animal
+
+	err := mc.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		animal, err = repos.Animals().GetAnimal(ctx, animalID)
+		if err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
+
+		if err := animal.ChangeStatus(status); err != nil {
+			return err
+		}
+
+		if err := repos.Animals().UpdateAnimal(ctx, animal); err != nil {
+			return fmt.Errorf("updating animal: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return animal, nil
+}
+
+func (mc *MedicalCare) DiagnoseAnimal(
+	ctx context.Context,
+	animalID domain.AnimalID,
+	description domain.DiagnosisDescription,
+	vet domain.VetName,
+) (*domain.Diagnosis, error) {
+	var diagnosis domain.Diagnosis
+
+	err := mc.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		if _, err := repos.Animals().GetAnimal(ctx, animalID); err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
+
+		record, isNew, err := mc.medicalRecord(ctx, repos, animalID)
+		if err != nil {
+			return err
+		}
+
+		diagnosis = domain.Diagnosis{
+			ID:          domain.DiagnosisID(uuid.New()),
+			Description: description,
+			Vet:         vet,
+			DiagnosedAt: mc.timeProvider.Now(),
+		}
+
+		if err := record.AddDiagnosis(diagnosis); err != nil {
+			return err
+		}
+
+		return mc.saveMedicalRecord(ctx, repos, record, isNew)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &diagnosis, nil
+}
+
+func (mc *MedicalCare) TreatAnimal(
+	ctx context.Context,
+	animalID domain.AnimalID,
+	input TreatmentInput,
+) (*domain.Animal, *domain.Treatment, error) {
+	var (
+		animal    *domain.Animal
+		treatment domain.Treatment
+	)
+
+	err := mc.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		animal, err = repos.Animals().GetAnimal(ctx, animalID)
+		if err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
+
+		if err := animal.Treat(input.Description); err != nil {
+			return err
+		}
+
+		record, isNew, err := mc.medicalRecord(ctx, repos, animalID)
+		if err != nil {
+			return err
+		}
+
+		now := mc.timeProvider.Now()
+
+		treatment = domain.Treatment{
+			ID:          domain.TreatmentID(uuid.New()),
+			Description: input.Description,
+			Vet:         input.Vet,
+			Medications: input.Medications,
+			DiagnosisID: input.DiagnosisID,
+			TreatedAt:   now,
+		}
+
+		if err := record.AddTreatment(treatment); err != nil {
+			return err
+		}
+
+		if err := repos.Animals().UpdateAnimal(ctx, animal); err != nil {
+			return fmt.Errorf("updating animal: %w", err)
+		}
+
+		if err := mc.saveMedicalRecord(ctx, repos, record, isNew); err != nil {
+			return err
+		}
+
+		treatedEvent := &domain.AnimalTreatedEvent{
+			AnimalID:      animal.ID,
+			AnimalName:    animal.Name,
+			AnimalSpecies: animal.Species,
+			TreatmentID:   treatment.ID,
+			Description:   treatment.Description,
+			Vet:           treatment.Vet,
+			Timestamp:     now,
+		}
+
+		// Record the AnimalTreatedEvent together with the treatment
+		if err := repos.Outbox().Record(ctx, treatedEvent, now); err != nil {
+			return fmt.Errorf("recording animal treated event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return animal, &treatment, nil
+}
+
+func (mc *MedicalCare) GetMedicalRecord(ctx context.Context, animalID domain.AnimalID) (*domain.MedicalRecord, error) {
+	var record *domain.MedicalRecord
+
+	err := mc.unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		if _, err := repos.Animals().GetAnimal(ctx, animalID); err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
+
+		var err error
+
+		record, err = repos.MedicalRecords().GetMedicalRecord(ctx, animalID)
+		if errors.Is(err, domain.ErrMedicalRecordNotFound) {
+			// Nothing has been recorded yet, the record is not opened
+			record = domain.NewMedicalRecord(animalID, time.Time{})
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("getting medical record: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// medicalRecord returns the animal's medical record, opening a new one if it does not exist yet.
+func (mc *MedicalCare) medicalRecord(
+	ctx context.Context,
+	repos domain.Repositories,
+	animalID domain.AnimalID,
+) (record *domain.MedicalRecord, isNew bool, err error) {
+	record, err = repos.MedicalRecords().GetMedicalRecord(ctx, animalID)
+	if errors.Is(err, domain.ErrMedicalRecordNotFound) {
+		return domain.NewMedicalRecord(animalID, mc.timeProvider.Now()), true, nil
+	}
+
+	if err != nil {
+		return nil, false, fmt.Errorf("getting medical record: %w", err)
+	}
+
+	return record, false, nil
+}
+
+func (mc *MedicalCare) saveMedicalRecord(
+	ctx context.Context,
+	repos domain.Repositories,
+	record *domain.MedicalRecord,
+	isNew bool,
+) error {
+	if isNew {
+		if err := repos.MedicalRecords().AddMedicalRecord(ctx, record); err != nil {
+			return fmt.Errorf("adding medical record: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := repos.MedicalRecords().UpdateMedicalRecord(ctx, record); err != nil {
+		return fmt.Errorf("updating medical record: %w", err)
+	}
+
+	return nil
+}
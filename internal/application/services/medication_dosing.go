@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+type MedicationDosingService interface {
+	// CalculateDose returns the dose range of the drug for the animal at its latest weight, together with
+	// the amount given within the last day. It fails if the drug is contraindicated for the animal.
+	CalculateDose(ctx context.Context, animalID domain.AnimalID, drug domain.DrugName) (*domain.DoseRange, error)
+	// AdministerDrug logs a dose given as part of one of the animal's treatments. Doses outside the range
+	// for the animal's weight, above the maximum daily dose or of contraindicated drugs are refused.
+	AdministerDrug(ctx context.Context, animalID domain.AnimalID, input AdministrationInput) (*domain.DrugAdministration, error)
+}
+
+// AdministrationInput describes a dose of a drug given to an animal.
+type AdministrationInput struct {
+	TreatmentID    domain.TreatmentID
+	Drug           domain.DrugName
+	DoseMg         float64
+	AdministeredBy domain.ReporterName
+	// AdministeredAt is the time the dose was given, now if nil.
+	AdministeredAt *time.Time
+}
+
+type MedicationDosing struct {
+	unitOfWork   domain.UnitOfWork
+	timeProvider TimeProvider
+}
+
+func NewMedicationDosing(
+	unitOfWork domain.UnitOfWork,
+	timeProvider TimeProvider,
+) *MedicationDosing {
+	return &MedicationDosing{
+		unitOfWork:   unitOfWork,
+		timeProvider: timeProvider,
+	}
+}
+
+func (md *MedicationDosing) CalculateDose(
+	ctx context.Context,
+	animalID domain.AnimalID,
+	drug domain.DrugName,
+) (*domain.DoseRange, error) {
+	var doseRange domain.DoseRange
+
+	err := md.unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		animal, err := repos.Animals().GetAnimal(ctx, animalID)
+		if err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
+
+		// An animal without a medical record has no contraindications and has not been given anything
+		record, err := repos.MedicalRecords().GetMedicalRecord(ctx, animalID)
+		if err != nil && !errors.Is(err, domain.ErrMedicalRecordNotFound) {
+			return fmt.Errorf("getting medical record: %w", err)
+		}
+
+		doseRange, err = md.doseRange(ctx, repos, animal, drug, record, md.timeProvider.Now())
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &doseRange, nil
+}
+
+func (md *MedicationDosing) AdministerDrug(
+	ctx context.Context,
+	animalID domain.AnimalID,
+	input AdministrationInput,
+) (*domain.DrugAdministration, error) {
+	var administration domain.DrugAdministration
+
+	err := md.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		animal, err := repos.Animals().GetAnimal(ctx, animalID)
+		if err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
+
+		now := md.timeProvider.Now()
+
+		administeredAt := now
+		if input.AdministeredAt != nil {
+			if input.AdministeredAt.After(now) {
+				return domain.ErrAdministrationInFuture
+			}
+
+			administeredAt = *input.AdministeredAt
+		}
+
+		// Doses are only given as part of a treatment, so an animal without a medical record has none
+		record, err := repos.MedicalRecords().GetMedicalRecord(ctx, animalID)
+		if errors.Is(err, domain.ErrMedicalRecordNotFound) {
+			return fmt.Errorf("%w: %s", domain.ErrUnknownTreatment, input.TreatmentID)
+		}
+
+		if err != nil {
+			return fmt.Errorf("getting medical record: %w", err)
+		}
+
+		doseRange, err := md.doseRange(ctx, repos, animal, input.Drug, record, administeredAt)
+		if err != nil {
+			return err
+		}
+
+		if err := doseRange.Check(input.DoseMg); err != nil {
+			return err
+		}
+
+		administration = domain.DrugAdministration{
+			ID:             domain.DrugAdministrationID(uuid.New()),
+			TreatmentID:    input.TreatmentID,
+			Drug:           input.Drug,
+			DoseMg:         input.DoseMg,
+			Weight:         doseRange.Weight,
+			AdministeredBy: input.AdministeredBy,
+			AdministeredAt: administeredAt,
+		}
+
+		if err := record.AddAdministration(administration); err != nil {
+			return err
+		}
+
+		if err := repos.MedicalRecords().UpdateMedicalRecord(ctx, record); err != nil {
+			return fmt.Errorf("updating medical record: %w", err)
+		}
+
+		administeredEvent := &domain.DrugAdministeredEvent{
+			AnimalID:         animal.ID,
+			AnimalName:       animal.Name,
+			AnimalSpecies:    animal.Species,
+			AdministrationID: administration.ID,
+			TreatmentID:      administration.TreatmentID,
+			Drug:             administration.Drug,
+			DoseMg:           administration.DoseMg,
+			AdministeredBy:   administration.AdministeredBy,
+			AdministeredAt:   administration.AdministeredAt,
+			Timestamp:        now,
+		}
+
+		if err := repos.Outbox().Record(ctx, administeredEvent, now); err != nil {
+			return fmt.Errorf("recording drug administered event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &administration, nil
+}
+
+// doseRange calculates the dose range of the drug for the animal at its last weight taken at or before at.
+func (md *MedicationDosing) doseRange(
+	ctx context.Context,
+	repos domain.Repositories,
+	animal *domain.Animal,
+	name domain.DrugName,
+	record *domain.MedicalRecord,
+	at time.Time,
+) (domain.DoseRange, error) {
+	drug, err := repos.Drugs().GetDrug(ctx, name)
+	if err != nil {
+		return domain.DoseRange{}, fmt.Errorf("getting drug: %w", err)
+	}
+
+	weightRecord, err := repos.WeightRecords().GetWeightRecord(ctx, animal.ID)
+	if errors.Is(err, domain.ErrWeightRecordNotFound) {
+		return domain.DoseRange{}, fmt.Errorf("%w: %s", domain.ErrAnimalNotWeighed, animal.ID)
+	}
+
+	if err != nil {
+		return domain.DoseRange{}, fmt.Errorf("getting weight record: %w", err)
+	}
+
+	weight, ok := weightRecord.Latest(at)
+	if !ok {
+		return domain.DoseRange{}, fmt.Errorf("%w: %s", domain.ErrAnimalNotWeighed, animal.ID)
+	}
+
+	return drug.CalculateDose(animal, weight, record, at)
+}
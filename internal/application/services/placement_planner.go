@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+type PlacementPlannerService interface {
+	// PlanPlacement proposes enclosures for the existing animals and the arriving ones without changing anything.
+	PlanPlacement(ctx context.Context, request PlacementRequest) (*domain.PlacementPlan, error)
+	// ExecutePlacement carries out the steps in order in a single transaction: either every animal
+	// is placed or none is. Arriving animals are created first.
+	ExecutePlacement(ctx context.Context, steps []PlacementStep) ([]*domain.Animal, error)
+}
+
+// PlacementRequest lists the animals to plan the placement of.
+type PlacementRequest struct {
+	AnimalIDs []domain.AnimalID
+	// Arrivals are animals that do not exist yet, such as a new shipment.
+	Arrivals []*domain.Animal
+}
+
+// PlacementStep is a reviewed placement to carry out.
+type PlacementStep struct {
+	AnimalID domain.AnimalID
+	// Arrival is the animal to create, nil for existing animals.
+	Arrival       *domain.Animal
+	ToEnclosureID domain.EnclosureID
+}
+
+type PlacementPlanner struct {
+	unitOfWork  domain.UnitOfWork
+	transferSvc AnimalTransferService
+}
+
+func NewPlacementPlanner(
+	unitOfWork domain.UnitOfWork,
+	transferSvc AnimalTransferService,
+) *PlacementPlanner {
+	return &PlacementPlanner{
+		unitOfWork:  unitOfWork,
+		transferSvc: transferSvc,
+	}
+}
+
+func (pp *PlacementPlanner) PlanPlacement(ctx context.Context, request PlacementRequest) (*domain.PlacementPlan, error) {
+	var plan *domain.PlacementPlan
+
+	err := pp.unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		enclosures, err := repos.Enclosures().GetAllEnclosures(ctx)
+		if err != nil {
+			return fmt.Errorf("getting enclosures: %w", err)
+		}
+
+		// The planned animals must be the residents of the loaded enclosures, not copies of them
+		residents := make(map[domain.AnimalID]*domain.Animal)
+		for _, enclosure := range enclosures {
+			for animal := range enclosure.Occupancy.Animals {
+				residents[animal.ID] = animal
+			}
+		}
+
+		animals := make([]*domain.Animal, 0, len(request.AnimalIDs))
+		seen := make(map[domain.AnimalID]struct{}, len(request.AnimalIDs))
+
+		for _, id := range request.AnimalIDs {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+
+			seen[id] = struct{}{}
+
+			animal, ok := residents[id]
+			if !ok {
+				if animal, err = repos.Animals().GetAnimal(ctx, id); err != nil {
+					return fmt.Errorf("getting animal: %w", err)
+				}
+			}
+
+			animals = append(animals, animal)
+		}
+
+		catalog, err := domain.LoadSpeciesCatalog(ctx, repos.Species())
+		if err != nil {
+			return err
+		}
+
+		plan = catalog.PlanPlacement(animals, request.Arrivals, enclosures)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (pp *PlacementPlanner) ExecutePlacement(ctx context.Context, steps []PlacementStep) ([]*domain.Animal, error) {
+	animals := make([]*domain.Animal, 0, len(steps))
+
+	err := pp.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		for _, step := range steps {
+			if step.Arrival != nil {
+				if err := repos.Animals().AddAnimal(ctx, step.Arrival); err != nil {
+					return fmt.Errorf("adding arriving animal: %w", err)
+				}
+			}
+
+			animal, err := repos.Animals().GetAnimal(ctx, step.AnimalID)
+			if err != nil {
+				return fmt.Errorf("getting animal: %w", err)
+			}
+
+			if animal.Enclosure != nil && animal.Enclosure.ID == step.ToEnclosureID {
+				continue
+			}
+
+			// The transfer joins the transaction, so a failing step rolls back the previous ones
+			if err := pp.transferSvc.TransferAnimal(ctx, step.AnimalID, step.ToEnclosureID); err != nil {
+				return fmt.Errorf("placing animal %s: %w", step.AnimalID, err)
+			}
+		}
+
+		for _, step := range steps {
+			animal, err := repos.Animals().GetAnimal(ctx, step.AnimalID)
+			if err != nil {
+				return fmt.Errorf("getting animal: %w", err)
+			}
+
+			animals = append(animals, animal)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return animals, nil
+}
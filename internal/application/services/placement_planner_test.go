@@ -0,0 +1,85 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/application/services"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutePlacementRollsBackEarlierSteps(t *testing.T) {
+	ctx := context.Background()
+	unitOfWork := newTestUnitOfWork()
+
+	newEnclosure := func(capacity int) *domain.Enclosure {
+		return &domain.Enclosure{
+			ID:   domain.EnclosureID(uuid.New()),
+			Type: domain.EnclosureTypeSavanna,
+			Size: 100,
+			Occupancy: domain.EnclosureOccupancy{
+				Capacity: capacity,
+				Animals:  make(map[*domain.Animal]struct{}),
+			},
+		}
+	}
+
+	from, to := newEnclosure(2), newEnclosure(1)
+
+	err := unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		if err := repos.Enclosures().AddEnclosure(ctx, from); err != nil {
+			return err
+		}
+
+		return repos.Enclosures().AddEnclosure(ctx, to)
+	})
+	require.NoError(t, err)
+
+	moved, err := placeNewAnimal(ctx, unitOfWork, from.ID, "Zed")
+	require.NoError(t, err)
+	left, err := placeNewAnimal(ctx, unitOfWork, from.ID, "Zoe")
+	require.NoError(t, err)
+
+	arrival := &domain.Animal{
+		ID:      domain.AnimalID(uuid.New()),
+		Species: "Zebra",
+		Name:    "Zack",
+		Gender:  domain.Male,
+		Status:  domain.AnimalStatusHealthy,
+	}
+
+	transfer := services.NewAnimalTransfer(unitOfWork, services.NewRealTimeProvider())
+	planner := services.NewPlacementPlanner(unitOfWork, transfer)
+
+	// The first move and the arrival succeed, the last step finds the enclosure full
+	_, err = planner.ExecutePlacement(ctx, []services.PlacementStep{
+		{AnimalID: moved.ID, ToEnclosureID: to.ID},
+		{AnimalID: arrival.ID, Arrival: arrival, ToEnclosureID: from.ID},
+		{AnimalID: left.ID, ToEnclosureID: to.ID},
+	})
+	require.ErrorIs(t, err, domain.ErrEnclosureFull)
+
+	err = unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		_, err := repos.Animals().GetAnimal(ctx, arrival.ID)
+		assert.ErrorIs(t, err, domain.ErrAnimalNotFound)
+
+		storedAnimal, err := repos.Animals().GetAnimal(ctx, moved.ID)
+		require.NoError(t, err)
+		require.NotNil(t, storedAnimal.Enclosure)
+		assert.Equal(t, from.ID, storedAnimal.Enclosure.ID)
+
+		storedFrom, err := repos.Enclosures().GetEnclosure(ctx, from.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, storedFrom.Occupancy.CountAnimals())
+
+		storedTo, err := repos.Enclosures().GetEnclosure(ctx, to.ID)
+		require.NoError(t, err)
+		assert.Zero(t, storedTo.Occupancy.CountAnimals())
+
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+type QuarantineService interface {
+	// QuarantineAnimal moves a sick or observed animal into a quarantine enclosure. If enclosureID is nil,
+	// the first quarantine enclosure with free space is used.
+	QuarantineAnimal(ctx context.Context, animalID domain.AnimalID, input QuarantineInput) (*domain.Quarantine, error)
+	// ClearQuarantine ends the quarantine and moves the animal out of the quarantine enclosure.
+	ClearQuarantine(ctx context.Context, id domain.QuarantineID, input QuarantineClearance) (*domain.Quarantine, error)
+	GetQuarantine(ctx context.Context, id domain.QuarantineID) (*domain.Quarantine, error)
+	// GetQuarantines returns all quarantines, or only the active ones, oldest first.
+	GetQuarantines(ctx context.Context, activeOnly bool) ([]*domain.Quarantine, error)
+}
+
+// QuarantineInput describes a vet's order to quarantine an animal.
+type QuarantineInput struct {
+	EnclosureID *domain.EnclosureID
+	Reason      domain.QuarantineReason
+	OrderedBy   domain.VetName
+}
+
+// QuarantineClearance describes a vet's decision to clear a quarantine.
+type QuarantineClearance struct {
+	ClearedBy domain.VetName
+	// Status is the animal's status after the quarantine, Healthy if zero.
+	Status domain.AnimalStatus
+	// ToEnclosureID is the regular enclosure the animal is moved to; nil returns it to the enclosure
+	// it was taken from.
+	ToEnclosureID *domain.EnclosureID
+}
+
+type Quarantines struct {
+	unitOfWork   domain.UnitOfWork
+	transferSvc  AnimalTransferService
+	timeProvider TimeProvider
+}
+
+func NewQuarantines(
+	unitOfWork domain.UnitOfWork,
+	transferSvc AnimalTransferService,
+	timeProvider TimeProvider,
+) *Quarantines {
+	return &Quarantines{
+		unitOfWork:   unitOfWork,
+		transferSvc:  transferSvc,
+		timeProvider: timeProvider,
+	}
+}
+
+func (qs *Quarantines) QuarantineAnimal(
+	ctx context.Context,
+	animalID domain.AnimalID,
+	input QuarantineInput,
+) (*domain.Quarantine, error) {
+	var quarantine *domain.Quarantine
+
+	err := qs.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		animal, err := repos.Animals().GetAnimal(ctx, animalID)
+		if err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
+
+		enclosure, err := qs.quarantineEnclosure(ctx, repos, animal, input.EnclosureID)
+		if err != nil {
+			return err
+		}
+
+		var fromEnclosureID domain.EnclosureID
+		if animal.Enclosure != nil {
+			fromEnclosureID = animal.Enclosure.ID
+		}
+
+		now := qs.timeProvider.Now()
+
+		quarantine, err = domain.NewQuarantine(
+			domain.QuarantineID(uuid.New()),
+			animal.ID,
+			enclosure.ID,
+			fromEnclosureID,
+			input.Reason,
+			input.OrderedBy,
+			now,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := animal.Quarantine(); err != nil {
+			return err
+		}
+
+		// The status must be saved first: only quarantined animals are let into quarantine enclosures
+		if err := repos.Animals().UpdateAnimal(ctx, animal); err != nil {
+			return fmt.Errorf("updating animal: %w", err)
+		}
+
+		if err := qs.transferSvc.TransferAnimal(ctx, animal.ID, enclosure.ID); err != nil {
+			return fmt.Errorf("moving animal to quarantine: %w", err)
+		}
+
+		if err := repos.Quarantines().AddQuarantine(ctx, quarantine); err != nil {
+			return fmt.Errorf("adding quarantine: %w", err)
+		}
+
+		quarantinedEvent := &domain.AnimalQuarantinedEvent{
+			QuarantineID:    quarantine.ID,
+			AnimalID:        animal.ID,
+			AnimalName:      animal.Name,
+			AnimalSpecies:   animal.Species,
+			EnclosureID:     enclosure.ID,
+			FromEnclosureID: fromEnclosureID,
+			Reason:          quarantine.Reason,
+			OrderedBy:       quarantine.OrderedBy,
+			Timestamp:       now,
+		}
+
+		// Record the AnimalQuarantinedEvent together with the quarantine
+		if err := repos.Outbox().Record(ctx, quarantinedEvent, now); err != nil {
+			return fmt.Errorf("recording animal quarantined event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return quarantine, nil
+}
+
+func (qs *Quarantines) ClearQuarantine(
+	ctx context.Context,
+	id domain.QuarantineID,
+	input QuarantineClearance,
+) (*domain.Quarantine, error) {
+	var quarantine *domain.Quarantine
+
+	err := qs.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		quarantine, err = repos.Quarantines().GetQuarantine(ctx, id)
+		if err != nil {
+			return fmt.Errorf("getting quarantine: %w", err)
+		}
+
+		animal, err := repos.Animals().GetAnimal(ctx, quarantine.AnimalID)
+		if err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
+
+		now := qs.timeProvider.Now()
+
+		if err := quarantine.Clear(input.ClearedBy, now); err != nil {
+			return err
+		}
+
+		toEnclosureID, err := quarantine.ReleaseEnclosure(input.ToEnclosureID)
+		if err != nil {
+			return err
+		}
+
+		if err := animal.ReleaseFromQuarantine(input.Status); err != nil {
+			return err
+		}
+
+		if err := repos.Animals().UpdateAnimal(ctx, animal); err != nil {
+			return fmt.Errorf("updating animal: %w", err)
+		}
+
+		if err := qs.transferSvc.TransferAnimal(ctx, animal.ID, toEnclosureID); err != nil {
+			return fmt.Errorf("moving animal out of quarantine: %w", err)
+		}
+
+		if err := repos.Quarantines().UpdateQuarantine(ctx, quarantine); err != nil {
+			return fmt.Errorf("updating quarantine: %w", err)
+		}
+
+		clearedEvent := &domain.AnimalQuarantineClearedEvent{
+			QuarantineID:  quarantine.ID,
+			AnimalID:      animal.ID,
+			AnimalName:    animal.Name,
+			AnimalSpecies: animal.Species,
+			ClearedBy:     quarantine.ClearedBy,
+			Status:        animal.Status,
+			StartedAt:     quarantine.StartedAt,
+			Timestamp:     now,
+		}
+
+		// Record the AnimalQuarantineClearedEvent together with the clearance
+		if err := repos.Outbox().Record(ctx, clearedEvent, now); err != nil {
+			return fmt.Errorf("recording animal quarantine cleared event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return quarantine, nil
+}
+
+func (qs *Quarantines) GetQuarantine(ctx context.Context, id domain.QuarantineID) (*domain.Quarantine, error) {
+	var quarantine *domain.Quarantine
+
+	err := qs.unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		quarantine, err = repos.Quarantines().GetQuarantine(ctx, id)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return quarantine, nil
+}
+
+func (qs *Quarantines) GetQuarantines(ctx context.Context, activeOnly bool) ([]*domain.Quarantine, error) {
+	var quarantines []*domain.Quarantine
+
+	err := qs.unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		if activeOnly {
+			quarantines, err = repos.Quarantines().GetActiveQuarantines(ctx)
+		} else {
+			quarantines, err = repos.Quarantines().GetAllQuarantines(ctx)
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting quarantines: %w", err)
+	}
+
+	return quarantines, nil
+}
+
+// quarantineEnclosure returns the requested quarantine enclosure or the first one with space for the animal
+// and keepers qualified for it.
+func (qs *Quarantines) quarantineEnclosure(
+	ctx context.Context,
+	repos domain.Repositories,
+	animal *domain.Animal,
+	enclosureID *domain.EnclosureID,
+) (*domain.Enclosure, error) {
+	if enclosureID != nil {
+		enclosure, err := repos.Enclosures().GetEnclosure(ctx, *enclosureID)
+		if err != nil {
+			return nil, fmt.Errorf("getting enclosure: %w", err)
+		}
+
+		if !enclosure.IsQuarantine() {
+			return nil, fmt.Errorf("%w: %s has type %q", domain.ErrNotQuarantineEnclosure, enclosure.ID, enclosure.Type)
+		}
+
+		return enclosure, nil
+	}
+
+	enclosures, err := repos.Enclosures().GetEnclosuresByType(ctx, domain.EnclosureTypeQuarantine)
+	if err != nil {
+		return nil, fmt.Errorf("getting quarantine enclosures: %w", err)
+	}
+
+	catalog, err := domain.LoadSpeciesCatalog(ctx, repos.Species())
+	if err != nil {
+		return nil, err
+	}
+
+	keepers, err := repos.Keepers().GetAllKeepers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting keepers: %w", err)
+	}
+
+	// The animal is moved only under keepers qualified for it
+	for _, enclosure := range enclosures {
+		if enclosure.RemainingSlots(animal.Species, catalog) > 0 &&
+			domain.CheckKeepersQualified(animal, enclosure.ID, keepers, catalog) == nil {
+			return enclosure, nil
+		}
+	}
+
+	return nil, domain.ErrNoQuarantineEnclosure
+}
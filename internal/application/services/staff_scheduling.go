@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+type StaffSchedulingService interface {
+	// UpdateKeeper changes the details of a keeper. It fails if the keeper's existing assignments
+	// would exceed the new limit or need a qualification the keeper no longer has.
+	UpdateKeeper(ctx context.Context, id domain.KeeperID, input KeeperInput) (*domain.Keeper, error)
+	// AssignKeeper puts the keeper in charge of the enclosure during the shift. Enclosures housing
+	// dangerous species need a keeper qualified for each of them.
+	AssignKeeper(ctx context.Context, id domain.KeeperID, enclosureID domain.EnclosureID, shift domain.ShiftName) (*domain.Keeper, error)
+	UnassignKeeper(ctx context.Context, id domain.KeeperID, enclosureID domain.EnclosureID, shift domain.ShiftName) (*domain.Keeper, error)
+	// DeleteShift removes a shift no keeper is assigned to.
+	DeleteShift(ctx context.Context, name domain.ShiftName) error
+	// ResponsibleKeepers returns the keepers in charge of the enclosure at the moment.
+	ResponsibleKeepers(ctx context.Context, enclosureID domain.EnclosureID, at time.Time) ([]domain.KeeperOnDuty, error)
+}
+
+// KeeperInput describes a keeper.
+type KeeperInput struct {
+	Name           domain.KeeperName
+	Qualifications []domain.AnimalSpecies
+	MaxEnclosures  int
+}
+
+type StaffScheduling struct {
+	unitOfWork domain.UnitOfWork
+}
+
+func NewStaffScheduling(unitOfWork domain.UnitOfWork) *StaffScheduling {
+	return &StaffScheduling{
+		unitOfWork: unitOfWork,
+	}
+}
+
+func (ss *StaffScheduling) UpdateKeeper(ctx context.Context, id domain.KeeperID, input KeeperInput) (*domain.Keeper, error) {
+	return ss.updateKeeper(ctx, id, func(ctx context.Context, repos domain.Repositories, keeper *domain.Keeper) error {
+		if err := keeper.Update(input.Name, input.Qualifications, input.MaxEnclosures); err != nil {
+			return err
+		}
+
+		// Deleted enclosures are skipped, their assignments no longer matter
+		enclosures := make(map[domain.EnclosureID]*domain.Enclosure)
+
+		for _, assignment := range keeper.Assignments {
+			if _, ok := enclosures[assignment.EnclosureID]; ok {
+				continue
+			}
+
+			enclosure, err := repos.Enclosures().GetEnclosure(ctx, assignment.EnclosureID)
+			if errors.Is(err, domain.ErrEnclosureNotFound) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("getting enclosure: %w", err)
+			}
+
+			enclosures[enclosure.ID] = enclosure
+		}
+
+		catalog, err := domain.LoadSpeciesCatalog(ctx, repos.Species())
+		if err != nil {
+			return err
+		}
+
+		return keeper.CheckAssignments(enclosures, catalog)
+	})
+}
+
+func (ss *StaffScheduling) AssignKeeper(
+	ctx context.Context,
+	id domain.KeeperID,
+	enclosureID domain.EnclosureID,
+	shift domain.ShiftName,
+) (*domain.Keeper, error) {
+	return ss.updateKeeper(ctx, id, func(ctx context.Context, repos domain.Repositories, keeper *domain.Keeper) error {
+		if _, err := repos.Shifts().GetShift(ctx, shift); err != nil {
+			return fmt.Errorf("getting shift: %w", err)
+		}
+
+		enclosure, err := repos.Enclosures().GetEnclosure(ctx, enclosureID)
+		if err != nil {
+			return fmt.Errorf("getting enclosure: %w", err)
+		}
+
+		catalog, err := domain.LoadSpeciesCatalog(ctx, repos.Species())
+		if err != nil {
+			return err
+		}
+
+		return keeper.Assign(enclosure, shift, catalog)
+	})
+}
+
+func (ss *StaffScheduling) UnassignKeeper(
+	ctx context.Context,
+	id domain.KeeperID,
+	enclosureID domain.EnclosureID,
+	shift domain.ShiftName,
+) (*domain.Keeper, error) {
+	return ss.updateKeeper(ctx, id, func(_ context.Context, _ domain.Repositories, keeper *domain.Keeper) error {
+		return keeper.Unassign(enclosureID, shift)
+	})
+}
+
+func (ss *StaffScheduling) DeleteShift(ctx context.Context, name domain.ShiftName) error {
+	return ss.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		keepers, err := repos.Keepers().GetAllKeepers(ctx)
+		if err != nil {
+			return fmt.Errorf("getting keepers: %w", err)
+		}
+
+		for _, keeper := range keepers {
+			for _, assignment := range keeper.Assignments {
+				if assignment.Shift == name {
+					return fmt.Errorf("%w: %s is assigned to shift %s", domain.ErrShiftInUse, keeper.Name, name)
+				}
+			}
+		}
+
+		return repos.Shifts().DeleteShift(ctx, name)
+	})
+}
+
+func (ss *StaffScheduling) ResponsibleKeepers(
+	ctx context.Context,
+	enclosureID domain.EnclosureID,
+	at time.Time,
+) ([]domain.KeeperOnDuty, error) {
+	var onDuty []domain.KeeperOnDuty
+
+	err := ss.unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		if _, err := repos.Enclosures().GetEnclosure(ctx, enclosureID); err != nil {
+			return fmt.Errorf("getting enclosure: %w", err)
+		}
+
+		keepers, err := repos.Keepers().GetAllKeepers(ctx)
+		if err != nil {
+			return fmt.Errorf("getting keepers: %w", err)
+		}
+
+		shifts, err := repos.Shifts().GetAllShifts(ctx)
+		if err != nil {
+			return fmt.Errorf("getting shifts: %w", err)
+		}
+
+		onDuty = domain.ResponsibleKeepers(enclosureID, keepers, shifts, at)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return onDuty, nil
+}
+
+// updateKeeper applies the change to the keeper and saves it in a single transaction.
+func (ss *StaffScheduling) updateKeeper(
+	ctx context.Context,
+	id domain.KeeperID,
+	change func(ctx context.Context, repos domain.Repositories, keeper *domain.Keeper) error,
+) (*domain.Keeper, error) {
+	var keeper *domain.Keeper
+
+	err := ss.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		keeper, err = repos.Keepers().GetKeeper(ctx, id)
+		if err != nil {
+			return fmt.Errorf("getting keeper: %w", err)
+		}
+
+		if err := change(ctx, repos, keeper); err != nil {
+			return err
+		}
+
+		if err := repos.Keepers().UpdateKeeper(ctx, keeper); err != nil {
+			return fmt.Errorf("updating keeper: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keeper, nil
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+type VetSchedulingService interface {
+	// ScheduleAppointment books a vet for the animal. It fails if the vet or the animal is booked at that time
+	// or the animal is fed during the appointment or, under anesthesia, during the fasting period before it.
+	ScheduleAppointment(ctx context.Context, animalID domain.AnimalID, input AppointmentInput) (*domain.VetAppointment, error)
+	// RescheduleAppointment changes a scheduled appointment, checking the same conflicts as scheduling.
+	RescheduleAppointment(ctx context.Context, id domain.VetAppointmentID, input AppointmentInput) (*domain.VetAppointment, error)
+	// CompleteAppointment records the outcome of a scheduled appointment.
+	CompleteAppointment(ctx context.Context, id domain.VetAppointmentID, outcome string) (*domain.VetAppointment, error)
+	// CancelAppointment cancels a scheduled appointment, freeing its slot.
+	CancelAppointment(ctx context.Context, id domain.VetAppointmentID) (*domain.VetAppointment, error)
+}
+
+// AppointmentInput describes when, by whom and what for an animal is seen.
+type AppointmentInput struct {
+	Vet        domain.VetName
+	Slot       domain.TimeSlot
+	Procedure  domain.TreatmentDescription
+	Anesthesia bool
+}
+
+type VetScheduling struct {
+	unitOfWork   domain.UnitOfWork
+	timeProvider TimeProvider
+}
+
+func NewVetScheduling(
+	unitOfWork domain.UnitOfWork,
+	timeProvider TimeProvider,
+) *VetScheduling {
+	return &VetScheduling{
+		unitOfWork:   unitOfWork,
+		timeProvider: timeProvider,
+	}
+}
+
+func (vs *VetScheduling) ScheduleAppointment(
+	ctx context.Context,
+	animalID domain.AnimalID,
+	input AppointmentInput,
+) (*domain.VetAppointment, error) {
+	var appointment *domain.VetAppointment
+
+	err := vs.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		if input.Slot.Start.Before(vs.timeProvider.Now()) {
+			return domain.ErrAppointmentInPast
+		}
+
+		if _, err := repos.Animals().GetAnimal(ctx, animalID); err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
+
+		var err error
+
+		appointment, err = domain.NewVetAppointment(animalID, input.Vet, input.Slot, input.Procedure, input.Anesthesia)
+		if err != nil {
+			return err
+		}
+
+		if err := vs.checkConflicts(ctx, repos, appointment); err != nil {
+			return err
+		}
+
+		if err := repos.VetAppointments().AddVetAppointment(ctx, appointment); err != nil {
+			return fmt.Errorf("adding vet appointment: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return appointment, nil
+}
+
+func (vs *VetScheduling) RescheduleAppointment(
+	ctx context.Context,
+	id domain.VetAppointmentID,
+	input AppointmentInput,
+) (*domain.VetAppointment, error) {
+	return vs.updateAppointment(ctx, id, func(ctx context.Context, repos domain.Repositories, appointment *domain.VetAppointment) error {
+		if input.Slot.Start.Before(vs.timeProvider.Now()) {
+			return domain.ErrAppointmentInPast
+		}
+
+		if err := appointment.Reschedule(input.Vet, input.Slot, input.Procedure, input.Anesthesia); err != nil {
+			return err
+		}
+
+		return vs.checkConflicts(ctx, repos, appointment)
+	})
+}
+
+func (vs *VetScheduling) CompleteAppointment(
+	ctx context.Context,
+	id domain.VetAppointmentID,
+	outcome string,
+) (*domain.VetAppointment, error) {
+	return vs.updateAppointment(ctx, id, func(_ context.Context, _ domain.Repositories, appointment *domain.VetAppointment) error {
+		return appointment.Complete(outcome)
+	})
+}
+
+func (vs *VetScheduling) CancelAppointment(ctx context.Context, id domain.VetAppointmentID) (*domain.VetAppointment, error) {
+	return vs.updateAppointment(ctx, id, func(_ context.Context, _ domain.Repositories, appointment *domain.VetAppointment) error {
+		return appointment.Cancel()
+	})
+}
+
+// updateAppointment applies the change to the appointment and saves it in a single transaction.
+func (vs *VetScheduling) updateAppointment(
+	ctx context.Context,
+	id domain.VetAppointmentID,
+	change func(ctx context.Context, repos domain.Repositories, appointment *domain.VetAppointment) error,
+) (*domain.VetAppointment, error) {
+	var appointment *domain.VetAppointment
+
+	err := vs.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		appointment, err = repos.VetAppointments().GetVetAppointment(ctx, id)
+		if err != nil {
+			return fmt.Errorf("getting vet appointment: %w", err)
+		}
+
+		if err := change(ctx, repos, appointment); err != nil {
+			return err
+		}
+
+		if err := repos.VetAppointments().UpdateVetAppointment(ctx, appointment); err != nil {
+			return fmt.Errorf("updating vet appointment: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return appointment, nil
+}
+
+// checkConflicts loads the appointments and feeding schedules the appointment may collide with.
+func (vs *VetScheduling) checkConflicts(
+	ctx context.Context,
+	repos domain.Repositories,
+	appointment *domain.VetAppointment,
+) error {
+	appointments, err := repos.VetAppointments().GetVetAppointmentsForTimeRange(ctx, appointment.Slot.Start, appointment.Slot.End)
+	if err != nil {
+		return fmt.Errorf("getting vet appointments: %w", err)
+	}
+
+	period := appointment.NoFeedingPeriod()
+
+	schedules, err := repos.FeedingSchedules().GetFeedingSchedulesForTimeRange(ctx, period.Start, period.End)
+	if err != nil {
+		return fmt.Errorf("getting feeding schedules: %w", err)
+	}
+
+	return appointment.CheckConflicts(appointments, schedules)
+}
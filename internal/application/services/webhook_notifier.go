@@ -0,0 +1,284 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	"github.com/maklybae/ddd-zoo/pkg/events"
+)
+
+// Headers sent with every webhook delivery.
+const (
+	WebhookEventHeader     = "X-Zoo-Event"
+	WebhookDeliveryHeader  = "X-Zoo-Delivery"
+	WebhookTimestampHeader = "X-Zoo-Timestamp"
+	WebhookSignatureHeader = "X-Zoo-Signature"
+)
+
+// WebhookPayload is the JSON body posted to webhooks.
+type WebhookPayload struct {
+	DeliveryID domain.WebhookDeliveryID `json:"deliveryId"`
+	Event      string                   `json:"event"`
+	Data       json.RawMessage          `json:"data"`
+	SentAt     time.Time                `json:"sentAt"`
+}
+
+// SignWebhookPayload returns the value of the signature header: the hex-encoded HMAC-SHA256
+// of "<timestamp>.<body>" keyed with the webhook secret, prefixed with "sha256=".
+// Receivers recompute it to verify that the delivery is authentic and has not been replayed.
+func SignWebhookPayload(secret domain.WebhookSecret, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookDeliveryEventName names the jobs delivering an event to a single webhook.
+const WebhookDeliveryEventName = "webhook.delivery"
+
+// WebhookDelivery is the job of delivering an event to a single webhook. It is passed
+// between the WebhookNotifier and the WebhookDeliverer like an event.
+type WebhookDelivery struct {
+	WebhookID  domain.WebhookID
+	DeliveryID domain.WebhookDeliveryID
+	EventType  string
+	Data       json.RawMessage
+}
+
+var _ events.Event = (*WebhookDelivery)(nil)
+
+func (d *WebhookDelivery) Name() string {
+	return WebhookDeliveryEventName
+}
+
+// RegisterWebhookDelivery makes webhook deliveries decodable, so their dead letters can be re-driven.
+func RegisterWebhookDelivery(registry *events.Registry) {
+	registry.Register(WebhookDeliveryEventName, func() events.Event { return &WebhookDelivery{} })
+}
+
+// WebhookNotifier is an event handler that hands every event over to the webhooks subscribed to it.
+// It dispatches a WebhookDelivery per webhook, so a slow or failing webhook does not hold up the others.
+type WebhookNotifier struct {
+	unitOfWork domain.UnitOfWork
+	deliveries events.Dispatcher
+}
+
+var (
+	_ events.EventHandler = (*WebhookNotifier)(nil)
+	_ events.NamedHandler = (*WebhookNotifier)(nil)
+)
+
+// NewWebhookNotifier returns a notifier dispatching deliveries to deliveries, which must have
+// a WebhookDeliverer registered for WebhookDeliveryEventName.
+func NewWebhookNotifier(unitOfWork domain.UnitOfWork, deliveries events.Dispatcher) *WebhookNotifier {
+	return &WebhookNotifier{
+		unitOfWork: unitOfWork,
+		deliveries: deliveries,
+	}
+}
+
+func (wn *WebhookNotifier) HandlerName() string {
+	return "services.WebhookNotifier"
+}
+
+// Replayable reports false: subscribers have already been notified of the stored events.
+func (wn *WebhookNotifier) Replayable() bool {
+	return false
+}
+
+// Handle dispatches a delivery of the event to every subscribed webhook.
+func (wn *WebhookNotifier) Handle(ctx context.Context, event events.Event) error {
+	var webhooks []*domain.WebhookSubscription
+
+	err := wn.unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		webhooks, err = repos.Webhooks().GetWebhooksForEvent(ctx, event.Name())
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("getting webhooks for %s: %w", event.Name(), err)
+	}
+
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	data, err := events.Encode(event)
+	if err != nil {
+		return err
+	}
+
+	for _, webhook := range webhooks {
+		wn.deliveries.Dispatch(ctx, &WebhookDelivery{
+			WebhookID:  webhook.ID,
+			DeliveryID: domain.WebhookDeliveryID(uuid.New()),
+			EventType:  event.Name(),
+			Data:       data,
+		})
+	}
+
+	return nil
+}
+
+// WebhookDeliverer handles webhook deliveries. It makes a single attempt and records it; failed attempts
+// are returned as errors, so the dispatcher retries them according to its retry policy.
+type WebhookDeliverer struct {
+	unitOfWork   domain.UnitOfWork
+	client       *http.Client
+	timeProvider TimeProvider
+}
+
+var (
+	_ events.EventHandler = (*WebhookDeliverer)(nil)
+	_ events.NamedHandler = (*WebhookDeliverer)(nil)
+)
+
+func NewWebhookDeliverer(unitOfWork domain.UnitOfWork, client *http.Client, timeProvider TimeProvider) *WebhookDeliverer {
+	return &WebhookDeliverer{
+		unitOfWork:   unitOfWork,
+		client:       client,
+		timeProvider: timeProvider,
+	}
+}
+
+func (wd *WebhookDeliverer) HandlerName() string {
+	return "services.WebhookDeliverer"
+}
+
+func (wd *WebhookDeliverer) Handle(ctx context.Context, event events.Event) error {
+	delivery, ok := event.(*WebhookDelivery)
+	if !ok {
+		return fmt.Errorf("webhook deliverer cannot handle %s events", event.Name())
+	}
+
+	var (
+		webhook  *domain.WebhookSubscription
+		attempts int
+	)
+
+	err := wd.unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		webhook, err = repos.Webhooks().GetWebhook(ctx, delivery.WebhookID)
+		if err != nil {
+			return err
+		}
+
+		history, err := repos.Webhooks().GetDeliveryAttempts(ctx, delivery.WebhookID)
+		if err != nil {
+			return err
+		}
+
+		for _, attempt := range history {
+			if attempt.DeliveryID == delivery.DeliveryID {
+				attempts++
+			}
+		}
+
+		return nil
+	})
+	if errors.Is(err, domain.ErrWebhookNotFound) {
+		// The webhook has been deleted since the event occurred
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("getting webhook %s: %w", delivery.WebhookID, err)
+	}
+
+	record := wd.post(ctx, webhook, delivery)
+	record.Attempt = attempts + 1
+
+	err = wd.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Webhooks().AddDeliveryAttempt(ctx, record)
+	})
+	if err != nil {
+		log.Printf("Failed to record delivery %s to webhook %s: %v", delivery.DeliveryID, webhook.ID, err)
+	}
+
+	if !record.Succeeded {
+		return fmt.Errorf("delivering %s to webhook %s: %s", delivery.EventType, webhook.ID, record.Error)
+	}
+
+	return nil
+}
+
+func (wd *WebhookDeliverer) post(
+	ctx context.Context,
+	webhook *domain.WebhookSubscription,
+	delivery *WebhookDelivery,
+) *domain.WebhookDeliveryAttempt {
+	now := wd.timeProvider.Now()
+
+	record := &domain.WebhookDeliveryAttempt{
+		ID:          uuid.New(),
+		WebhookID:   webhook.ID,
+		DeliveryID:  delivery.DeliveryID,
+		EventType:   delivery.EventType,
+		AttemptedAt: now,
+	}
+
+	body, err := json.Marshal(WebhookPayload{
+		DeliveryID: delivery.DeliveryID,
+		Event:      delivery.EventType,
+		Data:       delivery.Data,
+		SentAt:     now,
+	})
+	if err != nil {
+		record.Error = fmt.Sprintf("encoding payload: %v", err)
+		return record
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		record.Error = fmt.Sprintf("creating request: %v", err)
+		return record
+	}
+
+	timestamp := now.Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, delivery.EventType)
+	req.Header.Set(WebhookDeliveryHeader, delivery.DeliveryID.String())
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.Secret, timestamp, body))
+
+	started := time.Now()
+	resp, err := wd.client.Do(req)
+	record.Duration = time.Since(started)
+
+	if err != nil {
+		record.Error = err.Error()
+		return record
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	record.StatusCode = resp.StatusCode
+	record.Succeeded = resp.StatusCode >= 200 && resp.StatusCode < 300
+
+	if !record.Succeeded {
+		record.Error = fmt.Sprintf("unexpected status %s", resp.Status)
+	}
+
+	return record
+}
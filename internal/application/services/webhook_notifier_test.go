@@ -0,0 +1,223 @@
+package services_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/application/services"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	"github.com/maklybae/ddd-zoo/internal/infrastructure/persistence/inmemory"
+	"github.com/maklybae/ddd-zoo/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// webhookReceiver answers the first failures requests with 503 and the rest with 200.
+type webhookReceiver struct {
+	failures int
+	mu       sync.Mutex
+	requests []receivedRequest
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests = append(r.requests, receivedRequest{header: req.Header.Clone(), body: body})
+
+	if len(r.requests) <= r.failures {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *webhookReceiver) received() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]receivedRequest(nil), r.requests...)
+}
+
+func newTestUnitOfWork() domain.UnitOfWork {
+	return inmemory.NewUnitOfWork(
+		inmemory.NewAnimalRepository(),
+		inmemory.NewEnclosureRepository(),
+		inmemory.NewFeedingScheduleRepository(),
+		inmemory.NewMedicalRecordRepository(),
+		inmemory.NewQuarantineRepository(),
+		inmemory.NewSpeciesRepository(),
+		inmemory.NewFoodStockRepository(),
+		inmemory.NewWeightRecordRepository(),
+		inmemory.NewVetAppointmentRepository(),
+		inmemory.NewDrugRepository(),
+		inmemory.NewKeeperRepository(),
+		inmemory.NewShiftRepository(),
+		inmemory.NewCareTaskRepository(),
+		inmemory.NewWebhookRepository(),
+		inmemory.NewOutboxRepository(),
+	)
+}
+
+func TestWebhookNotifierSignsAndRetriesDeliveries(t *testing.T) {
+	ctx := context.Background()
+	unitOfWork := newTestUnitOfWork()
+
+	receiver := &webhookReceiver{failures: 2}
+	endpoint := httptest.NewServer(receiver)
+	defer endpoint.Close()
+
+	const secret domain.WebhookSecret = "s3cr3t"
+
+	webhook, err := domain.NewWebhookSubscription(
+		domain.WebhookID(uuid.New()),
+		endpoint.URL,
+		[]string{domain.AnimalMovedEventName},
+		secret,
+		time.Now(),
+	)
+	require.NoError(t, err)
+
+	err = unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Webhooks().AddWebhook(ctx, webhook)
+	})
+	require.NoError(t, err)
+
+	registry := events.NewRegistry()
+	services.RegisterWebhookDelivery(registry)
+
+	deadLetters := inmemory.NewDeadLetterRepository()
+	deliveries := events.NewAsyncDispatcher(deadLetters, registry, events.HandlerOptions{
+		Workers:   1,
+		QueueSize: 10,
+		RetryPolicy: events.RetryPolicy{
+			MaxAttempts:    5,
+			InitialBackoff: time.Millisecond,
+			Multiplier:     1,
+		},
+	})
+	defer deliveries.Shutdown(ctx)
+
+	deliveries.RegisterHandler(
+		services.WebhookDeliveryEventName,
+		services.NewWebhookDeliverer(unitOfWork, endpoint.Client(), services.NewRealTimeProvider()),
+	)
+
+	notifier := services.NewWebhookNotifier(unitOfWork, deliveries)
+	event := &domain.AnimalMovedEvent{AnimalID: domain.AnimalID(uuid.New()), Timestamp: time.Now()}
+	require.NoError(t, notifier.Handle(ctx, event))
+
+	require.Eventually(t, func() bool { return len(receiver.received()) == 3 }, 5*time.Second, 10*time.Millisecond)
+
+	requests := receiver.received()
+	deliveryID := requests[0].header.Get(services.WebhookDeliveryHeader)
+	require.NotEmpty(t, deliveryID)
+
+	for _, request := range requests {
+		assert.Equal(t, domain.AnimalMovedEventName, request.header.Get(services.WebhookEventHeader))
+		assert.Equal(t, deliveryID, request.header.Get(services.WebhookDeliveryHeader))
+
+		timestamp, err := strconv.ParseInt(request.header.Get(services.WebhookTimestampHeader), 10, 64)
+		require.NoError(t, err)
+		assert.Equal(t,
+			services.SignWebhookPayload(secret, timestamp, request.body),
+			request.header.Get(services.WebhookSignatureHeader),
+		)
+	}
+
+	var attempts []*domain.WebhookDeliveryAttempt
+
+	require.Eventually(t, func() bool {
+		err := unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+			var err error
+
+			attempts, err = repos.Webhooks().GetDeliveryAttempts(ctx, webhook.ID)
+
+			return err
+		})
+
+		return err == nil && len(attempts) == 3
+	}, time.Second, 10*time.Millisecond)
+
+	for i, attempt := range attempts {
+		assert.Equal(t, i+1, attempt.Attempt)
+		assert.Equal(t, deliveryID, attempt.DeliveryID.String())
+	}
+
+	assert.Equal(t, http.StatusServiceUnavailable, attempts[0].StatusCode)
+	assert.False(t, attempts[1].Succeeded)
+	assert.True(t, attempts[2].Succeeded)
+
+	letters, err := deadLetters.GetAllDeadLetters(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, letters)
+}
+
+func TestWebhookNotifierDeadLettersExhaustedDeliveries(t *testing.T) {
+	ctx := context.Background()
+	unitOfWork := newTestUnitOfWork()
+
+	receiver := &webhookReceiver{failures: 100}
+	endpoint := httptest.NewServer(receiver)
+	defer endpoint.Close()
+
+	webhook, err := domain.NewWebhookSubscription(
+		domain.WebhookID(uuid.New()),
+		endpoint.URL,
+		[]string{events.AllEvents},
+		"secret",
+		time.Now(),
+	)
+	require.NoError(t, err)
+
+	err = unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Webhooks().AddWebhook(ctx, webhook)
+	})
+	require.NoError(t, err)
+
+	registry := events.NewRegistry()
+	services.RegisterWebhookDelivery(registry)
+
+	deadLetters := inmemory.NewDeadLetterRepository()
+	deliveries := events.NewAsyncDispatcher(deadLetters, registry, events.HandlerOptions{
+		Workers:     1,
+		QueueSize:   10,
+		RetryPolicy: events.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond, Multiplier: 1},
+	})
+	defer deliveries.Shutdown(ctx)
+
+	deliveries.RegisterHandler(
+		services.WebhookDeliveryEventName,
+		services.NewWebhookDeliverer(unitOfWork, endpoint.Client(), services.NewRealTimeProvider()),
+	)
+
+	notifier := services.NewWebhookNotifier(unitOfWork, deliveries)
+	require.NoError(t, notifier.Handle(ctx, &domain.AnimalMovedEvent{Timestamp: time.Now()}))
+
+	var letters []*events.DeadLetter
+
+	require.Eventually(t, func() bool {
+		letters, err = deadLetters.GetAllDeadLetters(ctx)
+		return err == nil && len(letters) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, services.WebhookDeliveryEventName, letters[0].EventType)
+	assert.Equal(t, "services.WebhookDeliverer", letters[0].Handler)
+	assert.Equal(t, 2, letters[0].Attempts)
+	assert.Len(t, receiver.received(), 2)
+}
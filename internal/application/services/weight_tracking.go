@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+type WeightTrackingService interface {
+	// RecordWeight adds the weighing to the animal's weight record and analyses the trend as of the weighing.
+	// An abnormal trend is reported with an AnimalWeightAnomalyEvent.
+	RecordWeight(ctx context.Context, animalID domain.AnimalID, input WeightInput) (*domain.WeightMeasurement, *domain.WeightTrend, error)
+	// GetWeightHistory returns the animal's measurements taken within [from, to) and the trend as of to.
+	GetWeightHistory(ctx context.Context, animalID domain.AnimalID, from, to time.Time) ([]domain.WeightMeasurement, *domain.WeightTrend, error)
+}
+
+// WeightInput describes a weighing of an animal.
+type WeightInput struct {
+	// Weight is in kilograms.
+	Weight             float64
+	BodyConditionScore domain.BodyConditionScore
+	MeasuredBy         domain.ReporterName
+	// MeasuredAt is the time of the weighing, now if nil.
+	MeasuredAt *time.Time
+}
+
+type WeightTracking struct {
+	unitOfWork   domain.UnitOfWork
+	timeProvider TimeProvider
+}
+
+func NewWeightTracking(
+	unitOfWork domain.UnitOfWork,
+	timeProvider TimeProvider,
+) *WeightTracking {
+	return &WeightTracking{
+		unitOfWork:   unitOfWork,
+		timeProvider: timeProvider,
+	}
+}
+
+func (wt *WeightTracking) RecordWeight(
+	ctx context.Context,
+	animalID domain.AnimalID,
+	input WeightInput,
+) (*domain.WeightMeasurement, *domain.WeightTrend, error) {
+	var (
+		measurement domain.WeightMeasurement
+		trend       domain.WeightTrend
+	)
+
+	err := wt.unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		animal, err := repos.Animals().GetAnimal(ctx, animalID)
+		if err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
+
+		now := wt.timeProvider.Now()
+
+		measurement = domain.WeightMeasurement{
+			Weight:             input.Weight,
+			BodyConditionScore: input.BodyConditionScore,
+			MeasuredBy:         input.MeasuredBy,
+			MeasuredAt:         now,
+		}
+
+		if input.MeasuredAt != nil {
+			if input.MeasuredAt.After(now) {
+				return domain.ErrMeasurementInFuture
+			}
+
+			measurement.MeasuredAt = *input.MeasuredAt
+		}
+
+		record, isNew, err := wt.weightRecord(ctx, repos, animalID)
+		if err != nil {
+			return err
+		}
+
+		if err := record.AddMeasurement(measurement); err != nil {
+			return err
+		}
+
+		if err := wt.saveWeightRecord(ctx, repos, record, isNew); err != nil {
+			return err
+		}
+
+		catalog, err := domain.LoadSpeciesCatalog(ctx, repos.Species())
+		if err != nil {
+			return err
+		}
+
+		trend = catalog.AnalyzeWeight(animal, record, measurement.MeasuredAt)
+		if !trend.IsAbnormal() {
+			return nil
+		}
+
+		anomalyEvent := &domain.AnimalWeightAnomalyEvent{
+			AnimalID:           animal.ID,
+			AnimalName:         animal.Name,
+			AnimalSpecies:      animal.Species,
+			Weight:             measurement.Weight,
+			BodyConditionScore: measurement.BodyConditionScore,
+			Change:             trend.Change,
+			ChangePercent:      trend.ChangePercent,
+			Window:             trend.Window,
+			Anomalies:          trend.Anomalies,
+			MeasuredAt:         measurement.MeasuredAt,
+			Timestamp:          now,
+		}
+
+		if err := repos.Outbox().Record(ctx, anomalyEvent, now); err != nil {
+			return fmt.Errorf("recording animal weight anomaly event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &measurement, &trend, nil
+}
+
+func (wt *WeightTracking) GetWeightHistory(
+	ctx context.Context,
+	animalID domain.AnimalID,
+	from, to time.Time,
+) ([]domain.WeightMeasurement, *domain.WeightTrend, error) {
+	var (
+		measurements []domain.WeightMeasurement
+		trend        domain.WeightTrend
+	)
+
+	err := wt.unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		animal, err := repos.Animals().GetAnimal(ctx, animalID)
+		if err != nil {
+			return fmt.Errorf("getting animal: %w", err)
+		}
+
+		record, _, err := wt.weightRecord(ctx, repos, animalID)
+		if err != nil {
+			return err
+		}
+
+		catalog, err := domain.LoadSpeciesCatalog(ctx, repos.Species())
+		if err != nil {
+			return err
+		}
+
+		measurements = record.Between(from, to)
+		trend = catalog.AnalyzeWeight(animal, record, to)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return measurements, &trend, nil
+}
+
+// weightRecord returns the animal's weight record, opening a new one if it does not exist yet.
+func (wt *WeightTracking) weightRecord(
+	ctx context.Context,
+	repos domain.Repositories,
+	animalID domain.AnimalID,
+) (record *domain.WeightRecord, isNew bool, err error) {
+	record, err = repos.WeightRecords().GetWeightRecord(ctx, animalID)
+	if errors.Is(err, domain.ErrWeightRecordNotFound) {
+		return domain.NewWeightRecord(animalID, wt.timeProvider.Now()), true, nil
+	}
+
+	if err != nil {
+		return nil, false, fmt.Errorf("getting weight record: %w", err)
+	}
+
+	return record, false, nil
+}
+
+func (wt *WeightTracking) saveWeightRecord(
+	ctx context.Context,
+	repos domain.Repositories,
+	record *domain.WeightRecord,
+	isNew bool,
+) error {
+	if isNew {
+		if err := repos.WeightRecords().AddWeightRecord(ctx, record); err != nil {
+			return fmt.Errorf("adding weight record: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := repos.WeightRecords().UpdateWeightRecord(ctx, record); err != nil {
+		return fmt.Errorf("updating weight record: %w", err)
+	}
+
+	return nil
+}
@@ -1,15 +1,19 @@
 package domain
 
 import (
-	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 var (
-	ErrAnimalHealthy = errors.New("animal is already healthy")
-	ErrNilEnclosure  = errors.New("enclosure is nil")
+	ErrAnimalHealthy           = NewConflictError("animal_healthy", "animal is already healthy")
+	ErrInvalidStatusTransition = NewConflictError("invalid_status_transition", "animal status cannot be changed this way")
+	ErrIllnessNotReported      = NewInvariantError("illness_not_reported", "an animal becomes sick only through an illness report")
+	ErrUnknownAnimalStatus     = NewInvariantError("unknown_animal_status", "unknown animal status")
+	ErrNilEnclosure            = NewInvariantError("nil_enclosure", "enclosure is nil")
 )
 
 type (
@@ -30,11 +34,92 @@ func (aid AnimalID) UUID() uuid.UUID {
 	return uuid.UUID(aid)
 }
 
+func (aid AnimalID) MarshalText() ([]byte, error) {
+	return uuid.UUID(aid).MarshalText()
+}
+
+func (aid *AnimalID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(aid).UnmarshalText(data)
+}
+
+// The values are persisted, new statuses must be appended.
 const (
 	AnimalStatusHealthy AnimalStatus = iota
 	AnimalStatusSick
+	AnimalStatusUnderObservation
+	AnimalStatusQuarantined
+	AnimalStatusRecovering
 )
 
+var animalStatusNames = map[AnimalStatus]string{
+	AnimalStatusHealthy:          "Healthy",
+	AnimalStatusSick:             "Sick",
+	AnimalStatusUnderObservation: "UnderObservation",
+	AnimalStatusQuarantined:      "Quarantined",
+	AnimalStatusRecovering:       "Recovering",
+}
+
+// animalStatusTransitions lists the statuses every status can be changed to.
+var animalStatusTransitions = map[AnimalStatus][]AnimalStatus{
+	AnimalStatusHealthy:          {AnimalStatusUnderObservation, AnimalStatusSick},
+	AnimalStatusUnderObservation: {AnimalStatusHealthy, AnimalStatusSick, AnimalStatusQuarantined},
+	AnimalStatusSick:             {AnimalStatusHealthy, AnimalStatusRecovering, AnimalStatusQuarantined},
+	AnimalStatusQuarantined:      {AnimalStatusHealthy, AnimalStatusUnderObservation, AnimalStatusRecovering},
+	AnimalStatusRecovering:       {AnimalStatusHealthy, AnimalStatusSick, AnimalStatusUnderObservation},
+}
+
+func ParseAnimalStatus(s string) (AnimalStatus, error) {
+	for status, name := range animalStatusNames {
+		if name == s {
+			return status, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnknownAnimalStatus, s)
+}
+
+func (as AnimalStatus) String() string {
+	if name, ok := animalStatusNames[as]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("AnimalStatus(%d)", int(as))
+}
+
+func (as AnimalStatus) MarshalText() ([]byte, error) {
+	return []byte(as.String()), nil
+}
+
+func (as *AnimalStatus) UnmarshalText(data []byte) error {
+	status, err := ParseAnimalStatus(string(data))
+	if err != nil {
+		return err
+	}
+
+	*as = status
+
+	return nil
+}
+
+// CanTransitionTo reports whether an animal with this status can be given the target status.
+func (as AnimalStatus) CanTransitionTo(target AnimalStatus) bool {
+	return slices.Contains(animalStatusTransitions[as], target)
+}
+
+// sickStatuses are the statuses of animals counted as sick in the zoo statistics.
+var sickStatuses = []AnimalStatus{AnimalStatusSick, AnimalStatusQuarantined, AnimalStatusRecovering}
+
+// IsSick reports whether an animal with this status counts as sick in the zoo statistics:
+// an ill animal is counted until it is cured, also while it is quarantined or recovering.
+func (as AnimalStatus) IsSick() bool {
+	return slices.Contains(sickStatuses, as)
+}
+
+// SickStatuses returns every status IsSick reports.
+func SickStatuses() []AnimalStatus {
+	return slices.Clone(sickStatuses)
+}
+
 const (
 	Male   Gender = "Male"
 	Female Gender = "Female"
@@ -56,16 +141,112 @@ func (a *Animal) Feed(food Food) error {
 	return nil
 }
 
-func (a *Animal) Treat() error {
-	if a.Status != AnimalStatusSick {
+// Treat cures a sick animal. The description of the treatment is kept in the animal's MedicalRecord.
+// A quarantined animal is treated in isolation and stays quarantined until a vet releases it.
+func (a *Animal) Treat(description TreatmentDescription) error {
+	if description == "" {
+		return ErrEmptyTreatment
+	}
+
+	switch a.Status {
+	case AnimalStatusSick, AnimalStatusRecovering:
+		a.Status = AnimalStatusHealthy
+		return nil
+	case AnimalStatusQuarantined:
+		return nil
+	case AnimalStatusHealthy:
 		return ErrAnimalHealthy
+	default:
+		return a.invalidTransition(AnimalStatusHealthy)
+	}
+}
+
+// MarkSick records that the animal fell ill. The illness itself is kept in the animal's MedicalRecord.
+// A quarantined animal stays in quarantine, an animal that is already sick stays sick.
+func (a *Animal) MarkSick(illness Illness) error {
+	if err := illness.validate(); err != nil {
+		return err
+	}
+
+	if a.Status == AnimalStatusSick || a.Status == AnimalStatusQuarantined {
+		return nil
+	}
+
+	if !a.Status.CanTransitionTo(AnimalStatusSick) {
+		return a.invalidTransition(AnimalStatusSick)
+	}
+
+	a.Status = AnimalStatusSick
+
+	return nil
+}
+
+// ChangeStatus moves the animal to the target status if the transition is allowed.
+// Illnesses must be reported with MarkSick, quarantine goes through Quarantine and ReleaseFromQuarantine.
+func (a *Animal) ChangeStatus(target AnimalStatus) error {
+	if _, ok := animalStatusNames[target]; !ok {
+		return fmt.Errorf("%w: %d", ErrUnknownAnimalStatus, int(target))
+	}
+
+	if target == AnimalStatusSick {
+		return ErrIllnessNotReported
+	}
+
+	if a.Status == AnimalStatusQuarantined && target != AnimalStatusQuarantined {
+		return ErrAnimalQuarantined
+	}
+
+	if target == AnimalStatusQuarantined && a.Status != AnimalStatusQuarantined {
+		return fmt.Errorf("%w: quarantine the animal instead", a.invalidTransition(target))
+	}
+
+	if a.Status == target {
+		return nil
+	}
+
+	if !a.Status.CanTransitionTo(target) {
+		return a.invalidTransition(target)
 	}
 
-	a.Status = AnimalStatusHealthy
+	a.Status = target
 
 	return nil
 }
 
+// Quarantine isolates a sick or observed animal. It must be moved into a quarantine enclosure afterwards.
+func (a *Animal) Quarantine() error {
+	if a.Status == AnimalStatusQuarantined {
+		return ErrAnimalQuarantined
+	}
+
+	if !a.Status.CanTransitionTo(AnimalStatusQuarantined) {
+		return a.invalidTransition(AnimalStatusQuarantined)
+	}
+
+	a.Status = AnimalStatusQuarantined
+
+	return nil
+}
+
+// ReleaseFromQuarantine lifts the quarantine, giving the animal the status chosen by the vet.
+func (a *Animal) ReleaseFromQuarantine(status AnimalStatus) error {
+	if a.Status != AnimalStatusQuarantined {
+		return ErrAnimalNotQuarantined
+	}
+
+	if !a.Status.CanTransitionTo(status) {
+		return a.invalidTransition(status)
+	}
+
+	a.Status = status
+
+	return nil
+}
+
+func (a *Animal) invalidTransition(target AnimalStatus) error {
+	return fmt.Errorf("%w: from %s to %s", ErrInvalidStatusTransition, a.Status, target)
+}
+
 func (a *Animal) MoveToEnclosure(e *Enclosure) error {
 	if e == nil {
 		return ErrNilEnclosure
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnimalTreat(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  AnimalStatus
+		want    AnimalStatus
+		wantErr error
+	}{
+		{name: "sick animal is cured", status: AnimalStatusSick, want: AnimalStatusHealthy},
+		{name: "recovering animal is cured", status: AnimalStatusRecovering, want: AnimalStatusHealthy},
+		{name: "quarantined animal stays quarantined", status: AnimalStatusQuarantined, want: AnimalStatusQuarantined},
+		{name: "healthy animal", status: AnimalStatusHealthy, want: AnimalStatusHealthy, wantErr: ErrAnimalHealthy},
+		{
+			name:    "observed animal",
+			status:  AnimalStatusUnderObservation,
+			want:    AnimalStatusUnderObservation,
+			wantErr: ErrInvalidStatusTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			animal := &Animal{Status: tt.status}
+
+			err := animal.Treat("Antibiotics")
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, animal.Status)
+		})
+	}
+
+	animal := &Animal{Status: AnimalStatusSick}
+	require.ErrorIs(t, animal.Treat(""), ErrEmptyTreatment)
+	assert.Equal(t, AnimalStatusSick, animal.Status)
+}
+
+func TestAnimalStatusIsSick(t *testing.T) {
+	tests := []struct {
+		status AnimalStatus
+		want   bool
+	}{
+		{status: AnimalStatusHealthy, want: false},
+		{status: AnimalStatusUnderObservation, want: false},
+		{status: AnimalStatusSick, want: true},
+		{status: AnimalStatusQuarantined, want: true},
+		{status: AnimalStatusRecovering, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.status.IsSick())
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnknownCareTaskKind       = NewInvariantError("unknown_care_task_kind", "care task kind must be Cleaning or Checkup")
+	ErrUnknownCareTaskOccurrence = NewInvariantError("unknown_care_task_occurrence", "time is not an occurrence of the care task")
+	ErrCareTaskDone              = NewConflictError("care_task_done", "care task occurrence is already done")
+)
+
+type CareTaskID uuid.UUID
+
+func (id CareTaskID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func (id CareTaskID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id CareTaskID) MarshalText() ([]byte, error) {
+	return uuid.UUID(id).MarshalText()
+}
+
+func (id *CareTaskID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
+
+// CareTask is a cleaning of an enclosure or a checkup of its residents, done once at Time or,
+// with a Recurrence, repeatedly starting at Time.
+type CareTask struct {
+	ID          CareTaskID
+	Kind        TaskKind
+	EnclosureID EnclosureID
+	Time        time.Time
+	// Recurrence is nil for one-time tasks.
+	Recurrence *Recurrence
+	// Assignee is the keeper the task is assigned to, nil if it falls to the keeper on duty.
+	Assignee *KeeperID
+	// Completed are the occurrences that are done.
+	Completed []time.Time
+}
+
+func NewCareTask(kind TaskKind, enclosureID EnclosureID, t time.Time, recurrence *Recurrence) (*CareTask, error) {
+	if kind != TaskKindCleaning && kind != TaskKindCheckup {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCareTaskKind, kind)
+	}
+
+	return &CareTask{
+		ID:          CareTaskID(uuid.New()),
+		Kind:        kind,
+		EnclosureID: enclosureID,
+		Time:        t,
+		Recurrence:  recurrence,
+	}, nil
+}
+
+// IsRecurring reports whether the task repeats.
+func (ct *CareTask) IsRecurring() bool {
+	return ct.Recurrence != nil
+}
+
+// Occurrences returns the occurrences of the task within [from, to) in chronological order.
+func (ct *CareTask) Occurrences(from, to time.Time) []TaskOccurrence {
+	var occurrences []TaskOccurrence
+
+	for t := range ct.occurrenceTimes() {
+		if !t.Before(to) {
+			break
+		}
+
+		if t.Before(from) {
+			continue
+		}
+
+		status := TaskStatusPending
+		if containsTime(ct.Completed, t) {
+			status = TaskStatusDone
+		}
+
+		occurrences = append(occurrences, TaskOccurrence{Time: t, Status: status})
+	}
+
+	return occurrences
+}
+
+// CompleteOccurrence marks the occurrence of the task at t as done.
+func (ct *CareTask) CompleteOccurrence(t time.Time) error {
+	isOccurrence := false
+
+	for occurrence := range ct.occurrenceTimes() {
+		if !occurrence.Before(t) {
+			isOccurrence = occurrence.Equal(t)
+			break
+		}
+	}
+
+	if !isOccurrence {
+		return fmt.Errorf("%w: %s", ErrUnknownCareTaskOccurrence, t.Format(time.RFC3339))
+	}
+
+	if containsTime(ct.Completed, t) {
+		return fmt.Errorf("%w: %s", ErrCareTaskDone, t.Format(time.RFC3339))
+	}
+
+	ct.Completed = append(ct.Completed, t.UTC())
+
+	return nil
+}
+
+// Clone returns a deep copy of the task.
+func (ct *CareTask) Clone() *CareTask {
+	cloned := *ct
+	cloned.Recurrence = ct.Recurrence.Clone()
+	cloned.Completed = slices.Clone(ct.Completed)
+
+	if ct.Assignee != nil {
+		assignee := *ct.Assignee
+		cloned.Assignee = &assignee
+	}
+
+	return &cloned
+}
+
+func (ct *CareTask) occurrenceTimes() iter.Seq[time.Time] {
+	if ct.IsRecurring() {
+		return ct.Recurrence.Occurrences(ct.Time)
+	}
+
+	return func(yield func(time.Time) bool) {
+		yield(ct.Time)
+	}
+}
@@ -1,16 +1,16 @@
 package domain
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 )
 
 var (
-	ErrEnclosureFull        = errors.New("enclosure is full")
-	ErrAnimalInEnclosure    = errors.New("animal is already in enclosure")
-	ErrAnimalNotInEnclosure = errors.New("animal is not in enclosure")
+	ErrEnclosureFull        = NewConflictError("enclosure_full", "enclosure is full")
+	ErrAnimalInEnclosure    = NewConflictError("animal_in_enclosure", "animal is already in enclosure")
+	ErrAnimalNotInEnclosure = NewConflictError("animal_not_in_enclosure", "animal is not in enclosure")
+	ErrNotEnoughSpace       = NewConflictError("not_enough_space", "enclosure has not enough area left for the animal")
 )
 
 type (
@@ -27,6 +27,14 @@ func (eid EnclosureID) UUID() uuid.UUID {
 	return uuid.UUID(eid)
 }
 
+func (eid EnclosureID) MarshalText() ([]byte, error) {
+	return uuid.UUID(eid).MarshalText()
+}
+
+func (eid *EnclosureID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(eid).UnmarshalText(data)
+}
+
 // Value Object.
 type EnclosureOccupancy struct {
 	Capacity int
@@ -37,23 +45,32 @@ func (eo EnclosureOccupancy) CountAnimals() int {
 	return len(eo.Animals)
 }
 
-func (eo EnclosureOccupancy) AddAnimal(a *Animal) (newOccupancy EnclosureOccupancy, err error) {
-	if eo.CountAnimals() >= eo.Capacity {
-		return eo, ErrEnclosureFull
-	}
+func (eo EnclosureOccupancy) FreeSpace() int {
+	return max(eo.Capacity-eo.CountAnimals(), 0)
+}
 
-	if _, exists := eo.Animals[a]; exists {
-		return eo, ErrAnimalInEnclosure
+// UsedArea returns the area claimed by the residents: every animal claims the minimum area of its species.
+func (eo EnclosureOccupancy) UsedArea(catalog SpeciesCatalog) EnclosureSize {
+	var used EnclosureSize
+	for animal := range eo.Animals {
+		used += catalog.MinArea(animal.Species)
 	}
 
-	eo.Animals[a] = struct{}{}
+	return used
+}
+
+func (eo EnclosureOccupancy) residents() []*Animal {
+	residents := make([]*Animal, 0, len(eo.Animals))
+	for animal := range eo.Animals {
+		residents = append(residents, animal)
+	}
 
-	return EnclosureOccupancy{}, nil
+	return residents
 }
 
 func (eo EnclosureOccupancy) RemoveAnimal(a *Animal) (newOccupancy EnclosureOccupancy, err error) {
 	if _, exists := eo.Animals[a]; !exists {
-		return EnclosureOccupancy{}, ErrAnimalNotInEnclosure
+		return eo, ErrAnimalNotInEnclosure
 	}
 
 	delete(eo.Animals, a)
@@ -68,13 +85,69 @@ type Enclosure struct {
 	Occupancy EnclosureOccupancy
 }
 
-func (e *Enclosure) AddAnimal(a *Animal) error {
-	eo, err := e.Occupancy.AddAnimal(a)
-	if err != nil {
+// IsQuarantine reports whether the enclosure isolates quarantined animals.
+func (e *Enclosure) IsQuarantine() bool {
+	return e.Type == EnclosureTypeQuarantine
+}
+
+// RemainingArea returns the part of the enclosure size not claimed by the residents.
+func (e *Enclosure) RemainingArea(catalog SpeciesCatalog) EnclosureSize {
+	return max(e.Size-e.Occupancy.UsedArea(catalog), 0)
+}
+
+// RemainingSlots returns how many more animals of the species fit into the enclosure,
+// limited by both its capacity and its remaining area.
+func (e *Enclosure) RemainingSlots(species AnimalSpecies, catalog SpeciesCatalog) int {
+	slots := e.Occupancy.FreeSpace()
+
+	if minArea := catalog.MinArea(species); minArea > 0 {
+		slots = min(slots, int(e.RemainingArea(catalog)/minArea))
+	}
+
+	return slots
+}
+
+// CheckPlacement returns the reason the animal cannot be placed into the enclosure, nil if it can.
+// Quarantined animals are kept apart from the others: they can only be placed into quarantine
+// enclosures, and only they can. Other enclosures must suit the habitat of the species.
+// The enclosure must have room and area left for the animal, and the species catalog must let it live with the residents.
+func (e *Enclosure) CheckPlacement(a *Animal, catalog SpeciesCatalog) error {
+	quarantined := a.Status == AnimalStatusQuarantined
+
+	if quarantined && !e.IsQuarantine() {
+		return ErrAnimalQuarantined
+	}
+
+	if !quarantined && e.IsQuarantine() {
+		return ErrQuarantineEnclosureReserved
+	}
+
+	if err := catalog.CheckHabitat(a, e); err != nil {
+		return err
+	}
+
+	if _, exists := e.Occupancy.Animals[a]; exists {
+		return ErrAnimalInEnclosure
+	}
+
+	if e.Occupancy.FreeSpace() == 0 {
+		return ErrEnclosureFull
+	}
+
+	if minArea, remaining := catalog.MinArea(a.Species), e.RemainingArea(catalog); minArea > remaining {
+		return fmt.Errorf("%w: %s needs %d, %d left", ErrNotEnoughSpace, a.Species, minArea, remaining)
+	}
+
+	return catalog.CheckPlacement(a, e.Occupancy.residents())
+}
+
+// AddAnimal places the animal into the enclosure, enforcing the placement rules and those of the species catalog.
+func (e *Enclosure) AddAnimal(a *Animal, catalog SpeciesCatalog) error {
+	if err := e.CheckPlacement(a, catalog); err != nil {
 		return fmt.Errorf("could not add animal to enclosure: %w", err)
 	}
 
-	e.Occupancy = eo
+	e.Occupancy.Animals[a] = struct{}{}
 
 	return nil
 }
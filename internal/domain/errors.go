@@ -0,0 +1,73 @@
+package domain
+
+import "errors"
+
+// Error kinds. Every domain error wraps exactly one of them, so callers can branch
+// with errors.Is(err, ErrNotFound) without knowing the concrete error.
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrConflict           = errors.New("conflict")
+	ErrInvariantViolation = errors.New("invariant violation")
+)
+
+var (
+	ErrNilID = NewInvariantError("nil_id", "id cannot be nil")
+
+	ErrAnimalNotFound          = NewNotFoundError("animal_not_found", "animal not found")
+	ErrEnclosureNotFound       = NewNotFoundError("enclosure_not_found", "enclosure not found")
+	ErrFeedingScheduleNotFound = NewNotFoundError("feeding_schedule_not_found", "feeding schedule not found")
+	ErrWebhookNotFound         = NewNotFoundError("webhook_not_found", "webhook not found")
+	ErrMedicalRecordNotFound   = NewNotFoundError("medical_record_not_found", "medical record not found")
+	ErrQuarantineNotFound      = NewNotFoundError("quarantine_not_found", "quarantine not found")
+	ErrSpeciesNotFound         = NewNotFoundError("species_not_found", "species not found")
+	ErrFoodStockNotFound       = NewNotFoundError("food_stock_not_found", "food stock not found")
+	ErrWeightRecordNotFound    = NewNotFoundError("weight_record_not_found", "weight record not found")
+	ErrVetAppointmentNotFound  = NewNotFoundError("vet_appointment_not_found", "vet appointment not found")
+	ErrDrugNotFound            = NewNotFoundError("drug_not_found", "drug not found")
+	ErrKeeperNotFound          = NewNotFoundError("keeper_not_found", "keeper not found")
+	ErrShiftNotFound           = NewNotFoundError("shift_not_found", "shift not found")
+	ErrCareTaskNotFound        = NewNotFoundError("care_task_not_found", "care task not found")
+
+	ErrAnimalAlreadyExists          = NewConflictError("animal_already_exists", "animal already exists")
+	ErrEnclosureAlreadyExists       = NewConflictError("enclosure_already_exists", "enclosure already exists")
+	ErrFeedingScheduleAlreadyExists = NewConflictError("feeding_schedule_already_exists", "feeding schedule already exists")
+	ErrWebhookAlreadyExists         = NewConflictError("webhook_already_exists", "webhook already exists")
+	ErrMedicalRecordAlreadyExists   = NewConflictError("medical_record_already_exists", "medical record already exists")
+	ErrQuarantineAlreadyExists      = NewConflictError("quarantine_already_exists", "quarantine already exists")
+	ErrSpeciesAlreadyExists         = NewConflictError("species_already_exists", "species already exists")
+	ErrFoodStockAlreadyExists       = NewConflictError("food_stock_already_exists", "food stock already exists")
+	ErrWeightRecordAlreadyExists    = NewConflictError("weight_record_already_exists", "weight record already exists")
+	ErrVetAppointmentAlreadyExists  = NewConflictError("vet_appointment_already_exists", "vet appointment already exists")
+	ErrDrugAlreadyExists            = NewConflictError("drug_already_exists", "drug already exists")
+	ErrKeeperAlreadyExists          = NewConflictError("keeper_already_exists", "keeper already exists")
+	ErrShiftAlreadyExists           = NewConflictError("shift_already_exists", "shift already exists")
+	ErrCareTaskAlreadyExists        = NewConflictError("care_task_already_exists", "care task already exists")
+	ErrEnclosureNotEmpty            = NewConflictError("enclosure_not_empty", "enclosure contains animals")
+)
+
+// Error is a domain error carrying a stable machine-readable code.
+type Error struct {
+	Code    string
+	Message string
+	kind    error
+}
+
+func NewNotFoundError(code, message string) *Error {
+	return &Error{Code: code, Message: message, kind: ErrNotFound}
+}
+
+func NewConflictError(code, message string) *Error {
+	return &Error{Code: code, Message: message, kind: ErrConflict}
+}
+
+func NewInvariantError(code, message string) *Error {
+	return &Error{Code: code, Message: message, kind: ErrInvariantViolation}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.kind
+}
@@ -3,23 +3,95 @@ package domain
 import (
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/maklybae/ddd-zoo/pkg/events"
 )
 
+const (
+	AnimalMovedEventName   = "animal.moved"
+	FeedingTimeEventName   = "feeding.time"
+	AnimalTreatedEventName = "animal.treated"
+	AnimalFellIllEventName = "animal.fell_ill"
+
+	AnimalQuarantinedEventName       = "animal.quarantined"
+	AnimalQuarantineClearedEventName = "animal.quarantine_cleared"
+	AnimalsTransferredEventName      = "animals.transferred"
+	FoodDeliveredEventName           = "food.delivered"
+	FoodStockLowEventName            = "food.stock_low"
+	FoodShortageEventName            = "food.shortage"
+	AnimalWeightAnomalyEventName     = "animal.weight_anomaly"
+	DrugAdministeredEventName        = "animal.drug_administered"
+	CareTaskCompletedEventName       = "enclosure.care_task_completed"
+)
+
+// EventNames returns the names of all domain events.
+func EventNames() []string {
+	return []string{
+		AnimalMovedEventName,
+		FeedingTimeEventName,
+		AnimalTreatedEventName,
+		AnimalFellIllEventName,
+		AnimalQuarantinedEventName,
+		AnimalQuarantineClearedEventName,
+		AnimalsTransferredEventName,
+		FoodDeliveredEventName,
+		FoodStockLowEventName,
+		FoodShortageEventName,
+		AnimalWeightAnomalyEventName,
+		DrugAdministeredEventName,
+		CareTaskCompletedEventName,
+	}
+}
+
+// RegisterEvents makes all domain events decodable by the registry.
+func RegisterEvents(registry *events.Registry) {
+	registry.Register(AnimalMovedEventName, func() events.Event { return &AnimalMovedEvent{} })
+	registry.Register(FeedingTimeEventName, func() events.Event { return &FeedingTimeEvent{} })
+	registry.Register(AnimalTreatedEventName, func() events.Event { return &AnimalTreatedEvent{} })
+	registry.Register(AnimalFellIllEventName, func() events.Event { return &AnimalFellIllEvent{} })
+	registry.Register(AnimalQuarantinedEventName, func() events.Event { return &AnimalQuarantinedEvent{} })
+	registry.Register(AnimalQuarantineClearedEventName, func() events.Event { return &AnimalQuarantineClearedEvent{} })
+	registry.Register(AnimalsTransferredEventName, func() events.Event { return &AnimalsTransferredEvent{} })
+	registry.Register(FoodDeliveredEventName, func() events.Event { return &FoodDeliveredEvent{} })
+	registry.Register(FoodStockLowEventName, func() events.Event { return &FoodStockLowEvent{} })
+	registry.Register(FoodShortageEventName, func() events.Event { return &FoodShortageEvent{} })
+	registry.Register(AnimalWeightAnomalyEventName, func() events.Event { return &AnimalWeightAnomalyEvent{} })
+	registry.Register(DrugAdministeredEventName, func() events.Event { return &DrugAdministeredEvent{} })
+	registry.Register(CareTaskCompletedEventName, func() events.Event { return &CareTaskCompletedEvent{} })
+}
+
 // AnimalMovedEvent is triggered when an animal is moved to a new enclosure.
+// FromEnclosureID is the zero ID when the animal had no enclosure before.
 type AnimalMovedEvent struct {
-	AnimalID      AnimalID
-	AnimalName    AnimalName
-	AnimalSpecies AnimalSpecies
-	FromEnclosure *Enclosure
-	ToEnclosure   *Enclosure
-	Timestamp     time.Time
+	AnimalID        AnimalID
+	AnimalName      AnimalName
+	AnimalSpecies   AnimalSpecies
+	FromEnclosureID EnclosureID
+	ToEnclosureID   EnclosureID
+	Timestamp       time.Time
 }
 
-var _ events.Event = (*AnimalMovedEvent)(nil)
+var (
+	_ events.Event          = (*AnimalMovedEvent)(nil)
+	_ events.AggregateEvent = (*AnimalMovedEvent)(nil)
+	_ events.TimedEvent     = (*AnimalMovedEvent)(nil)
+)
 
 func (e *AnimalMovedEvent) Name() string {
-	return "animal.moved"
+	return AnimalMovedEventName
+}
+
+func (e *AnimalMovedEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e *AnimalMovedEvent) AggregateIDs() []string {
+	ids := []string{e.AnimalID.String(), e.ToEnclosureID.String()}
+	if e.FromEnclosureID != EnclosureID(uuid.Nil) {
+		ids = append(ids, e.FromEnclosureID.String())
+	}
+
+	return ids
 }
 
 // FeedingTimeEvent is triggered when it's time to feed an animal.
@@ -29,12 +101,376 @@ type FeedingTimeEvent struct {
 	AnimalName    AnimalName
 	AnimalSpecies AnimalSpecies
 	Food          Food
-	FeedingTime   time.Time
-	Timestamp     time.Time
+	// Portion is the amount of food taken from the inventory, zero if it is not tracked.
+	Portion     FoodQuantity
+	FeedingTime time.Time
+	// FedBy is the keeper who completed the feeding, nil for feedings run by the scheduler.
+	FedBy     *KeeperID
+	Timestamp time.Time
 }
 
-var _ events.Event = (*FeedingTimeEvent)(nil)
+var (
+	_ events.Event          = (*FeedingTimeEvent)(nil)
+	_ events.AggregateEvent = (*FeedingTimeEvent)(nil)
+	_ events.TimedEvent     = (*FeedingTimeEvent)(nil)
+)
 
 func (e *FeedingTimeEvent) Name() string {
-	return "feeding.time"
+	return FeedingTimeEventName
+}
+
+func (e *FeedingTimeEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e *FeedingTimeEvent) AggregateIDs() []string {
+	ids := []string{e.ScheduleID.String(), e.AnimalID.String()}
+	if e.FedBy != nil {
+		ids = append(ids, e.FedBy.String())
+	}
+
+	return ids
+}
+
+// AnimalTreatedEvent is triggered when a sick animal is treated.
+type AnimalTreatedEvent struct {
+	AnimalID      AnimalID
+	AnimalName    AnimalName
+	AnimalSpecies AnimalSpecies
+	TreatmentID   TreatmentID
+	Description   TreatmentDescription
+	Vet           VetName
+	Timestamp     time.Time
+}
+
+var (
+	_ events.Event          = (*AnimalTreatedEvent)(nil)
+	_ events.AggregateEvent = (*AnimalTreatedEvent)(nil)
+	_ events.TimedEvent     = (*AnimalTreatedEvent)(nil)
+)
+
+func (e *AnimalTreatedEvent) Name() string {
+	return AnimalTreatedEventName
+}
+
+func (e *AnimalTreatedEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e *AnimalTreatedEvent) AggregateIDs() []string {
+	return []string{e.AnimalID.String()}
+}
+
+// AnimalFellIllEvent is triggered when an illness is reported for an animal.
+type AnimalFellIllEvent struct {
+	AnimalID       AnimalID
+	AnimalName     AnimalName
+	AnimalSpecies  AnimalSpecies
+	IllnessID      IllnessID
+	Diagnosis      DiagnosisDescription
+	Severity       IllnessSeverity
+	ReportedBy     ReporterName
+	PreviousStatus AnimalStatus
+	Status         AnimalStatus
+	Timestamp      time.Time
+}
+
+var (
+	_ events.Event          = (*AnimalFellIllEvent)(nil)
+	_ events.AggregateEvent = (*AnimalFellIllEvent)(nil)
+	_ events.TimedEvent     = (*AnimalFellIllEvent)(nil)
+)
+
+func (e *AnimalFellIllEvent) Name() string {
+	return AnimalFellIllEventName
+}
+
+func (e *AnimalFellIllEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e *AnimalFellIllEvent) AggregateIDs() []string {
+	return []string{e.AnimalID.String()}
+}
+
+// AnimalQuarantinedEvent is triggered when an animal is moved into quarantine.
+// FromEnclosureID is the zero ID when the animal had no enclosure before.
+type AnimalQuarantinedEvent struct {
+	QuarantineID    QuarantineID
+	AnimalID        AnimalID
+	AnimalName      AnimalName
+	AnimalSpecies   AnimalSpecies
+	EnclosureID     EnclosureID
+	FromEnclosureID EnclosureID
+	Reason          QuarantineReason
+	OrderedBy       VetName
+	Timestamp       time.Time
+}
+
+var (
+	_ events.Event          = (*AnimalQuarantinedEvent)(nil)
+	_ events.AggregateEvent = (*AnimalQuarantinedEvent)(nil)
+	_ events.TimedEvent     = (*AnimalQuarantinedEvent)(nil)
+)
+
+func (e *AnimalQuarantinedEvent) Name() string {
+	return AnimalQuarantinedEventName
+}
+
+func (e *AnimalQuarantinedEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e *AnimalQuarantinedEvent) AggregateIDs() []string {
+	return []string{e.QuarantineID.String(), e.AnimalID.String(), e.EnclosureID.String()}
+}
+
+// AnimalQuarantineClearedEvent is triggered when a vet clears an animal's quarantine.
+type AnimalQuarantineClearedEvent struct {
+	QuarantineID  QuarantineID
+	AnimalID      AnimalID
+	AnimalName    AnimalName
+	AnimalSpecies AnimalSpecies
+	ClearedBy     VetName
+	Status        AnimalStatus
+	StartedAt     time.Time
+	Timestamp     time.Time
+}
+
+var (
+	_ events.Event          = (*AnimalQuarantineClearedEvent)(nil)
+	_ events.AggregateEvent = (*AnimalQuarantineClearedEvent)(nil)
+	_ events.TimedEvent     = (*AnimalQuarantineClearedEvent)(nil)
+)
+
+func (e *AnimalQuarantineClearedEvent) Name() string {
+	return AnimalQuarantineClearedEventName
+}
+
+func (e *AnimalQuarantineClearedEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e *AnimalQuarantineClearedEvent) AggregateIDs() []string {
+	return []string{e.QuarantineID.String(), e.AnimalID.String()}
+}
+
+// AnimalsTransferredEvent summarises a batch transfer. Every transferred animal also gets its own AnimalMovedEvent.
+type AnimalsTransferredEvent struct {
+	BatchID   TransferBatchID
+	Transfers []TransferRecord
+	Timestamp time.Time
+}
+
+var (
+	_ events.Event          = (*AnimalsTransferredEvent)(nil)
+	_ events.AggregateEvent = (*AnimalsTransferredEvent)(nil)
+	_ events.TimedEvent     = (*AnimalsTransferredEvent)(nil)
+)
+
+func (e *AnimalsTransferredEvent) Name() string {
+	return AnimalsTransferredEventName
+}
+
+func (e *AnimalsTransferredEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e *AnimalsTransferredEvent) AggregateIDs() []string {
+	ids := []string{e.BatchID.String()}
+	seen := make(map[string]struct{})
+
+	add := func(id string) {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+
+	for _, transfer := range e.Transfers {
+		add(transfer.AnimalID.String())
+		add(transfer.ToEnclosureID.String())
+
+		if transfer.FromEnclosureID != EnclosureID(uuid.Nil) {
+			add(transfer.FromEnclosureID.String())
+		}
+	}
+
+	return ids
+}
+
+// FoodDeliveredEvent is triggered when food is delivered to the inventory.
+type FoodDeliveredEvent struct {
+	Food      Food
+	Delivered FoodQuantity
+	InStock   FoodQuantity
+	Timestamp time.Time
+}
+
+var (
+	_ events.Event          = (*FoodDeliveredEvent)(nil)
+	_ events.AggregateEvent = (*FoodDeliveredEvent)(nil)
+	_ events.TimedEvent     = (*FoodDeliveredEvent)(nil)
+)
+
+func (e *FoodDeliveredEvent) Name() string {
+	return FoodDeliveredEventName
+}
+
+func (e *FoodDeliveredEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e *FoodDeliveredEvent) AggregateIDs() []string {
+	return []string{string(e.Food)}
+}
+
+// FoodStockLowEvent is triggered when feedings bring a food stock down to its low stock threshold.
+type FoodStockLowEvent struct {
+	Food              Food
+	InStock           FoodQuantity
+	LowStockThreshold float64
+	Timestamp         time.Time
+}
+
+var (
+	_ events.Event          = (*FoodStockLowEvent)(nil)
+	_ events.AggregateEvent = (*FoodStockLowEvent)(nil)
+	_ events.TimedEvent     = (*FoodStockLowEvent)(nil)
+)
+
+func (e *FoodStockLowEvent) Name() string {
+	return FoodStockLowEventName
+}
+
+func (e *FoodStockLowEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e *FoodStockLowEvent) AggregateIDs() []string {
+	return []string{string(e.Food)}
+}
+
+// FoodShortageEvent is triggered when a feeding cannot take place because the food ran out.
+// It is triggered once per shortage, the next one after a delivery.
+type FoodShortageEvent struct {
+	Food       Food
+	Required   FoodQuantity
+	InStock    FoodQuantity
+	ScheduleID FeedingScheduleID
+	AnimalID   AnimalID
+	Timestamp  time.Time
+}
+
+var (
+	_ events.Event          = (*FoodShortageEvent)(nil)
+	_ events.AggregateEvent = (*FoodShortageEvent)(nil)
+	_ events.TimedEvent     = (*FoodShortageEvent)(nil)
+)
+
+func (e *FoodShortageEvent) Name() string {
+	return FoodShortageEventName
+}
+
+func (e *FoodShortageEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e *FoodShortageEvent) AggregateIDs() []string {
+	return []string{string(e.Food), e.ScheduleID.String(), e.AnimalID.String()}
+}
+
+// AnimalWeightAnomalyEvent is triggered when a weighing shows an abnormal weight change within
+// the rolling window, a weight outside the norms of the species or a poor body condition.
+type AnimalWeightAnomalyEvent struct {
+	AnimalID           AnimalID
+	AnimalName         AnimalName
+	AnimalSpecies      AnimalSpecies
+	Weight             float64
+	BodyConditionScore BodyConditionScore
+	Change             float64
+	ChangePercent      float64
+	Window             time.Duration
+	Anomalies          []WeightAnomaly
+	MeasuredAt         time.Time
+	Timestamp          time.Time
+}
+
+var (
+	_ events.Event          = (*AnimalWeightAnomalyEvent)(nil)
+	_ events.AggregateEvent = (*AnimalWeightAnomalyEvent)(nil)
+	_ events.TimedEvent     = (*AnimalWeightAnomalyEvent)(nil)
+)
+
+func (e *AnimalWeightAnomalyEvent) Name() string {
+	return AnimalWeightAnomalyEventName
+}
+
+func (e *AnimalWeightAnomalyEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e *AnimalWeightAnomalyEvent) AggregateIDs() []string {
+	return []string{e.AnimalID.String()}
+}
+
+// DrugAdministeredEvent is triggered when a dose of a drug is given to an animal as part of a treatment.
+type DrugAdministeredEvent struct {
+	AnimalID         AnimalID
+	AnimalName       AnimalName
+	AnimalSpecies    AnimalSpecies
+	AdministrationID DrugAdministrationID
+	TreatmentID      TreatmentID
+	Drug             DrugName
+	DoseMg           float64
+	AdministeredBy   ReporterName
+	AdministeredAt   time.Time
+	Timestamp        time.Time
+}
+
+var (
+	_ events.Event          = (*DrugAdministeredEvent)(nil)
+	_ events.AggregateEvent = (*DrugAdministeredEvent)(nil)
+	_ events.TimedEvent     = (*DrugAdministeredEvent)(nil)
+)
+
+func (e *DrugAdministeredEvent) Name() string {
+	return DrugAdministeredEventName
+}
+
+func (e *DrugAdministeredEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e *DrugAdministeredEvent) AggregateIDs() []string {
+	return []string{e.AnimalID.String()}
+}
+
+// CareTaskCompletedEvent is triggered when a keeper completes a cleaning or a checkup of an enclosure.
+type CareTaskCompletedEvent struct {
+	TaskID      CareTaskID
+	Kind        TaskKind
+	EnclosureID EnclosureID
+	KeeperID    KeeperID
+	// ScheduledAt is the occurrence of the task that was completed.
+	ScheduledAt time.Time
+	Timestamp   time.Time
+}
+
+var (
+	_ events.Event          = (*CareTaskCompletedEvent)(nil)
+	_ events.AggregateEvent = (*CareTaskCompletedEvent)(nil)
+	_ events.TimedEvent     = (*CareTaskCompletedEvent)(nil)
+)
+
+func (e *CareTaskCompletedEvent) Name() string {
+	return CareTaskCompletedEventName
+}
+
+func (e *CareTaskCompletedEvent) OccurredAt() time.Time {
+	return e.Timestamp
+}
+
+func (e *CareTaskCompletedEvent) AggregateIDs() []string {
+	return []string{e.TaskID.String(), e.EnclosureID.String(), e.KeeperID.String()}
 }
@@ -1,15 +1,21 @@
 package domain
 
 import (
-	"errors"
 	"fmt"
+	"iter"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 var (
-	ErrFeedingStatusIsDone = errors.New("feeding status is already done")
+	ErrFeedingStatusIsDone      = NewConflictError("feeding_already_done", "feeding status is already done")
+	ErrFeedingSkipped           = NewConflictError("feeding_skipped", "feeding occurrence is skipped")
+	ErrFeedingSettled           = NewConflictError("feeding_settled", "feeding occurrence is before the last completed one")
+	ErrNoDueFeeding             = NewConflictError("no_due_feeding", "feeding schedule has no due occurrence")
+	ErrUnknownFeedingOccurrence = NewInvariantError("unknown_feeding_occurrence", "time is not an occurrence of the feeding schedule")
+	ErrNotRecurringFeeding      = NewInvariantError("not_recurring_feeding", "only occurrences of recurring feeding schedules can be skipped")
 )
 
 type (
@@ -26,6 +32,14 @@ func (fsid FeedingScheduleID) UUID() uuid.UUID {
 	return uuid.UUID(fsid)
 }
 
+func (fsid FeedingScheduleID) MarshalText() ([]byte, error) {
+	return uuid.UUID(fsid).MarshalText()
+}
+
+func (fsid *FeedingScheduleID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(fsid).UnmarshalText(data)
+}
+
 const (
 	FeedingStatusDone    FeedingStatus = true
 	FeedingStatusNotDone FeedingStatus = false
@@ -45,12 +59,49 @@ func (fs FeedingStatus) Done() (newFeedingStatus FeedingStatus, err error) {
 	return fs, nil
 }
 
+type FeedingOccurrenceStatus string
+
+const (
+	FeedingOccurrenceStatusPending FeedingOccurrenceStatus = "Pending"
+	FeedingOccurrenceStatusDone    FeedingOccurrenceStatus = "Done"
+	FeedingOccurrenceStatusSkipped FeedingOccurrenceStatus = "Skipped"
+	FeedingOccurrenceStatusMissed  FeedingOccurrenceStatus = "Missed"
+)
+
+// Value Object. FeedingOccurrence is a single feeding a schedule produces.
+type FeedingOccurrence struct {
+	Time   time.Time
+	Status FeedingOccurrenceStatus
+}
+
+// FeedingSchedule is a one-time feeding at Time or, with a Recurrence, a template of feedings
+// starting at Time. Occurrences of a recurring schedule are completed and skipped one by one,
+// and completing one settles the earlier ones: those still pending are missed.
+// Its Status becomes done once a finite rule has no pending occurrences left.
 type FeedingSchedule struct {
 	ID     FeedingScheduleID
 	Animal *Animal
 	Food   Food
 	Time   FeedingScheduleTime
 	Status FeedingStatus
+	// Portion is the amount of food taken from the inventory by every feeding, zero if it is not tracked.
+	Portion FoodQuantity
+	// Recurrence is nil for one-time schedules.
+	Recurrence *Recurrence
+	// FedThrough is the last completed occurrence of a recurring schedule, zero if none is.
+	FedThrough time.Time
+	// Skipped are the skipped occurrences after FedThrough in chronological order.
+	Skipped []time.Time
+	// History holds the completed and skipped occurrences before FedThrough the repository loaded
+	// for a time range, in chronological order. The other occurrences before FedThrough were missed.
+	History []FeedingOccurrence
+	// Assignee is the keeper the feedings are assigned to, nil if they fall to the keeper on duty.
+	Assignee *KeeperID
+}
+
+// IsRecurring reports whether the schedule repeats.
+func (fs *FeedingSchedule) IsRecurring() bool {
+	return fs.Recurrence != nil
 }
 
 func (fs *FeedingSchedule) ChangeTime(newTime FeedingScheduleTime) error {
@@ -70,5 +121,240 @@ func (fs *FeedingSchedule) Done() error {
 }
 
 func (fs *FeedingSchedule) IsReady(now time.Time) bool {
+	if fs.IsRecurring() {
+		next, ok := fs.NextOccurrence()
+		return ok && next.Before(now)
+	}
+
 	return fs.Status == FeedingStatusNotDone && fs.Time.IsReady(now)
 }
+
+// Occurrences returns the feedings of the schedule within [from, to) in chronological order.
+func (fs *FeedingSchedule) Occurrences(from, to time.Time) []FeedingOccurrence {
+	var occurrences []FeedingOccurrence
+
+	for t := range fs.occurrenceTimes(from) {
+		if !t.Before(to) {
+			break
+		}
+
+		occurrences = append(occurrences, FeedingOccurrence{Time: t, Status: fs.occurrenceStatus(t)})
+	}
+
+	return occurrences
+}
+
+// DueOccurrences returns the pending feedings scheduled before now, the earliest first.
+func (fs *FeedingSchedule) DueOccurrences(now time.Time) []time.Time {
+	var due []time.Time
+
+	for t := range fs.pendingOccurrences() {
+		if !t.Before(now) {
+			break
+		}
+
+		due = append(due, t)
+	}
+
+	return due
+}
+
+// NextOccurrence returns the earliest pending feeding, false if there is none left.
+func (fs *FeedingSchedule) NextOccurrence() (time.Time, bool) {
+	for t := range fs.pendingOccurrences() {
+		return t, true
+	}
+
+	return time.Time{}, false
+}
+
+// End returns a moment no feeding of the schedule happens after, false if the schedule repeats forever.
+func (fs *FeedingSchedule) End() (time.Time, bool) {
+	switch {
+	case !fs.IsRecurring():
+		return time.Time(fs.Time), true
+	case !fs.Recurrence.Until.IsZero():
+		return fs.Recurrence.Until, true
+	case fs.Recurrence.Count > 0:
+		var last time.Time
+		for t := range fs.occurrenceTimes(time.Time(fs.Time)) {
+			last = t
+		}
+
+		return last, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// Complete marks the earliest due feeding as done. One-time schedules are done as a whole.
+func (fs *FeedingSchedule) Complete(now time.Time) error {
+	if !fs.IsRecurring() {
+		return fs.Done()
+	}
+
+	due := fs.DueOccurrences(now)
+	if len(due) == 0 {
+		return ErrNoDueFeeding
+	}
+
+	return fs.CompleteOccurrence(due[0])
+}
+
+// CompleteOccurrence marks the feeding scheduled at t as done. The pending feedings before it are missed.
+func (fs *FeedingSchedule) CompleteOccurrence(t time.Time) error {
+	if !fs.IsRecurring() {
+		if !t.Equal(time.Time(fs.Time)) {
+			return fmt.Errorf("%w: %s", ErrUnknownFeedingOccurrence, t.Format(time.RFC3339))
+		}
+
+		return fs.Done()
+	}
+
+	if err := fs.checkPending(t); err != nil {
+		return fmt.Errorf("completing feeding: %w", err)
+	}
+
+	fs.FedThrough = t.UTC()
+	fs.Skipped = slices.DeleteFunc(fs.Skipped, func(skipped time.Time) bool { return !skipped.After(t) })
+	fs.updateStatus()
+
+	return nil
+}
+
+// SkipOccurrence cancels the feeding scheduled at t, like an EXDATE of RFC 5545.
+// One-time schedules are deleted instead of being skipped.
+func (fs *FeedingSchedule) SkipOccurrence(t time.Time) error {
+	if !fs.IsRecurring() {
+		return ErrNotRecurringFeeding
+	}
+
+	if err := fs.checkPending(t); err != nil {
+		return fmt.Errorf("skipping feeding: %w", err)
+	}
+
+	i, _ := slices.BinarySearchFunc(fs.Skipped, t, time.Time.Compare)
+	fs.Skipped = slices.Insert(fs.Skipped, i, t.UTC())
+	fs.updateStatus()
+
+	return nil
+}
+
+// Clone returns a copy of the schedule that shares the animal.
+func (fs *FeedingSchedule) Clone() *FeedingSchedule {
+	cloned := *fs
+	cloned.Recurrence = fs.Recurrence.Clone()
+	cloned.Skipped = slices.Clone(fs.Skipped)
+	cloned.History = slices.Clone(fs.History)
+
+	if fs.Assignee != nil {
+		assignee := *fs.Assignee
+		cloned.Assignee = &assignee
+	}
+
+	return &cloned
+}
+
+// occurrenceTimes yields the feedings of the schedule not before from in chronological order.
+func (fs *FeedingSchedule) occurrenceTimes(from time.Time) iter.Seq[time.Time] {
+	if fs.IsRecurring() {
+		return fs.Recurrence.OccurrencesFrom(time.Time(fs.Time), from)
+	}
+
+	return func(yield func(time.Time) bool) {
+		if !time.Time(fs.Time).Before(from) {
+			yield(time.Time(fs.Time))
+		}
+	}
+}
+
+// pendingOccurrences yields the pending feedings of the schedule in chronological order.
+// Only the occurrences after FedThrough can be pending, so the earlier ones are not walked.
+func (fs *FeedingSchedule) pendingOccurrences() iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		for t := range fs.occurrenceTimes(fs.FedThrough) {
+			if fs.occurrenceStatus(t) == FeedingOccurrenceStatusPending && !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+func (fs *FeedingSchedule) occurrenceStatus(t time.Time) FeedingOccurrenceStatus {
+	if !fs.IsRecurring() {
+		if fs.Status == FeedingStatusDone {
+			return FeedingOccurrenceStatusDone
+		}
+
+		return FeedingOccurrenceStatusPending
+	}
+
+	switch {
+	case t.Equal(fs.FedThrough):
+		return FeedingOccurrenceStatusDone
+	case t.Before(fs.FedThrough):
+		if i, found := slices.BinarySearchFunc(fs.History, t, func(o FeedingOccurrence, t time.Time) int {
+			return o.Time.Compare(t)
+		}); found {
+			return fs.History[i].Status
+		}
+
+		return FeedingOccurrenceStatusMissed
+	case slices.ContainsFunc(fs.Skipped, t.Equal):
+		return FeedingOccurrenceStatusSkipped
+	default:
+		return FeedingOccurrenceStatusPending
+	}
+}
+
+func (fs *FeedingSchedule) checkPending(t time.Time) error {
+	isOccurrence := false
+
+	for occurrence := range fs.occurrenceTimes(t) {
+		isOccurrence = occurrence.Equal(t)
+		break
+	}
+
+	if !isOccurrence {
+		return fmt.Errorf("%w: %s", ErrUnknownFeedingOccurrence, t.Format(time.RFC3339))
+	}
+
+	switch {
+	case t.Equal(fs.FedThrough):
+		return ErrFeedingStatusIsDone
+	case t.Before(fs.FedThrough):
+		return fmt.Errorf("%w: %s", ErrFeedingSettled, fs.FedThrough.Format(time.RFC3339))
+	case fs.occurrenceStatus(t) == FeedingOccurrenceStatusSkipped:
+		return ErrFeedingSkipped
+	default:
+		return nil
+	}
+}
+
+// updateStatus marks a finite recurring schedule as done once none of its occurrences is pending.
+func (fs *FeedingSchedule) updateStatus() {
+	if !fs.Recurrence.IsFinite() {
+		return
+	}
+
+	if _, ok := fs.NextOccurrence(); !ok {
+		fs.Status = FeedingStatusDone
+	}
+}
+
+func containsTime(times []time.Time, t time.Time) bool {
+	return slices.ContainsFunc(times, t.Equal)
+}
+
+// CountFeedingOccurrences counts the occurrences of the schedules within [from, to) by status.
+func CountFeedingOccurrences(schedules []*FeedingSchedule, from, to time.Time) map[FeedingOccurrenceStatus]int {
+	counts := make(map[FeedingOccurrenceStatus]int)
+
+	for _, schedule := range schedules {
+		for _, occurrence := range schedule.Occurrences(from, to) {
+			counts[occurrence.Status]++
+		}
+	}
+
+	return counts
+}
@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRecurringSchedule(t *testing.T, rule string) *FeedingSchedule {
+	t.Helper()
+
+	recurrence, err := ParseRecurrence(rule, "")
+	require.NoError(t, err)
+
+	return &FeedingSchedule{
+		Food:       "Meat",
+		Time:       FeedingScheduleTime(mustParseTime(t, "2025-01-01T10:00:00Z")),
+		Status:     FeedingStatusNotDone,
+		Recurrence: recurrence,
+	}
+}
+
+// day returns the time of the daily feeding on the given day of January 2025.
+func day(n int) time.Time {
+	return time.Date(2025, time.January, n, 10, 0, 0, 0, time.UTC)
+}
+
+type feedingAction struct {
+	skip    bool
+	at      time.Time
+	wantErr error
+}
+
+func TestFeedingScheduleOccurrenceStatuses(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		history []FeedingOccurrence
+		actions []feedingAction
+		// want are the statuses of the feedings on January 1 to 5
+		want       []FeedingOccurrenceStatus
+		wantStatus FeedingStatus
+	}{
+		{
+			name: "completing a feeding misses the pending ones before it",
+			rule: "FREQ=DAILY",
+			actions: []feedingAction{
+				{at: day(3)},
+			},
+			want: []FeedingOccurrenceStatus{
+				FeedingOccurrenceStatusMissed, FeedingOccurrenceStatusMissed, FeedingOccurrenceStatusDone,
+				FeedingOccurrenceStatusPending, FeedingOccurrenceStatusPending,
+			},
+			wantStatus: FeedingStatusNotDone,
+		},
+		{
+			name:    "history keeps the statuses before FedThrough",
+			rule:    "FREQ=DAILY",
+			history: []FeedingOccurrence{{Time: day(1), Status: FeedingOccurrenceStatusDone}, {Time: day(2), Status: FeedingOccurrenceStatusSkipped}},
+			actions: []feedingAction{
+				{at: day(3)},
+			},
+			want: []FeedingOccurrenceStatus{
+				FeedingOccurrenceStatusDone, FeedingOccurrenceStatusSkipped, FeedingOccurrenceStatusDone,
+				FeedingOccurrenceStatusPending, FeedingOccurrenceStatusPending,
+			},
+			wantStatus: FeedingStatusNotDone,
+		},
+		{
+			name: "skipped feedings after FedThrough stay skipped",
+			rule: "FREQ=DAILY",
+			actions: []feedingAction{
+				{at: day(1)},
+				{skip: true, at: day(3)},
+				{skip: true, at: day(2)},
+			},
+			want: []FeedingOccurrenceStatus{
+				FeedingOccurrenceStatusDone, FeedingOccurrenceStatusSkipped, FeedingOccurrenceStatusSkipped,
+				FeedingOccurrenceStatusPending, FeedingOccurrenceStatusPending,
+			},
+			wantStatus: FeedingStatusNotDone,
+		},
+		{
+			name: "completing a later feeding settles the skipped ones",
+			rule: "FREQ=DAILY",
+			actions: []feedingAction{
+				{skip: true, at: day(2)},
+				{at: day(4)},
+			},
+			want: []FeedingOccurrenceStatus{
+				FeedingOccurrenceStatusMissed, FeedingOccurrenceStatusMissed, FeedingOccurrenceStatusMissed,
+				FeedingOccurrenceStatusDone, FeedingOccurrenceStatusPending,
+			},
+			wantStatus: FeedingStatusNotDone,
+		},
+		{
+			name: "settled, done and skipped feedings cannot change",
+			rule: "FREQ=DAILY",
+			actions: []feedingAction{
+				{at: day(2)},
+				{skip: true, at: day(4)},
+				{at: day(1), wantErr: ErrFeedingSettled},
+				{skip: true, at: day(1), wantErr: ErrFeedingSettled},
+				{at: day(2), wantErr: ErrFeedingStatusIsDone},
+				{skip: true, at: day(2), wantErr: ErrFeedingStatusIsDone},
+				{at: day(4), wantErr: ErrFeedingSkipped},
+				{skip: true, at: day(4), wantErr: ErrFeedingSkipped},
+			},
+			want: []FeedingOccurrenceStatus{
+				FeedingOccurrenceStatusMissed, FeedingOccurrenceStatusDone, FeedingOccurrenceStatusPending,
+				FeedingOccurrenceStatusSkipped, FeedingOccurrenceStatusPending,
+			},
+			wantStatus: FeedingStatusNotDone,
+		},
+		{
+			name: "times that are not occurrences are rejected",
+			rule: "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			actions: []feedingAction{
+				{at: day(4), wantErr: ErrUnknownFeedingOccurrence}, // Saturday
+				{at: day(2).Add(time.Hour), wantErr: ErrUnknownFeedingOccurrence},
+				{skip: true, at: day(1).Add(-24 * time.Hour), wantErr: ErrUnknownFeedingOccurrence},
+			},
+			want: []FeedingOccurrenceStatus{
+				FeedingOccurrenceStatusPending, FeedingOccurrenceStatusPending, FeedingOccurrenceStatusPending,
+			},
+			wantStatus: FeedingStatusNotDone,
+		},
+		{
+			name: "finite schedule is done once nothing is pending",
+			rule: "FREQ=DAILY;COUNT=3",
+			actions: []feedingAction{
+				{at: day(2)},
+				{skip: true, at: day(3)},
+			},
+			want: []FeedingOccurrenceStatus{
+				FeedingOccurrenceStatusMissed, FeedingOccurrenceStatusDone, FeedingOccurrenceStatusSkipped,
+			},
+			wantStatus: FeedingStatusDone,
+		},
+		{
+			name: "schedule ending with UNTIL is done after its last feeding",
+			rule: "FREQ=DAILY;UNTIL=20250102T100000Z",
+			actions: []feedingAction{
+				{at: day(2)},
+				{at: day(3), wantErr: ErrUnknownFeedingOccurrence},
+			},
+			want: []FeedingOccurrenceStatus{
+				FeedingOccurrenceStatusMissed, FeedingOccurrenceStatusDone,
+			},
+			wantStatus: FeedingStatusDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := newRecurringSchedule(t, tt.rule)
+			schedule.History = tt.history
+
+			for _, action := range tt.actions {
+				var err error
+				if action.skip {
+					err = schedule.SkipOccurrence(action.at)
+				} else {
+					err = schedule.CompleteOccurrence(action.at)
+				}
+
+				if action.wantErr != nil {
+					require.ErrorIs(t, err, action.wantErr, "at %s", action.at)
+				} else {
+					require.NoError(t, err, "at %s", action.at)
+				}
+			}
+
+			occurrences := schedule.Occurrences(day(1), day(6))
+
+			statuses := make([]FeedingOccurrenceStatus, len(occurrences))
+			for i, occurrence := range occurrences {
+				statuses[i] = occurrence.Status
+			}
+
+			assert.Equal(t, tt.want, statuses)
+			assert.Equal(t, tt.wantStatus, schedule.Status)
+		})
+	}
+}
+
+func TestFeedingScheduleOneTimeOccurrence(t *testing.T) {
+	schedule := &FeedingSchedule{
+		Food:   "Meat",
+		Time:   FeedingScheduleTime(day(1)),
+		Status: FeedingStatusNotDone,
+	}
+
+	require.ErrorIs(t, schedule.SkipOccurrence(day(1)), ErrNotRecurringFeeding)
+	require.ErrorIs(t, schedule.CompleteOccurrence(day(2)), ErrUnknownFeedingOccurrence)
+
+	require.NoError(t, schedule.CompleteOccurrence(day(1)))
+	assert.Equal(t, FeedingStatusDone, schedule.Status)
+	assert.Equal(t, []FeedingOccurrence{{Time: day(1), Status: FeedingOccurrenceStatusDone}}, schedule.Occurrences(day(1), day(2)))
+
+	require.ErrorIs(t, schedule.CompleteOccurrence(day(1)), ErrFeedingStatusIsDone)
+}
+
+func TestFeedingScheduleDueAndNextOccurrences(t *testing.T) {
+	schedule := newRecurringSchedule(t, "FREQ=DAILY;COUNT=4")
+
+	require.NoError(t, schedule.SkipOccurrence(day(2)))
+
+	assert.Equal(t, []time.Time{day(1), day(3)}, schedule.DueOccurrences(day(3).Add(time.Minute)))
+
+	require.NoError(t, schedule.Complete(day(3).Add(time.Minute)))
+	assert.True(t, schedule.FedThrough.Equal(day(1)))
+
+	next, ok := schedule.NextOccurrence()
+	require.True(t, ok)
+	assert.True(t, next.Equal(day(3)))
+
+	end, ok := schedule.End()
+	require.True(t, ok)
+	assert.True(t, end.Equal(day(4)))
+}
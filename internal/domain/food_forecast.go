@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+)
+
+// Value Object. FoodDemand is the projected consumption of a food compared against its stock.
+type FoodDemand struct {
+	Food Food
+	// Feedings is the number of pending feedings of the food within the forecast.
+	Feedings int
+	// UnportionedFeedings are the feedings without a portion. They are not included in Required.
+	UnportionedFeedings int
+	// Required is the total of the portions, in the stock's unit if the food is stocked.
+	Required FoodQuantity
+	// Stock is the inventory of the food, nil if it is not stocked.
+	Stock *FoodStock
+	// Error explains why the portions of the food could not be added up, empty if they could.
+	// Required and Shortfall do not cover the portions after the first one that failed.
+	Error string
+}
+
+// Shortfall returns the amount missing from the stock to cover the demand.
+func (fd FoodDemand) Shortfall() FoodQuantity {
+	shortfall := fd.Required
+	if fd.Stock != nil {
+		shortfall.Amount = roundFoodAmount(max(fd.Required.Amount-fd.Stock.Quantity.Amount, 0))
+	}
+
+	return shortfall
+}
+
+// IsCovered reports whether the stock holds enough food for the demand. A demand that failed is not covered.
+func (fd FoodDemand) IsCovered() bool {
+	return fd.Error == "" && fd.Shortfall().Amount == 0
+}
+
+// FoodForecast is the projected consumption of every scheduled or stocked food within [From, To).
+type FoodForecast struct {
+	From    time.Time
+	To      time.Time
+	Demands []FoodDemand
+}
+
+// ForecastFoodDemand adds up the portions of the pending feedings within [from, to) for every food and
+// compares them against the stocks. Stocked foods nothing is scheduled for are included with no demand.
+// A food whose portions cannot be converted into one unit is reported with an error, the others are forecast as usual.
+// Demands are ordered by food name.
+func ForecastFoodDemand(schedules []*FeedingSchedule, stocks []*FoodStock, from, to time.Time) *FoodForecast {
+	demands := make(map[Food]*FoodDemand, len(stocks))
+
+	for _, stock := range stocks {
+		demands[stock.Food] = &FoodDemand{
+			Food:     stock.Food,
+			Required: FoodQuantity{Unit: stock.Quantity.Unit},
+			Stock:    stock,
+		}
+	}
+
+	for _, schedule := range schedules {
+		demand, ok := demands[schedule.Food]
+		if !ok {
+			demand = &FoodDemand{Food: schedule.Food}
+			demands[schedule.Food] = demand
+		}
+
+		for _, occurrence := range schedule.Occurrences(from, to) {
+			if occurrence.Status != FeedingOccurrenceStatusPending {
+				continue
+			}
+
+			demand.Feedings++
+
+			if schedule.Portion.IsZero() {
+				demand.UnportionedFeedings++
+				continue
+			}
+
+			if demand.Error != "" {
+				continue
+			}
+
+			// Unstocked foods are counted in the unit of their first portion
+			if demand.Required.Unit == "" {
+				demand.Required.Unit = schedule.Portion.Unit
+			}
+
+			portion, err := schedule.Portion.In(demand.Required.Unit)
+			if err != nil {
+				demand.Error = fmt.Sprintf("feeding schedule %s: %v", schedule.ID, err)
+				continue
+			}
+
+			demand.Required.Amount = roundFoodAmount(demand.Required.Amount + portion.Amount)
+		}
+	}
+
+	forecast := &FoodForecast{
+		From:    from,
+		To:      to,
+		Demands: make([]FoodDemand, 0, len(demands)),
+	}
+
+	for _, food := range slices.Sorted(maps.Keys(demands)) {
+		forecast.Demands = append(forecast.Demands, *demands[food])
+	}
+
+	return forecast
+}
@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+var (
+	ErrEmptyFoodName            = NewInvariantError("empty_food_name", "food name cannot be empty")
+	ErrUnknownFoodUnit          = NewInvariantError("unknown_food_unit", "unit must be one of kg, g, l, ml, pcs")
+	ErrIncompatibleFoodUnits    = NewInvariantError("incompatible_food_units", "quantities are measured in incompatible units")
+	ErrInvalidFoodQuantity      = NewInvariantError("invalid_food_quantity", "food quantity must be positive")
+	ErrInvalidLowStockThreshold = NewInvariantError("invalid_low_stock_threshold", "low stock threshold cannot be negative")
+	ErrInsufficientFoodStock    = NewConflictError("insufficient_food_stock", "not enough food in stock")
+)
+
+type FoodUnit string
+
+const (
+	FoodUnitKilogram   FoodUnit = "kg"
+	FoodUnitGram       FoodUnit = "g"
+	FoodUnitLiter      FoodUnit = "l"
+	FoodUnitMilliliter FoodUnit = "ml"
+	FoodUnitPiece      FoodUnit = "pcs"
+)
+
+// foodUnitScales maps every unit to the base unit of its dimension and its size in base units.
+var foodUnitScales = map[FoodUnit]struct {
+	base   FoodUnit
+	factor float64
+}{
+	FoodUnitKilogram:   {FoodUnitGram, 1000},
+	FoodUnitGram:       {FoodUnitGram, 1},
+	FoodUnitLiter:      {FoodUnitMilliliter, 1000},
+	FoodUnitMilliliter: {FoodUnitMilliliter, 1},
+	FoodUnitPiece:      {FoodUnitPiece, 1},
+}
+
+// foodAmountPrecision is the number of decimal places stock amounts are kept to, so that
+// repeated deliveries and portions do not accumulate floating point errors.
+const foodAmountPrecision = 1e6
+
+func roundFoodAmount(amount float64) float64 {
+	return math.Round(amount*foodAmountPrecision) / foodAmountPrecision
+}
+
+func (u FoodUnit) IsValid() bool {
+	_, ok := foodUnitScales[u]
+	return ok
+}
+
+// Value Object. FoodQuantity is an amount of food in a unit.
+type FoodQuantity struct {
+	Amount float64
+	Unit   FoodUnit
+}
+
+// NewFoodQuantity returns a positive quantity of food.
+func NewFoodQuantity(amount float64, unit FoodUnit) (FoodQuantity, error) {
+	if !unit.IsValid() {
+		return FoodQuantity{}, fmt.Errorf("%w: %q", ErrUnknownFoodUnit, unit)
+	}
+
+	if amount <= 0 {
+		return FoodQuantity{}, ErrInvalidFoodQuantity
+	}
+
+	return FoodQuantity{Amount: amount, Unit: unit}, nil
+}
+
+// IsZero reports whether the quantity is not set.
+func (q FoodQuantity) IsZero() bool {
+	return q.Amount == 0
+}
+
+// In converts the quantity to the unit, which must measure the same dimension.
+func (q FoodQuantity) In(unit FoodUnit) (FoodQuantity, error) {
+	from, ok := foodUnitScales[q.Unit]
+	if !ok {
+		return FoodQuantity{}, fmt.Errorf("%w: %q", ErrUnknownFoodUnit, q.Unit)
+	}
+
+	to, ok := foodUnitScales[unit]
+	if !ok {
+		return FoodQuantity{}, fmt.Errorf("%w: %q", ErrUnknownFoodUnit, unit)
+	}
+
+	if from.base != to.base {
+		return FoodQuantity{}, fmt.Errorf("%w: %s and %s", ErrIncompatibleFoodUnits, q.Unit, unit)
+	}
+
+	return FoodQuantity{Amount: q.Amount * from.factor / to.factor, Unit: unit}, nil
+}
+
+func (q FoodQuantity) String() string {
+	return fmt.Sprintf("%g %s", q.Amount, q.Unit)
+}
+
+// FoodStock is the inventory of a food, identified by the food's name. Feedings of foods
+// missing from the inventory are not limited.
+type FoodStock struct {
+	Food Food
+	// Quantity is the amount in stock, always in the stock's unit.
+	Quantity FoodQuantity
+	// LowStockThreshold is the amount in the stock's unit at or below which the stock is low.
+	LowStockThreshold float64
+	// Short reports that a feeding did not take place for lack of the food. A delivery resets it.
+	Short bool
+}
+
+// NewFoodStock returns an empty stock of the food measured in the unit.
+func NewFoodStock(food Food, unit FoodUnit, lowStockThreshold float64) (*FoodStock, error) {
+	if strings.TrimSpace(string(food)) == "" {
+		return nil, ErrEmptyFoodName
+	}
+
+	if !unit.IsValid() {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFoodUnit, unit)
+	}
+
+	if lowStockThreshold < 0 {
+		return nil, ErrInvalidLowStockThreshold
+	}
+
+	return &FoodStock{
+		Food:              food,
+		Quantity:          FoodQuantity{Unit: unit},
+		LowStockThreshold: lowStockThreshold,
+	}, nil
+}
+
+// Deliver adds the delivered quantity to the stock.
+func (fs *FoodStock) Deliver(delivered FoodQuantity) error {
+	if delivered.Amount <= 0 {
+		return ErrInvalidFoodQuantity
+	}
+
+	converted, err := delivered.In(fs.Quantity.Unit)
+	if err != nil {
+		return fmt.Errorf("delivering %s: %w", fs.Food, err)
+	}
+
+	fs.Quantity.Amount = roundFoodAmount(fs.Quantity.Amount + converted.Amount)
+	fs.Short = false
+
+	return nil
+}
+
+// Consume takes the portion out of the stock. The stock is left unchanged if it does not hold enough.
+func (fs *FoodStock) Consume(portion FoodQuantity) error {
+	converted, err := portion.In(fs.Quantity.Unit)
+	if err != nil {
+		return fmt.Errorf("consuming %s: %w", fs.Food, err)
+	}
+
+	if roundFoodAmount(converted.Amount) > fs.Quantity.Amount {
+		return fmt.Errorf("%w: %s needs %s, %s left", ErrInsufficientFoodStock, fs.Food, converted, fs.Quantity)
+	}
+
+	fs.Quantity.Amount = roundFoodAmount(fs.Quantity.Amount - converted.Amount)
+
+	return nil
+}
+
+// IsLow reports whether the stock is at or below its threshold.
+func (fs *FoodStock) IsLow() bool {
+	return fs.Quantity.Amount <= fs.LowStockThreshold
+}
+
+// ReportShortage marks the stock as short and reports whether it was not short already,
+// so that a shortage is alerted once until the next delivery.
+func (fs *FoodStock) ReportShortage() bool {
+	if fs.Short {
+		return false
+	}
+
+	fs.Short = true
+
+	return true
+}
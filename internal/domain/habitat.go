@@ -0,0 +1,205 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrUnknownEnclosureType = NewInvariantError("unknown_enclosure_type", "enclosure type is not supported")
+	ErrUnknownClimate       = NewInvariantError("unknown_climate", "climate must be one of Tropical, Arid, Temperate, Polar")
+	ErrUnsuitableHabitat    = NewConflictError("unsuitable_habitat", "enclosure does not suit the habitat of the species")
+)
+
+type Climate string
+
+const (
+	ClimateTropical  Climate = "Tropical"
+	ClimateArid      Climate = "Arid"
+	ClimateTemperate Climate = "Temperate"
+	ClimatePolar     Climate = "Polar"
+)
+
+func (c Climate) IsValid() bool {
+	switch c {
+	case ClimateTropical, ClimateArid, ClimateTemperate, ClimatePolar:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	EnclosureTypeSavanna         EnclosureType = "Savanna"
+	EnclosureTypeDesert          EnclosureType = "Desert"
+	EnclosureTypeForest          EnclosureType = "Forest"
+	EnclosureTypeRainforest      EnclosureType = "Rainforest"
+	EnclosureTypeTundra          EnclosureType = "Tundra"
+	EnclosureTypeAquarium        EnclosureType = "Aquarium"
+	EnclosureTypePond            EnclosureType = "Pond"
+	EnclosureTypePolarPool       EnclosureType = "PolarPool"
+	EnclosureTypeAviary          EnclosureType = "Aviary"
+	EnclosureTypeTropicalAviary  EnclosureType = "TropicalAviary"
+	EnclosureTypeTerrarium       EnclosureType = "Terrarium"
+	EnclosureTypeDesertTerrarium EnclosureType = "DesertTerrarium"
+	// EnclosureTypeQuarantine is the designated type of enclosures that isolate sick animals.
+	// Quarantine enclosures are climate-controlled and suit any species.
+	EnclosureTypeQuarantine EnclosureType = "Quarantine"
+)
+
+// Value Object. Habitat describes the environment an enclosure provides.
+type Habitat struct {
+	Aquatic   bool
+	Aviary    bool
+	Terrarium bool
+	Climate   Climate
+}
+
+var enclosureTypeHabitats = map[EnclosureType]Habitat{
+	EnclosureTypeSavanna:         {Climate: ClimateTropical},
+	EnclosureTypeDesert:          {Climate: ClimateArid},
+	EnclosureTypeForest:          {Climate: ClimateTemperate},
+	EnclosureTypeRainforest:      {Climate: ClimateTropical},
+	EnclosureTypeTundra:          {Climate: ClimatePolar},
+	EnclosureTypeAquarium:        {Aquatic: true, Climate: ClimateTropical},
+	EnclosureTypePond:            {Aquatic: true, Climate: ClimateTemperate},
+	EnclosureTypePolarPool:       {Aquatic: true, Climate: ClimatePolar},
+	EnclosureTypeAviary:          {Aviary: true, Climate: ClimateTemperate},
+	EnclosureTypeTropicalAviary:  {Aviary: true, Climate: ClimateTropical},
+	EnclosureTypeTerrarium:       {Terrarium: true, Climate: ClimateTropical},
+	EnclosureTypeDesertTerrarium: {Terrarium: true, Climate: ClimateArid},
+	EnclosureTypeQuarantine:      {},
+}
+
+// EnclosureTypes returns every supported enclosure type sorted by name.
+func EnclosureTypes() []EnclosureType {
+	types := make([]EnclosureType, 0, len(enclosureTypeHabitats))
+	for t := range enclosureTypeHabitats {
+		types = append(types, t)
+	}
+
+	slices.Sort(types)
+
+	return types
+}
+
+func ParseEnclosureType(s string) (EnclosureType, error) {
+	t := EnclosureType(s)
+	if !t.IsValid() {
+		return "", fmt.Errorf("%w: %q", ErrUnknownEnclosureType, s)
+	}
+
+	return t, nil
+}
+
+func (t EnclosureType) IsValid() bool {
+	_, ok := enclosureTypeHabitats[t]
+	return ok
+}
+
+// Habitat returns the environment enclosures of the type provide.
+// Types that are no longer supported provide a zero habitat.
+func (t EnclosureType) Habitat() Habitat {
+	return enclosureTypeHabitats[t]
+}
+
+// Value Object. HabitatRequirements describe the environment a species needs.
+// A species lives only in enclosures that are aquatic, aviaries or terrariums exactly when it needs one.
+type HabitatRequirements struct {
+	Aquatic   bool
+	Aviary    bool
+	Terrarium bool
+	// Climates are the climates the species tolerates, the preferred one first. Any climate suits it if empty.
+	Climates []Climate
+}
+
+func (hr HabitatRequirements) validate() error {
+	for _, climate := range hr.Climates {
+		if !climate.IsValid() {
+			return fmt.Errorf("%w: %q", ErrUnknownClimate, climate)
+		}
+	}
+
+	return nil
+}
+
+// Fit rates how well the habitat suits the requirements: 0 if it does not suit them,
+// 1 for the preferred climate and less for every next tolerated climate.
+func (hr HabitatRequirements) Fit(h Habitat) float64 {
+	if h.Aquatic != hr.Aquatic || h.Aviary != hr.Aviary || h.Terrarium != hr.Terrarium {
+		return 0
+	}
+
+	if len(hr.Climates) == 0 {
+		return 1
+	}
+
+	rank := slices.Index(hr.Climates, h.Climate)
+	if rank < 0 {
+		return 0
+	}
+
+	return 1 - float64(rank)/float64(len(hr.Climates))
+}
+
+// HabitatFit rates how well the enclosure suits the animal's species from 0 to 1.
+// Quarantine enclosures and species missing from the catalog are always a perfect fit.
+func (sc SpeciesCatalog) HabitatFit(animal *Animal, enclosure *Enclosure) float64 {
+	species, ok := sc[animal.Species]
+	if !ok || enclosure.IsQuarantine() {
+		return 1
+	}
+
+	return species.Habitat.Fit(enclosure.Type.Habitat())
+}
+
+// CheckHabitat returns ErrUnsuitableHabitat if the enclosure does not suit the animal's species.
+func (sc SpeciesCatalog) CheckHabitat(animal *Animal, enclosure *Enclosure) error {
+	if sc.HabitatFit(animal, enclosure) > 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s cannot live in an enclosure of type %s", ErrUnsuitableHabitat, animal.Species, enclosure.Type)
+}
+
+// Value Object. EnclosureSuitability rates an enclosure the animal can be placed into.
+type EnclosureSuitability struct {
+	Enclosure *Enclosure
+	Fit       float64
+	// FreeSpace is the number of animals of the species the enclosure can still take.
+	FreeSpace int
+}
+
+// RankEnclosures returns the enclosures the animal can be placed into, the best habitat fit first
+// and the most slots left for its species first among equally fitting ones. The animal's own enclosure is skipped.
+func (sc SpeciesCatalog) RankEnclosures(animal *Animal, enclosures []*Enclosure) []EnclosureSuitability {
+	suitable := make([]EnclosureSuitability, 0)
+
+	for _, enclosure := range enclosures {
+		if animal.Enclosure != nil && animal.Enclosure.ID == enclosure.ID {
+			continue
+		}
+
+		if err := enclosure.CheckPlacement(animal, sc); err != nil {
+			continue
+		}
+
+		suitable = append(suitable, EnclosureSuitability{
+			Enclosure: enclosure,
+			Fit:       sc.HabitatFit(animal, enclosure),
+			FreeSpace: enclosure.RemainingSlots(animal.Species, sc),
+		})
+	}
+
+	slices.SortFunc(suitable, func(a, b EnclosureSuitability) int {
+		return cmp.Or(
+			cmp.Compare(b.Fit, a.Fit),
+			cmp.Compare(b.FreeSpace, a.FreeSpace),
+			strings.Compare(a.Enclosure.ID.String(), b.Enclosure.ID.String()),
+		)
+	})
+
+	return suitable
+}
@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHabitatRequirementsFit(t *testing.T) {
+	tests := []struct {
+		name         string
+		requirements HabitatRequirements
+		habitat      Habitat
+		want         float64
+	}{
+		{name: "any climate", requirements: HabitatRequirements{}, habitat: Habitat{Climate: ClimatePolar}, want: 1},
+		{
+			name:         "preferred climate",
+			requirements: HabitatRequirements{Climates: []Climate{ClimateArid, ClimateTropical}},
+			habitat:      Habitat{Climate: ClimateArid},
+			want:         1,
+		},
+		{
+			name:         "tolerated climate",
+			requirements: HabitatRequirements{Climates: []Climate{ClimateArid, ClimateTropical}},
+			habitat:      Habitat{Climate: ClimateTropical},
+			want:         0.5,
+		},
+		{
+			name:         "climate not tolerated",
+			requirements: HabitatRequirements{Climates: []Climate{ClimateArid, ClimateTropical}},
+			habitat:      Habitat{Climate: ClimatePolar},
+			want:         0,
+		},
+		{
+			name:         "aquatic species needs water",
+			requirements: HabitatRequirements{Aquatic: true},
+			habitat:      Habitat{Climate: ClimateTemperate},
+			want:         0,
+		},
+		{
+			name:         "land species cannot live in water",
+			requirements: HabitatRequirements{},
+			habitat:      Habitat{Aquatic: true, Climate: ClimateTemperate},
+			want:         0,
+		},
+		{
+			name:         "bird in an aviary",
+			requirements: HabitatRequirements{Aviary: true, Climates: []Climate{ClimateTemperate}},
+			habitat:      EnclosureTypeAviary.Habitat(),
+			want:         1,
+		},
+		{
+			name:         "reptile outside a terrarium",
+			requirements: HabitatRequirements{Terrarium: true},
+			habitat:      EnclosureTypeDesert.Habitat(),
+			want:         0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, tt.requirements.Fit(tt.habitat), 1e-9)
+		})
+	}
+}
+
+func TestEnclosureCheckPlacement(t *testing.T) {
+	catalog := NewSpeciesCatalog([]*Species{
+		{Name: "Camel", Habitat: HabitatRequirements{Climates: []Climate{ClimateArid}}, MinArea: 40},
+		{Name: "Lion", Predator: true},
+	})
+
+	tests := []struct {
+		name          string
+		enclosureType EnclosureType
+		capacity      int
+		residents     []AnimalSpecies
+		species       AnimalSpecies
+		status        AnimalStatus
+		wantErr       error
+	}{
+		{name: "suitable enclosure", enclosureType: EnclosureTypeDesert, capacity: 2, species: "Camel"},
+		{name: "unsuitable habitat", enclosureType: EnclosureTypeSavanna, capacity: 2, species: "Camel", wantErr: ErrUnsuitableHabitat},
+		{name: "species missing from the catalog", enclosureType: EnclosureTypePolarPool, capacity: 2, species: "Okapi"},
+		{name: "full", enclosureType: EnclosureTypeDesert, capacity: 1, residents: []AnimalSpecies{"Okapi"}, species: "Camel", wantErr: ErrEnclosureFull},
+		{
+			name:          "not enough area",
+			enclosureType: EnclosureTypeDesert,
+			capacity:      5,
+			residents:     []AnimalSpecies{"Camel", "Camel"},
+			species:       "Camel",
+			wantErr:       ErrNotEnoughSpace,
+		},
+		{
+			name:          "incompatible residents",
+			enclosureType: EnclosureTypeDesert,
+			capacity:      5,
+			residents:     []AnimalSpecies{"Camel"},
+			species:       "Lion",
+			wantErr:       ErrIncompatibleSpecies,
+		},
+		{
+			name:          "quarantined animal outside quarantine",
+			enclosureType: EnclosureTypeDesert,
+			capacity:      2,
+			species:       "Camel",
+			status:        AnimalStatusQuarantined,
+			wantErr:       ErrAnimalQuarantined,
+		},
+		{
+			name:          "quarantine suits any species",
+			enclosureType: EnclosureTypeQuarantine,
+			capacity:      2,
+			species:       "Camel",
+			status:        AnimalStatusQuarantined,
+		},
+		{
+			name:          "quarantine is reserved for quarantined animals",
+			enclosureType: EnclosureTypeQuarantine,
+			capacity:      2,
+			species:       "Camel",
+			wantErr:       ErrQuarantineEnclosureReserved,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enclosure := newTestEnclosure(1, tt.enclosureType, tt.capacity)
+			// 100 fits two camels
+			enclosure.Size = 100
+
+			for i, species := range tt.residents {
+				resident := newPlacedAnimal(byte(10+i), species, enclosure)
+				resident.Status = tt.status
+			}
+
+			animal := newPlacedAnimal(1, tt.species, nil)
+			animal.Status = tt.status
+
+			err := enclosure.CheckPlacement(animal, catalog)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRankEnclosures(t *testing.T) {
+	catalog := NewSpeciesCatalog([]*Species{
+		{Name: "Camel", Habitat: HabitatRequirements{Climates: []Climate{ClimateArid, ClimateTropical}}},
+	})
+
+	desert := newTestEnclosure(1, EnclosureTypeDesert, 2)
+	roomyDesert := newTestEnclosure(2, EnclosureTypeDesert, 3)
+	savanna := newTestEnclosure(3, EnclosureTypeSavanna, 5)
+	tundra := newTestEnclosure(4, EnclosureTypeTundra, 5)
+	ownDesert := newTestEnclosure(5, EnclosureTypeDesert, 5)
+	quarantine := newTestEnclosure(6, EnclosureTypeQuarantine, 5)
+
+	camel := newPlacedAnimal(1, "Camel", ownDesert)
+
+	ranked := catalog.RankEnclosures(camel, []*Enclosure{savanna, tundra, desert, quarantine, roomyDesert, ownDesert})
+
+	got := make([]*Enclosure, len(ranked))
+	for i, suitability := range ranked {
+		got[i] = suitability.Enclosure
+	}
+
+	// Preferred climate first, the most free space first among them; the own enclosure is skipped
+	assert.Equal(t, []*Enclosure{roomyDesert, desert, savanna}, got)
+	assert.Equal(t, []float64{1, 1, 0.5}, []float64{ranked[0].Fit, ranked[1].Fit, ranked[2].Fit})
+	assert.Equal(t, 3, ranked[0].FreeSpace)
+}
@@ -0,0 +1,383 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEmptyKeeperName          = NewInvariantError("empty_keeper_name", "keeper name cannot be empty")
+	ErrInvalidMaxEnclosures     = NewInvariantError("invalid_max_enclosures", "max enclosures per shift must be positive")
+	ErrEmptyShiftName           = NewInvariantError("empty_shift_name", "shift name cannot be empty")
+	ErrInvalidShiftTime         = NewInvariantError("invalid_shift_time", "shift times must be within a day and a shift cannot end when it starts")
+	ErrUnknownWeekday           = NewInvariantError("unknown_weekday", "weekday must be one of MO, TU, WE, TH, FR, SA, SU")
+	ErrKeeperAlreadyAssigned    = NewConflictError("keeper_already_assigned", "keeper is already assigned to the enclosure in the shift")
+	ErrKeeperAssignmentNotFound = NewNotFoundError("keeper_assignment_not_found", "keeper is not assigned to the enclosure in the shift")
+	ErrTooManyEnclosures        = NewConflictError("too_many_enclosures", "keeper would be assigned more enclosures in the shift than allowed")
+	ErrKeeperNotQualified       = NewConflictError("keeper_not_qualified", "keeper is not qualified for a dangerous species in the enclosure")
+	ErrShiftInUse               = NewConflictError("shift_in_use", "keepers are assigned to the shift")
+)
+
+type (
+	KeeperID   uuid.UUID
+	KeeperName string
+	ShiftName  string
+)
+
+func (id KeeperID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func (id KeeperID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id KeeperID) MarshalText() ([]byte, error) {
+	return uuid.UUID(id).MarshalText()
+}
+
+func (id *KeeperID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
+
+// Shift is a recurring working period of keepers, identified by its name.
+type Shift struct {
+	Name ShiftName
+	// Start and End are the wall-clock times of day as offsets from midnight in Location.
+	// A shift ending at or before the time it starts ends on the next day.
+	Start time.Duration
+	End   time.Duration
+	// Days are the weekdays the shift starts on, every day if empty.
+	Days     []time.Weekday
+	Location *time.Location
+}
+
+func NewShift(name ShiftName, start, end time.Duration, days []time.Weekday, location *time.Location) (*Shift, error) {
+	if strings.TrimSpace(string(name)) == "" {
+		return nil, ErrEmptyShiftName
+	}
+
+	if start < 0 || start >= 24*time.Hour || end < 0 || end >= 24*time.Hour || start == end {
+		return nil, ErrInvalidShiftTime
+	}
+
+	if location == nil {
+		location = time.UTC
+	}
+
+	shift := &Shift{
+		Name:     name,
+		Start:    start,
+		End:      end,
+		Location: location,
+	}
+
+	for _, day := range days {
+		if !slices.Contains(shift.Days, day) {
+			shift.Days = append(shift.Days, day)
+		}
+	}
+
+	slices.SortFunc(shift.Days, func(a, b time.Weekday) int { return mondayOffset(a) - mondayOffset(b) })
+
+	return shift, nil
+}
+
+// ParseTimeOfDay parses a wall-clock time such as "08:30" into an offset from midnight.
+func ParseTimeOfDay(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not a time like 08:30", ErrInvalidShiftTime, value)
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// FormatTimeOfDay formats an offset from midnight as a wall-clock time such as "08:30".
+func FormatTimeOfDay(offset time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(offset/time.Hour), int(offset%time.Hour/time.Minute))
+}
+
+// ParseWeekday parses an RFC 5545 weekday code such as "MO".
+func ParseWeekday(code string) (time.Weekday, bool) {
+	day, ok := weekdayCodes[strings.ToUpper(code)]
+	return day, ok
+}
+
+// WeekdayCode returns the RFC 5545 code of the weekday, such as "MO".
+func WeekdayCode(day time.Weekday) string {
+	return strings.ToUpper(day.String()[:2])
+}
+
+// TimeZone returns the name of the time zone the shift times are in.
+func (s *Shift) TimeZone() string {
+	return s.Location.String()
+}
+
+// SlotAt returns the period of the shift in progress at the moment, if any.
+func (s *Shift) SlotAt(at time.Time) (TimeSlot, bool) {
+	local := at.In(s.Location)
+
+	// A shift in progress started either today or, if it runs past midnight, yesterday
+	for _, offset := range []int{0, -1} {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, s.Location)
+		if len(s.Days) > 0 && !slices.Contains(s.Days, day.Weekday()) {
+			continue
+		}
+
+		slot := TimeSlot{Start: s.wallClock(day, s.Start), End: s.wallClock(day, s.End)}
+		if s.End <= s.Start {
+			slot.End = s.wallClock(day.AddDate(0, 0, 1), s.End)
+		}
+
+		if slot.Contains(at) {
+			return slot, true
+		}
+	}
+
+	return TimeSlot{}, false
+}
+
+// wallClock returns the time of day on the day, keeping the wall-clock time across DST changes.
+func (s *Shift) wallClock(day time.Time, offset time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, int(offset/time.Second), 0, s.Location)
+}
+
+// Clone returns a deep copy of the shift.
+func (s *Shift) Clone() *Shift {
+	cloned := *s
+	cloned.Days = slices.Clone(s.Days)
+
+	return &cloned
+}
+
+// Value Object. KeeperAssignment puts a keeper in charge of an enclosure during a shift.
+type KeeperAssignment struct {
+	EnclosureID EnclosureID
+	Shift       ShiftName
+}
+
+// Keeper is a member of the staff caring for enclosures.
+type Keeper struct {
+	ID   KeeperID
+	Name KeeperName
+	// Qualifications are the dangerous species the keeper may care for. Species are dangerous
+	// if the catalog marks them as predators.
+	Qualifications []AnimalSpecies
+	// MaxEnclosures is the number of enclosures the keeper may be assigned in a single shift.
+	MaxEnclosures int
+	// Assignments are ordered by the time they were made.
+	Assignments []KeeperAssignment
+}
+
+func NewKeeper(name KeeperName, qualifications []AnimalSpecies, maxEnclosures int) (*Keeper, error) {
+	keeper := &Keeper{ID: KeeperID(uuid.New())}
+
+	if err := keeper.Update(name, qualifications, maxEnclosures); err != nil {
+		return nil, err
+	}
+
+	return keeper, nil
+}
+
+// Update changes the details of the keeper. It does not check the existing assignments against them,
+// see CheckAssignments.
+func (k *Keeper) Update(name KeeperName, qualifications []AnimalSpecies, maxEnclosures int) error {
+	if strings.TrimSpace(string(name)) == "" {
+		return ErrEmptyKeeperName
+	}
+
+	if maxEnclosures < 1 {
+		return ErrInvalidMaxEnclosures
+	}
+
+	k.Name = name
+	k.MaxEnclosures = maxEnclosures
+	k.Qualifications = make([]AnimalSpecies, 0, len(qualifications))
+
+	for _, species := range qualifications {
+		if species = AnimalSpecies(strings.TrimSpace(string(species))); species != "" && !slices.Contains(k.Qualifications, species) {
+			k.Qualifications = append(k.Qualifications, species)
+		}
+	}
+
+	return nil
+}
+
+// IsQualified reports whether the keeper may care for animals of the dangerous species.
+func (k *Keeper) IsQualified(species AnimalSpecies) bool {
+	return slices.Contains(k.Qualifications, species)
+}
+
+// IsAssigned reports whether the keeper is in charge of the enclosure during the shift.
+func (k *Keeper) IsAssigned(enclosureID EnclosureID, shift ShiftName) bool {
+	return slices.Contains(k.Assignments, KeeperAssignment{EnclosureID: enclosureID, Shift: shift})
+}
+
+// Assign puts the keeper in charge of the enclosure during the shift.
+func (k *Keeper) Assign(enclosure *Enclosure, shift ShiftName, catalog SpeciesCatalog) error {
+	if k.IsAssigned(enclosure.ID, shift) {
+		return fmt.Errorf("%w: enclosure %s in shift %s", ErrKeeperAlreadyAssigned, enclosure.ID, shift)
+	}
+
+	if k.enclosuresIn(shift) >= k.MaxEnclosures {
+		return fmt.Errorf("%w: %s is limited to %d in shift %s", ErrTooManyEnclosures, k.Name, k.MaxEnclosures, shift)
+	}
+
+	if err := k.checkQualified(enclosure, catalog); err != nil {
+		return err
+	}
+
+	k.Assignments = append(k.Assignments, KeeperAssignment{EnclosureID: enclosure.ID, Shift: shift})
+
+	return nil
+}
+
+func (k *Keeper) Unassign(enclosureID EnclosureID, shift ShiftName) error {
+	i := slices.Index(k.Assignments, KeeperAssignment{EnclosureID: enclosureID, Shift: shift})
+	if i < 0 {
+		return fmt.Errorf("%w: enclosure %s in shift %s", ErrKeeperAssignmentNotFound, enclosureID, shift)
+	}
+
+	k.Assignments = slices.Delete(k.Assignments, i, i+1)
+
+	return nil
+}
+
+// CheckAssignments returns an error if the existing assignments exceed the keeper's limit or include
+// enclosures with dangerous species the keeper is not qualified for. Enclosures missing from the map
+// are skipped.
+func (k *Keeper) CheckAssignments(enclosures map[EnclosureID]*Enclosure, catalog SpeciesCatalog) error {
+	for _, assignment := range k.Assignments {
+		if k.enclosuresIn(assignment.Shift) > k.MaxEnclosures {
+			return fmt.Errorf("%w: %s is assigned %d in shift %s",
+				ErrTooManyEnclosures, k.Name, k.enclosuresIn(assignment.Shift), assignment.Shift)
+		}
+
+		if enclosure, ok := enclosures[assignment.EnclosureID]; ok {
+			if err := k.checkQualified(enclosure, catalog); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (k *Keeper) enclosuresIn(shift ShiftName) int {
+	count := 0
+	for _, assignment := range k.Assignments {
+		if assignment.Shift == shift {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (k *Keeper) checkQualified(enclosure *Enclosure, catalog SpeciesCatalog) error {
+	for animal := range enclosure.Occupancy.Animals {
+		if err := k.checkAnimal(animal, enclosure.ID, catalog); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k *Keeper) checkAnimal(animal *Animal, enclosureID EnclosureID, catalog SpeciesCatalog) error {
+	if catalog.isPredator(animal.Species) && !k.IsQualified(animal.Species) {
+		return fmt.Errorf("%w: %s cannot care for %s in enclosure %s", ErrKeeperNotQualified, k.Name, animal.Species, enclosureID)
+	}
+
+	return nil
+}
+
+// CheckKeepersQualified returns an error if a keeper assigned to the enclosure in any shift
+// is not qualified for the animal moving into it.
+func CheckKeepersQualified(animal *Animal, enclosureID EnclosureID, keepers []*Keeper, catalog SpeciesCatalog) error {
+	for _, keeper := range keepers {
+		if !slices.ContainsFunc(keeper.Assignments, func(assignment KeeperAssignment) bool {
+			return assignment.EnclosureID == enclosureID
+		}) {
+			continue
+		}
+
+		if err := keeper.checkAnimal(animal, enclosureID, catalog); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckKeepersQualifiedForSpecies returns an error if a keeper assigned to an enclosure housing
+// animals of the species is not qualified for them under the catalog, such as after the species
+// has been marked as a predator.
+func CheckKeepersQualifiedForSpecies(species AnimalSpecies, enclosures []*Enclosure, keepers []*Keeper, catalog SpeciesCatalog) error {
+	for _, enclosure := range enclosures {
+		for animal := range enclosure.Occupancy.Animals {
+			if animal.Species != species {
+				continue
+			}
+
+			if err := CheckKeepersQualified(animal, enclosure.ID, keepers, catalog); err != nil {
+				return err
+			}
+
+			break
+		}
+	}
+
+	return nil
+}
+
+// Clone returns a deep copy of the keeper.
+func (k *Keeper) Clone() *Keeper {
+	cloned := *k
+	cloned.Qualifications = slices.Clone(k.Qualifications)
+	cloned.Assignments = slices.Clone(k.Assignments)
+
+	return &cloned
+}
+
+// Value Object. KeeperOnDuty is a keeper in charge of an enclosure during a shift in progress.
+type KeeperOnDuty struct {
+	Keeper *Keeper
+	Shift  *Shift
+	// Slot is the period of the shift in progress.
+	Slot TimeSlot
+}
+
+// ResponsibleKeepers returns the keepers in charge of the enclosure at the moment, ordered by name.
+// Assignments to shifts missing from the list are skipped.
+func ResponsibleKeepers(enclosureID EnclosureID, keepers []*Keeper, shifts []*Shift, at time.Time) []KeeperOnDuty {
+	onDuty := make([]KeeperOnDuty, 0)
+
+	for _, shift := range shifts {
+		slot, ok := shift.SlotAt(at)
+		if !ok {
+			continue
+		}
+
+		for _, keeper := range keepers {
+			if keeper.IsAssigned(enclosureID, shift.Name) {
+				onDuty = append(onDuty, KeeperOnDuty{Keeper: keeper, Shift: shift, Slot: slot})
+			}
+		}
+	}
+
+	slices.SortStableFunc(onDuty, func(a, b KeeperOnDuty) int {
+		if c := strings.Compare(string(a.Keeper.Name), string(b.Keeper.Name)); c != 0 {
+			return c
+		}
+
+		return a.Slot.Start.Compare(b.Slot.Start)
+	})
+
+	return onDuty
+}
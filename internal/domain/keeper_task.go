@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnknownTaskKind = NewInvariantError("unknown_task_kind", "task kind must be Feeding, Cleaning or Checkup")
+	ErrTaskNotAssigned = NewConflictError("task_not_assigned", "task does not fall to the keeper")
+	ErrTaskInFuture    = NewInvariantError("task_in_future", "task cannot be completed before it is due")
+)
+
+type (
+	TaskKind   string
+	TaskStatus string
+)
+
+const (
+	TaskKindFeeding  TaskKind = "Feeding"
+	TaskKindCleaning TaskKind = "Cleaning"
+	TaskKindCheckup  TaskKind = "Checkup"
+)
+
+const (
+	TaskStatusPending TaskStatus = "Pending"
+	TaskStatusDone    TaskStatus = "Done"
+	TaskStatusSkipped TaskStatus = "Skipped"
+	TaskStatusMissed  TaskStatus = "Missed"
+)
+
+// Value Object. TaskOccurrence is a single occurrence of a care task.
+type TaskOccurrence struct {
+	Time   time.Time
+	Status TaskStatus
+}
+
+// Value Object. KeeperTask is a feeding, cleaning or checkup on the task list of a keeper.
+type KeeperTask struct {
+	Kind TaskKind
+	// TaskID is the ID of the feeding schedule or of the care task.
+	TaskID uuid.UUID
+	Time   time.Time
+	Status TaskStatus
+	// EnclosureID is nil for feedings of animals outside enclosures.
+	EnclosureID *EnclosureID
+	// Schedule is the schedule of a feeding, nil for care tasks.
+	Schedule *FeedingSchedule
+	// Explicit reports whether the task is assigned to the keeper rather than falling to them by their shift.
+	Explicit bool
+}
+
+// Task returns the feeding as a task of a keeper.
+func (fs *FeedingSchedule) Task(occurrence FeedingOccurrence, explicit bool) KeeperTask {
+	return KeeperTask{
+		Kind:        TaskKindFeeding,
+		TaskID:      fs.ID.UUID(),
+		Time:        occurrence.Time,
+		Status:      TaskStatus(occurrence.Status),
+		EnclosureID: fs.EnclosureID(),
+		Schedule:    fs,
+		Explicit:    explicit,
+	}
+}
+
+// EnclosureID returns the enclosure of the fed animal, nil if it is not in one.
+func (fs *FeedingSchedule) EnclosureID() *EnclosureID {
+	if fs.Animal == nil || fs.Animal.Enclosure == nil {
+		return nil
+	}
+
+	id := fs.Animal.Enclosure.ID
+
+	return &id
+}
+
+// Task returns the occurrence of the care task as a task of a keeper.
+func (ct *CareTask) Task(occurrence TaskOccurrence, explicit bool) KeeperTask {
+	enclosureID := ct.EnclosureID
+
+	return KeeperTask{
+		Kind:        ct.Kind,
+		TaskID:      ct.ID.UUID(),
+		Time:        occurrence.Time,
+		Status:      occurrence.Status,
+		EnclosureID: &enclosureID,
+		Explicit:    explicit,
+	}
+}
+
+// TaskAssignee returns the keeper a task in the enclosure at the moment falls to: the assignee unless
+// the keeper is missing from the list, otherwise the first keeper by name responsible for the enclosure
+// at the moment. It returns nil if the task falls to nobody; explicit reports whether it falls to the assignee.
+func TaskAssignee(
+	assignee *KeeperID,
+	enclosureID *EnclosureID,
+	keepers []*Keeper,
+	shifts []*Shift,
+	at time.Time,
+) (keeper *Keeper, explicit bool) {
+	if assignee != nil {
+		i := slices.IndexFunc(keepers, func(keeper *Keeper) bool { return keeper.ID == *assignee })
+		if i >= 0 {
+			return keepers[i], true
+		}
+	}
+
+	if enclosureID == nil {
+		return nil, false
+	}
+
+	if onDuty := ResponsibleKeepers(*enclosureID, keepers, shifts, at); len(onDuty) > 0 {
+		return onDuty[0].Keeper, false
+	}
+
+	return nil, false
+}
+
+// KeeperTasks returns the feedings and care tasks within the period falling to the keeper, ordered by time.
+func KeeperTasks(
+	keeperID KeeperID,
+	period TimeSlot,
+	schedules []*FeedingSchedule,
+	careTasks []*CareTask,
+	keepers []*Keeper,
+	shifts []*Shift,
+) []KeeperTask {
+	tasks := make([]KeeperTask, 0)
+
+	for _, schedule := range schedules {
+		for _, occurrence := range schedule.Occurrences(period.Start, period.End) {
+			keeper, explicit := TaskAssignee(schedule.Assignee, schedule.EnclosureID(), keepers, shifts, occurrence.Time)
+			if keeper != nil && keeper.ID == keeperID {
+				tasks = append(tasks, schedule.Task(occurrence, explicit))
+			}
+		}
+	}
+
+	for _, careTask := range careTasks {
+		for _, occurrence := range careTask.Occurrences(period.Start, period.End) {
+			keeper, explicit := TaskAssignee(careTask.Assignee, &careTask.EnclosureID, keepers, shifts, occurrence.Time)
+			if keeper != nil && keeper.ID == keeperID {
+				tasks = append(tasks, careTask.Task(occurrence, explicit))
+			}
+		}
+	}
+
+	// Tasks at the same time are ordered by kind and ID, so that the order does not depend on the repositories
+	slices.SortFunc(tasks, func(a, b KeeperTask) int {
+		if c := a.Time.Compare(b.Time); c != 0 {
+			return c
+		}
+
+		if c := strings.Compare(string(a.Kind), string(b.Kind)); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.TaskID.String(), b.TaskID.String())
+	})
+
+	return tasks
+}
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// keeperTasksFixture is an enclosure cared for by Ann in the day shift and by Bob in the night shift.
+type keeperTasksFixture struct {
+	enclosure *Enclosure
+	ann, bob  *Keeper
+	keepers   []*Keeper
+	shifts    []*Shift
+}
+
+func newKeeperTasksFixture(t *testing.T) keeperTasksFixture {
+	t.Helper()
+
+	enclosure := newTestEnclosure(1, EnclosureTypeSavanna, 5)
+
+	day, err := NewShift("Day", 8*time.Hour, 16*time.Hour, nil, time.UTC)
+	require.NoError(t, err)
+	night, err := NewShift("Night", 22*time.Hour, 6*time.Hour, nil, time.UTC)
+	require.NoError(t, err)
+
+	ann, err := NewKeeper("Ann", nil, 2)
+	require.NoError(t, err)
+	ann.Assignments = []KeeperAssignment{{EnclosureID: enclosure.ID, Shift: day.Name}}
+
+	bob, err := NewKeeper("Bob", nil, 2)
+	require.NoError(t, err)
+	bob.Assignments = []KeeperAssignment{{EnclosureID: enclosure.ID, Shift: night.Name}}
+
+	return keeperTasksFixture{
+		enclosure: enclosure,
+		ann:       ann,
+		bob:       bob,
+		keepers:   []*Keeper{bob, ann},
+		shifts:    []*Shift{day, night},
+	}
+}
+
+func newDailyCareTask(t *testing.T, kind TaskKind, enclosureID EnclosureID, at time.Time) *CareTask {
+	t.Helper()
+
+	recurrence, err := ParseRecurrence("FREQ=DAILY", "")
+	require.NoError(t, err)
+
+	careTask, err := NewCareTask(kind, enclosureID, at, recurrence)
+	require.NoError(t, err)
+
+	return careTask
+}
+
+func TestTaskAssignee(t *testing.T) {
+	fixture := newKeeperTasksFixture(t)
+	missing := KeeperID(uuid.New())
+
+	tests := []struct {
+		name         string
+		assignee     *KeeperID
+		enclosureID  *EnclosureID
+		at           time.Time
+		want         *Keeper
+		wantExplicit bool
+	}{
+		{name: "keeper on duty", enclosureID: &fixture.enclosure.ID, at: day(2).Add(time.Hour), want: fixture.ann},
+		{name: "keeper on duty past midnight", enclosureID: &fixture.enclosure.ID, at: day(2).Add(-5 * time.Hour), want: fixture.bob},
+		{name: "nobody on duty", enclosureID: &fixture.enclosure.ID, at: day(2).Add(8 * time.Hour)},
+		{name: "outside an enclosure", at: day(2)},
+		{
+			name:         "assignee off duty",
+			assignee:     &fixture.bob.ID,
+			enclosureID:  &fixture.enclosure.ID,
+			at:           day(2),
+			want:         fixture.bob,
+			wantExplicit: true,
+		},
+		{name: "assignee outside an enclosure", assignee: &fixture.ann.ID, at: day(2), want: fixture.ann, wantExplicit: true},
+		{name: "missing assignee falls back to the keeper on duty", assignee: &missing, enclosureID: &fixture.enclosure.ID, at: day(2), want: fixture.ann},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keeper, explicit := TaskAssignee(tt.assignee, tt.enclosureID, fixture.keepers, fixture.shifts, tt.at)
+
+			assert.Equal(t, tt.want, keeper)
+			assert.Equal(t, tt.wantExplicit, explicit)
+		})
+	}
+}
+
+func TestKeeperTasks(t *testing.T) {
+	fixture := newKeeperTasksFixture(t)
+
+	// The feedings at 10:00 are assigned to Bob although Ann is on duty
+	schedule := newRecurringSchedule(t, "FREQ=DAILY")
+	schedule.ID = FeedingScheduleID(uuid.New())
+	schedule.Animal = newPlacedAnimal(1, "Zebra", fixture.enclosure)
+	schedule.Assignee = &fixture.bob.ID
+
+	cleaning := newDailyCareTask(t, TaskKindCleaning, fixture.enclosure.ID, day(1))
+	checkup := newDailyCareTask(t, TaskKindCheckup, fixture.enclosure.ID, day(1))
+	nightCheckup := newDailyCareTask(t, TaskKindCheckup, fixture.enclosure.ID, day(1).Add(13*time.Hour))
+
+	require.NoError(t, cleaning.CompleteOccurrence(day(2)))
+
+	period := TimeSlot{Start: day(2).Add(-10 * time.Hour), End: day(3).Add(-10 * time.Hour)}
+	schedules := []*FeedingSchedule{schedule}
+	careTasks := []*CareTask{nightCheckup, cleaning, checkup}
+
+	type task struct {
+		kind     TaskKind
+		id       uuid.UUID
+		time     time.Time
+		status   TaskStatus
+		explicit bool
+	}
+
+	describe := func(tasks []KeeperTask) []task {
+		described := make([]task, len(tasks))
+		for i, kt := range tasks {
+			described[i] = task{kt.Kind, kt.TaskID, kt.Time, kt.Status, kt.Explicit}
+		}
+
+		return described
+	}
+
+	// Tasks at the same time are ordered by kind
+	assert.Equal(t, []task{
+		{TaskKindCheckup, checkup.ID.UUID(), day(2), TaskStatusPending, false},
+		{TaskKindCleaning, cleaning.ID.UUID(), day(2), TaskStatusDone, false},
+	}, describe(KeeperTasks(fixture.ann.ID, period, schedules, careTasks, fixture.keepers, fixture.shifts)))
+
+	assert.Equal(t, []task{
+		{TaskKindFeeding, schedule.ID.UUID(), day(2), TaskStatusPending, true},
+		{TaskKindCheckup, nightCheckup.ID.UUID(), day(2).Add(13 * time.Hour), TaskStatusPending, false},
+	}, describe(KeeperTasks(fixture.bob.ID, period, schedules, careTasks, fixture.keepers, fixture.shifts)))
+
+	assert.Empty(t, KeeperTasks(KeeperID(uuid.New()), period, schedules, careTasks, fixture.keepers, fixture.shifts))
+}
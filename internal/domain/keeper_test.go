@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckKeepersQualifiedForSpecies(t *testing.T) {
+	savanna := newTestEnclosure(1, EnclosureTypeSavanna, 5)
+	forest := newTestEnclosure(2, EnclosureTypeForest, 5)
+	newPlacedAnimal(1, "Lion", savanna)
+	newPlacedAnimal(2, "Wolf", forest)
+
+	keeper, err := NewKeeper("Ann", []AnimalSpecies{"Wolf"}, 2)
+	require.NoError(t, err)
+	keeper.Assignments = []KeeperAssignment{{EnclosureID: savanna.ID, Shift: "Day"}, {EnclosureID: forest.ID, Shift: "Day"}}
+
+	enclosures := []*Enclosure{savanna, forest}
+	keepers := []*Keeper{keeper}
+
+	// Nobody needs a qualification for species that are not predators
+	require.NoError(t, CheckKeepersQualifiedForSpecies("Lion", enclosures, keepers, SpeciesCatalog{}))
+
+	predators := NewSpeciesCatalog([]*Species{{Name: "Lion", Predator: true}, {Name: "Wolf", Predator: true}})
+
+	require.ErrorIs(t, CheckKeepersQualifiedForSpecies("Lion", enclosures, keepers, predators), ErrKeeperNotQualified)
+	require.NoError(t, CheckKeepersQualifiedForSpecies("Wolf", enclosures, keepers, predators))
+	require.NoError(t, CheckKeepersQualifiedForSpecies("Tiger", enclosures, keepers, predators))
+
+	keeper.Assignments = keeper.Assignments[1:]
+
+	require.NoError(t, CheckKeepersQualifiedForSpecies("Lion", enclosures, keepers, predators))
+}
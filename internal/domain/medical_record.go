@@ -0,0 +1,221 @@
+package domain
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEmptyTreatment    = NewInvariantError("empty_treatment", "treatment description cannot be empty")
+	ErrEmptyDiagnosis    = NewInvariantError("empty_diagnosis", "diagnosis description cannot be empty")
+	ErrEmptyVet          = NewInvariantError("empty_vet", "vet cannot be empty")
+	ErrInvalidMedication = NewInvariantError("invalid_medication", "medication must have a name and a dosage")
+	ErrUnknownDiagnosis  = NewInvariantError("unknown_diagnosis", "diagnosis is not in the animal's medical record")
+	ErrEmptyReporter     = NewInvariantError("empty_reporter", "reporter cannot be empty")
+	ErrInvalidSeverity   = NewInvariantError("invalid_severity", "severity must be one of Mild, Moderate, Severe, Critical")
+)
+
+type (
+	DiagnosisID          uuid.UUID
+	TreatmentID          uuid.UUID
+	IllnessID            uuid.UUID
+	DiagnosisDescription string
+	TreatmentDescription string
+	VetName              string
+	ReporterName         string
+	IllnessSeverity      string
+)
+
+const (
+	IllnessSeverityMild     IllnessSeverity = "Mild"
+	IllnessSeverityModerate IllnessSeverity = "Moderate"
+	IllnessSeveritySevere   IllnessSeverity = "Severe"
+	IllnessSeverityCritical IllnessSeverity = "Critical"
+)
+
+func (s IllnessSeverity) IsValid() bool {
+	switch s {
+	case IllnessSeverityMild, IllnessSeverityModerate, IllnessSeveritySevere, IllnessSeverityCritical:
+		return true
+	default:
+		return false
+	}
+}
+
+func (did DiagnosisID) String() string {
+	return uuid.UUID(did).String()
+}
+
+func (did DiagnosisID) UUID() uuid.UUID {
+	return uuid.UUID(did)
+}
+
+func (tid TreatmentID) String() string {
+	return uuid.UUID(tid).String()
+}
+
+func (tid TreatmentID) UUID() uuid.UUID {
+	return uuid.UUID(tid)
+}
+
+func (iid IllnessID) String() string {
+	return uuid.UUID(iid).String()
+}
+
+func (iid IllnessID) UUID() uuid.UUID {
+	return uuid.UUID(iid)
+}
+
+func (iid IllnessID) MarshalText() ([]byte, error) {
+	return uuid.UUID(iid).MarshalText()
+}
+
+func (iid *IllnessID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(iid).UnmarshalText(data)
+}
+
+func (tid TreatmentID) MarshalText() ([]byte, error) {
+	return uuid.UUID(tid).MarshalText()
+}
+
+func (tid *TreatmentID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(tid).UnmarshalText(data)
+}
+
+// Value Object.
+type Medication struct {
+	Name   string
+	Dosage string
+}
+
+// Value Object.
+type Diagnosis struct {
+	ID          DiagnosisID
+	Description DiagnosisDescription
+	Vet         VetName
+	DiagnosedAt time.Time
+}
+
+// Value Object.
+type Treatment struct {
+	ID          TreatmentID
+	Description TreatmentDescription
+	Vet         VetName
+	Medications []Medication
+	// DiagnosisID is the diagnosis the treatment addresses, nil if unspecified.
+	DiagnosisID *DiagnosisID
+	TreatedAt   time.Time
+}
+
+// Value Object. Illness is a report that an animal fell ill, made by a keeper or a vet.
+type Illness struct {
+	ID         IllnessID
+	Diagnosis  DiagnosisDescription
+	Severity   IllnessSeverity
+	ReportedBy ReporterName
+	ReportedAt time.Time
+}
+
+func (i Illness) validate() error {
+	if i.Diagnosis == "" {
+		return ErrEmptyDiagnosis
+	}
+
+	if !i.Severity.IsValid() {
+		return ErrInvalidSeverity
+	}
+
+	if i.ReportedBy == "" {
+		return ErrEmptyReporter
+	}
+
+	return nil
+}
+
+// MedicalRecord is the medical history of a single animal. It is identified by the animal's ID.
+type MedicalRecord struct {
+	AnimalID   AnimalID
+	OpenedAt   time.Time
+	Illnesses  []Illness
+	Diagnoses  []Diagnosis
+	Treatments []Treatment
+	// Administrations log the doses of drugs given as part of the treatments.
+	Administrations []DrugAdministration
+}
+
+func NewMedicalRecord(animalID AnimalID, openedAt time.Time) *MedicalRecord {
+	return &MedicalRecord{
+		AnimalID: animalID,
+		OpenedAt: openedAt,
+	}
+}
+
+func (mr *MedicalRecord) AddIllness(illness Illness) error {
+	if err := illness.validate(); err != nil {
+		return err
+	}
+
+	mr.Illnesses = append(mr.Illnesses, illness)
+
+	return nil
+}
+
+func (mr *MedicalRecord) AddDiagnosis(diagnosis Diagnosis) error {
+	if diagnosis.Description == "" {
+		return ErrEmptyDiagnosis
+	}
+
+	if diagnosis.Vet == "" {
+		return ErrEmptyVet
+	}
+
+	mr.Diagnoses = append(mr.Diagnoses, diagnosis)
+
+	return nil
+}
+
+func (mr *MedicalRecord) AddTreatment(treatment Treatment) error {
+	if treatment.Description == "" {
+		return ErrEmptyTreatment
+	}
+
+	if treatment.Vet == "" {
+		return ErrEmptyVet
+	}
+
+	for _, medication := range treatment.Medications {
+		if medication.Name == "" || medication.Dosage == "" {
+			return ErrInvalidMedication
+		}
+	}
+
+	if treatment.DiagnosisID != nil && !mr.hasDiagnosis(*treatment.DiagnosisID) {
+		return ErrUnknownDiagnosis
+	}
+
+	treatment.Medications = slices.Clone(treatment.Medications)
+	mr.Treatments = append(mr.Treatments, treatment)
+
+	return nil
+}
+
+// Clone returns a deep copy of the record.
+func (mr *MedicalRecord) Clone() *MedicalRecord {
+	cloned := *mr
+	cloned.Illnesses = slices.Clone(mr.Illnesses)
+	cloned.Diagnoses = slices.Clone(mr.Diagnoses)
+	cloned.Treatments = slices.Clone(mr.Treatments)
+	cloned.Administrations = slices.Clone(mr.Administrations)
+
+	for i, treatment := range cloned.Treatments {
+		cloned.Treatments[i].Medications = slices.Clone(treatment.Medications)
+	}
+
+	return &cloned
+}
+
+func (mr *MedicalRecord) hasDiagnosis(id DiagnosisID) bool {
+	return slices.ContainsFunc(mr.Diagnoses, func(d Diagnosis) bool { return d.ID == id })
+}
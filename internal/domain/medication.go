@@ -0,0 +1,310 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEmptyDrugName          = NewInvariantError("empty_drug_name", "drug name cannot be empty")
+	ErrInvalidDosage          = NewInvariantError("invalid_dosage", "dosage must be positive, its minimum cannot exceed its maximum and the daily maximum cannot be below the maximum")
+	ErrDuplicateDosage        = NewInvariantError("duplicate_dosage", "drug has several dosages for the same species")
+	ErrDrugNotForSpecies      = NewInvariantError("drug_not_for_species", "drug has no dosage for the animal's species")
+	ErrInvalidDose            = NewInvariantError("invalid_dose", "dose must be positive")
+	ErrDoseOutOfRange         = NewInvariantError("dose_out_of_range", "dose is outside the range for the animal's weight")
+	ErrAdministrationInFuture = NewInvariantError("administration_in_future", "administration cannot be recorded in the future")
+	ErrUnknownTreatment       = NewInvariantError("unknown_treatment", "treatment is not in the animal's medical record")
+	ErrDailyDoseExceeded      = NewConflictError("daily_dose_exceeded", "dose would exceed the maximum daily dose")
+	ErrDrugContraindicated    = NewConflictError("drug_contraindicated", "drug is contraindicated for the animal")
+	ErrAnimalNotWeighed       = NewConflictError("animal_not_weighed", "animal has not been weighed")
+)
+
+// DailyDoseWindow is the period the maximum daily dose limits the total given within.
+const DailyDoseWindow = 24 * time.Hour
+
+// doseAmountPrecision is the number of decimal places doses are kept to, so that a dose equal to
+// a bound of the range is not rejected for a floating point error in the weight-based calculation.
+const doseAmountPrecision = 1e6
+
+func roundMg(amount float64) float64 {
+	return math.Round(amount*doseAmountPrecision) / doseAmountPrecision
+}
+
+type (
+	DrugName             string
+	DrugAdministrationID uuid.UUID
+)
+
+func (id DrugAdministrationID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func (id DrugAdministrationID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id DrugAdministrationID) MarshalText() ([]byte, error) {
+	return uuid.UUID(id).MarshalText()
+}
+
+func (id *DrugAdministrationID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
+
+// Value Object. SpeciesDosage is the dosage of a drug for a species in milligrams per kilogram of body weight.
+type SpeciesDosage struct {
+	Species    AnimalSpecies
+	MinMgPerKg float64
+	MaxMgPerKg float64
+	// MaxDailyMgPerKg limits the total given within DailyDoseWindow, 0 if only single doses are limited.
+	MaxDailyMgPerKg float64
+}
+
+func (sd SpeciesDosage) validate() error {
+	if strings.TrimSpace(string(sd.Species)) == "" {
+		return ErrEmptySpeciesName
+	}
+
+	if sd.MinMgPerKg <= 0 || sd.MaxMgPerKg < sd.MinMgPerKg {
+		return fmt.Errorf("%w: %s", ErrInvalidDosage, sd.Species)
+	}
+
+	if sd.MaxDailyMgPerKg != 0 && sd.MaxDailyMgPerKg < sd.MaxMgPerKg {
+		return fmt.Errorf("%w: %s", ErrInvalidDosage, sd.Species)
+	}
+
+	return nil
+}
+
+// Drug is an entry of the drug formulary. It is identified by its name.
+type Drug struct {
+	Name DrugName
+	// Dosages list the species the drug may be given to, one dosage per species.
+	Dosages []SpeciesDosage
+	// Contraindications are conditions ruling the drug out. An animal has one if a diagnosis or an illness
+	// in its medical record mentions it, ignoring case.
+	Contraindications []string
+}
+
+func NewDrug(name DrugName, dosages []SpeciesDosage, contraindications []string) (*Drug, error) {
+	if strings.TrimSpace(string(name)) == "" {
+		return nil, ErrEmptyDrugName
+	}
+
+	seen := make(map[AnimalSpecies]struct{}, len(dosages))
+
+	for _, dosage := range dosages {
+		if err := dosage.validate(); err != nil {
+			return nil, err
+		}
+
+		if _, ok := seen[dosage.Species]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateDosage, dosage.Species)
+		}
+
+		seen[dosage.Species] = struct{}{}
+	}
+
+	drug := &Drug{
+		Name:    name,
+		Dosages: slices.Clone(dosages),
+	}
+
+	for _, condition := range contraindications {
+		if condition = strings.TrimSpace(condition); condition != "" {
+			drug.Contraindications = append(drug.Contraindications, condition)
+		}
+	}
+
+	return drug, nil
+}
+
+// Clone returns a deep copy of the drug.
+func (d *Drug) Clone() *Drug {
+	cloned := *d
+	cloned.Dosages = slices.Clone(d.Dosages)
+	cloned.Contraindications = slices.Clone(d.Contraindications)
+
+	return &cloned
+}
+
+// Dosage returns the dosage of the drug for the species.
+func (d *Drug) Dosage(species AnimalSpecies) (SpeciesDosage, bool) {
+	i := slices.IndexFunc(d.Dosages, func(dosage SpeciesDosage) bool { return dosage.Species == species })
+	if i < 0 {
+		return SpeciesDosage{}, false
+	}
+
+	return d.Dosages[i], true
+}
+
+// Value Object. DoseRange is the range of a single dose of a drug for an animal, in milligrams.
+type DoseRange struct {
+	Drug DrugName
+	// Weight is the body weight in kilograms the range is calculated for, taken at WeighedAt.
+	Weight    float64
+	WeighedAt time.Time
+	MinMg     float64
+	MaxMg     float64
+	// MaxDailyMg limits the total given within any DailyDoseWindow, 0 if unlimited.
+	MaxDailyMg float64
+	// GivenMg is the largest amount of the drug already given within a DailyDoseWindow the dose falls into.
+	GivenMg float64
+}
+
+// RemainingDailyMg returns how much of the drug may still be given without exceeding the maximum in any window.
+// It is meaningful only if the daily dose is limited.
+func (dr DoseRange) RemainingDailyMg() float64 {
+	return max(dr.MaxDailyMg-dr.GivenMg, 0)
+}
+
+// Check returns an error if the dose is outside the range or would exceed the maximum daily dose.
+func (dr DoseRange) Check(doseMg float64) error {
+	if doseMg <= 0 {
+		return ErrInvalidDose
+	}
+
+	if doseMg < dr.MinMg || doseMg > dr.MaxMg {
+		return fmt.Errorf("%w: %g mg of %s, allowed %g to %g mg for %g kg",
+			ErrDoseOutOfRange, doseMg, dr.Drug, dr.MinMg, dr.MaxMg, dr.Weight)
+	}
+
+	if dr.MaxDailyMg > 0 && dr.GivenMg+doseMg > dr.MaxDailyMg {
+		return fmt.Errorf("%w: %g mg of %s already given, %g mg more allowed today",
+			ErrDailyDoseExceeded, dr.GivenMg, dr.Drug, dr.RemainingDailyMg())
+	}
+
+	return nil
+}
+
+// CalculateDose returns the dose range of the drug for the animal at its weight as of at.
+// The record is the animal's medical record, nil if it has none. It fails if the drug has no dosage
+// for the species or the record shows a contraindication.
+func (d *Drug) CalculateDose(animal *Animal, weight WeightMeasurement, record *MedicalRecord, at time.Time) (DoseRange, error) {
+	dosage, ok := d.Dosage(animal.Species)
+	if !ok {
+		return DoseRange{}, fmt.Errorf("%w: %s for %s", ErrDrugNotForSpecies, d.Name, animal.Species)
+	}
+
+	doseRange := DoseRange{
+		Drug:       d.Name,
+		Weight:     weight.Weight,
+		WeighedAt:  weight.MeasuredAt,
+		MinMg:      roundMg(dosage.MinMgPerKg * weight.Weight),
+		MaxMg:      roundMg(dosage.MaxMgPerKg * weight.Weight),
+		MaxDailyMg: roundMg(dosage.MaxDailyMgPerKg * weight.Weight),
+	}
+
+	if record == nil {
+		return doseRange, nil
+	}
+
+	if condition, ok := record.findCondition(d.Contraindications); ok {
+		return DoseRange{}, fmt.Errorf("%w: %s is contraindicated by %s", ErrDrugContraindicated, d.Name, condition)
+	}
+
+	doseRange.GivenMg = record.AdministeredAround(d.Name, at, DailyDoseWindow)
+
+	return doseRange, nil
+}
+
+// Value Object. DrugAdministration is a dose of a drug given to an animal as part of a treatment.
+type DrugAdministration struct {
+	ID          DrugAdministrationID
+	TreatmentID TreatmentID
+	Drug        DrugName
+	DoseMg      float64
+	// Weight is the body weight in kilograms the dose was checked against.
+	Weight         float64
+	AdministeredBy ReporterName
+	AdministeredAt time.Time
+}
+
+func (da DrugAdministration) validate() error {
+	if strings.TrimSpace(string(da.Drug)) == "" {
+		return ErrEmptyDrugName
+	}
+
+	if da.DoseMg <= 0 {
+		return ErrInvalidDose
+	}
+
+	if da.AdministeredBy == "" {
+		return ErrEmptyReporter
+	}
+
+	return nil
+}
+
+// AddAdministration logs a dose given as part of a treatment in the record.
+func (mr *MedicalRecord) AddAdministration(administration DrugAdministration) error {
+	if err := administration.validate(); err != nil {
+		return err
+	}
+
+	if !slices.ContainsFunc(mr.Treatments, func(t Treatment) bool { return t.ID == administration.TreatmentID }) {
+		return fmt.Errorf("%w: %s", ErrUnknownTreatment, administration.TreatmentID)
+	}
+
+	mr.Administrations = append(mr.Administrations, administration)
+
+	return nil
+}
+
+// AdministeredWithin returns the total of the drug given within (from, to] in milligrams.
+func (mr *MedicalRecord) AdministeredWithin(drug DrugName, from, to time.Time) float64 {
+	var total float64
+
+	for _, administration := range mr.Administrations {
+		if administration.Drug == drug && administration.AdministeredAt.After(from) && !administration.AdministeredAt.After(to) {
+			total += administration.DoseMg
+		}
+	}
+
+	return roundMg(total)
+}
+
+// AdministeredAround returns the largest total of the drug given within a window (s, s+window] containing at,
+// in milligrams. Doses may be logged after the fact, so the windows reaching past at are checked as well.
+func (mr *MedicalRecord) AdministeredAround(drug DrugName, at time.Time, window time.Duration) float64 {
+	// The total only grows when a later dose enters the window, so the windows ending at at
+	// and at each dose after it are the only ones to check
+	largest := mr.AdministeredWithin(drug, at.Add(-window), at)
+
+	for _, administration := range mr.Administrations {
+		if administration.Drug != drug || !administration.AdministeredAt.After(at) || administration.AdministeredAt.Sub(at) >= window {
+			continue
+		}
+
+		end := administration.AdministeredAt
+		largest = max(largest, mr.AdministeredWithin(drug, end.Add(-window), end))
+	}
+
+	return largest
+}
+
+// findCondition returns the first of the conditions mentioned by a diagnosis or an illness in the record.
+func (mr *MedicalRecord) findCondition(conditions []string) (string, bool) {
+	for _, condition := range conditions {
+		condition := strings.ToLower(condition)
+
+		for _, diagnosis := range mr.Diagnoses {
+			if strings.Contains(strings.ToLower(string(diagnosis.Description)), condition) {
+				return string(diagnosis.Description), true
+			}
+		}
+
+		for _, illness := range mr.Illnesses {
+			if strings.Contains(strings.ToLower(string(illness.Diagnosis)), condition) {
+				return string(illness.Diagnosis), true
+			}
+		}
+	}
+
+	return "", false
+}
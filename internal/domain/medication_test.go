@@ -0,0 +1,206 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDrug DrugName = "Meloxicam"
+
+// dose is a dose of testDrug given at the offset from the moment a test checks.
+type dose struct {
+	offset time.Duration
+	mg     float64
+}
+
+func recordWithDoses(doses ...dose) (*MedicalRecord, time.Time) {
+	at := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	record := &MedicalRecord{}
+
+	for _, d := range doses {
+		record.Administrations = append(record.Administrations, DrugAdministration{
+			Drug:           testDrug,
+			DoseMg:         d.mg,
+			AdministeredAt: at.Add(d.offset),
+		})
+	}
+
+	return record, at
+}
+
+func TestMedicalRecordAdministeredAround(t *testing.T) {
+	tests := []struct {
+		name  string
+		doses []dose
+		want  float64
+	}{
+		{name: "dose at the moment", doses: []dose{{0, 10}}, want: 10},
+		{name: "earlier doses within the window", doses: []dose{{-23 * time.Hour, 10}, {-time.Hour, 5}}, want: 15},
+		{name: "window start is exclusive", doses: []dose{{-DailyDoseWindow, 10}, {-time.Hour, 5}}, want: 5},
+		{name: "just after window start", doses: []dose{{-DailyDoseWindow + time.Second, 10}}, want: 10},
+		{name: "later dose counts for a back-dated one", doses: []dose{{23 * time.Hour, 10}}, want: 10},
+		{name: "later dose a window away does not count", doses: []dose{{DailyDoseWindow, 10}}, want: 0},
+		{
+			name:  "doses on both sides too far apart to share a window",
+			doses: []dose{{-20 * time.Hour, 10}, {20 * time.Hour, 10}},
+			want:  10,
+		},
+		{
+			name:  "doses on both sides sharing a window",
+			doses: []dose{{-10 * time.Hour, 30}, {10 * time.Hour, 30}},
+			want:  60,
+		},
+		{
+			name:  "the largest window wins",
+			doses: []dose{{-23 * time.Hour, 40}, {-2 * time.Hour, 5}, {2 * time.Hour, 5}, {3 * time.Hour, 5}},
+			want:  45,
+		},
+		{name: "only other drugs given", doses: nil, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record, at := recordWithDoses(tt.doses...)
+
+			record.Administrations = append(record.Administrations, DrugAdministration{
+				Drug:           "Amoxicillin",
+				DoseMg:         100,
+				AdministeredAt: at,
+			})
+
+			assert.InDelta(t, tt.want, record.AdministeredAround(testDrug, at, DailyDoseWindow), 1e-9)
+		})
+	}
+}
+
+func TestDoseRangeCheck(t *testing.T) {
+	doseRange := DoseRange{Drug: testDrug, Weight: 10, MinMg: 1, MaxMg: 5, MaxDailyMg: 12, GivenMg: 8}
+
+	tests := []struct {
+		name    string
+		given   float64
+		daily   float64
+		doseMg  float64
+		wantErr error
+	}{
+		{name: "zero dose", given: 0, daily: 12, doseMg: 0, wantErr: ErrInvalidDose},
+		{name: "negative dose", given: 0, daily: 12, doseMg: -1, wantErr: ErrInvalidDose},
+		{name: "below minimum", given: 0, daily: 12, doseMg: 0.5, wantErr: ErrDoseOutOfRange},
+		{name: "above maximum", given: 0, daily: 12, doseMg: 5.5, wantErr: ErrDoseOutOfRange},
+		{name: "at minimum", given: 0, daily: 12, doseMg: 1},
+		{name: "at maximum", given: 0, daily: 12, doseMg: 5},
+		{name: "up to the daily maximum", given: 8, daily: 12, doseMg: 4},
+		{name: "over the daily maximum", given: 8, daily: 12, doseMg: 4.5, wantErr: ErrDailyDoseExceeded},
+		{name: "daily maximum already reached", given: 12, daily: 12, doseMg: 1, wantErr: ErrDailyDoseExceeded},
+		{name: "unlimited daily dose", given: 100, daily: 0, doseMg: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doseRange.GivenMg, doseRange.MaxDailyMg = tt.given, tt.daily
+
+			err := doseRange.Check(tt.doseMg)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCalculateDose(t *testing.T) {
+	animal := &Animal{Species: "Lion"}
+
+	tests := []struct {
+		name    string
+		dosage  SpeciesDosage
+		weight  float64
+		doses   []dose
+		doseMg  float64
+		wantErr error
+	}{
+		{
+			// 0.1 * 3 is 0.30000000000000004 in floating point
+			name:   "minimum is rounded per kg",
+			dosage: SpeciesDosage{Species: "Lion", MinMgPerKg: 0.1, MaxMgPerKg: 1},
+			weight: 3,
+			doseMg: 0.3,
+		},
+		{
+			// 0.7 * 0.1 is 0.06999999999999999 in floating point
+			name:   "maximum is rounded per kg",
+			dosage: SpeciesDosage{Species: "Lion", MinMgPerKg: 0.1, MaxMgPerKg: 0.7},
+			weight: 0.1,
+			doseMg: 0.07,
+		},
+		{
+			name:    "dose over the maximum for the weight",
+			dosage:  SpeciesDosage{Species: "Lion", MinMgPerKg: 0.1, MaxMgPerKg: 0.5},
+			weight:  10,
+			doseMg:  5.1,
+			wantErr: ErrDoseOutOfRange,
+		},
+		{
+			name:    "back-dated dose exceeds the daily maximum with later doses",
+			dosage:  SpeciesDosage{Species: "Lion", MinMgPerKg: 1, MaxMgPerKg: 3, MaxDailyMgPerKg: 8},
+			weight:  10,
+			doses:   []dose{{5 * time.Hour, 30}, {6 * time.Hour, 30}},
+			doseMg:  30,
+			wantErr: ErrDailyDoseExceeded,
+		},
+		{
+			name:    "dose logged between two earlier doses exceeds the daily maximum",
+			dosage:  SpeciesDosage{Species: "Lion", MinMgPerKg: 1, MaxMgPerKg: 3, MaxDailyMgPerKg: 8},
+			weight:  10,
+			doses:   []dose{{-10 * time.Hour, 30}, {10 * time.Hour, 30}},
+			doseMg:  30,
+			wantErr: ErrDailyDoseExceeded,
+		},
+		{
+			name:   "doses a window apart do not add up",
+			dosage: SpeciesDosage{Species: "Lion", MinMgPerKg: 1, MaxMgPerKg: 3, MaxDailyMgPerKg: 8},
+			weight: 10,
+			doses:  []dose{{-DailyDoseWindow, 30}, {DailyDoseWindow, 30}},
+			doseMg: 30,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drug, err := NewDrug(testDrug, []SpeciesDosage{tt.dosage}, nil)
+			require.NoError(t, err)
+
+			record, at := recordWithDoses(tt.doses...)
+			weight := WeightMeasurement{Weight: tt.weight, MeasuredAt: at.Add(-48 * time.Hour)}
+
+			doseRange, err := drug.CalculateDose(animal, weight, record, at)
+			require.NoError(t, err)
+
+			err = doseRange.Check(tt.doseMg)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCalculateDoseRejectsUnsuitableDrugs(t *testing.T) {
+	drug, err := NewDrug(testDrug, []SpeciesDosage{{Species: "Lion", MinMgPerKg: 1, MaxMgPerKg: 2}}, []string{"renal failure"})
+	require.NoError(t, err)
+
+	weight := WeightMeasurement{Weight: 100}
+
+	_, err = drug.CalculateDose(&Animal{Species: "Zebra"}, weight, nil, time.Now())
+	require.ErrorIs(t, err, ErrDrugNotForSpecies)
+
+	record := &MedicalRecord{Diagnoses: []Diagnosis{{Description: "Acute Renal Failure"}}}
+
+	_, err = drug.CalculateDose(&Animal{Species: "Lion"}, weight, record, time.Now())
+	require.ErrorIs(t, err, ErrDrugContraindicated)
+}
@@ -0,0 +1,250 @@
+package domain
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strings"
+)
+
+var ErrNoSuitableEnclosure = NewConflictError("no_suitable_enclosure", "no enclosure can take the animal")
+
+// Value Object. Placement assigns an animal to an enclosure.
+type Placement struct {
+	Animal *Animal
+	// New reports that the animal arrives with the plan and does not exist yet.
+	New bool
+	// From is the enclosure the animal is in, nil if it is not placed yet.
+	From *EnclosureID
+	To   EnclosureID
+	Fit  float64
+}
+
+// Stays reports whether the animal is kept in its enclosure.
+func (p Placement) Stays() bool {
+	return p.From != nil && *p.From == p.To
+}
+
+// IsMove reports whether an existing animal changes its enclosure.
+func (p Placement) IsMove() bool {
+	return !p.New && !p.Stays()
+}
+
+// Value Object. UnplacedAnimal is an animal no enclosure can take.
+type UnplacedAnimal struct {
+	Animal *Animal
+	New    bool
+	Reason error
+}
+
+// PlacementPlan is a proposed assignment of animals to enclosures. Its placements are ordered
+// so that carrying them out one by one never overfills an enclosure or breaks its rules.
+type PlacementPlan struct {
+	Placements []Placement
+	Unplaced   []UnplacedAnimal
+}
+
+// Moves returns the number of existing animals that change their enclosure.
+func (pp *PlacementPlan) Moves() int {
+	moves := 0
+
+	for _, placement := range pp.Placements {
+		if placement.IsMove() {
+			moves++
+		}
+	}
+
+	return moves
+}
+
+// PlanPlacement assigns the existing animals and the arriving ones to the enclosures, respecting their
+// capacity, area and habitat and the compatibility rules of the catalog. To keep the number of moves low,
+// existing animals stay in their enclosures whenever the rules allow it; the others are placed greedily,
+// those with the fewest suitable enclosures first, each into its best ranked enclosure.
+// The enclosures are not modified.
+func (sc SpeciesCatalog) PlanPlacement(animals []*Animal, arrivals []*Animal, enclosures []*Enclosure) *PlacementPlan {
+	simulation := newPlacementSimulation(enclosures)
+	plan := &PlacementPlan{}
+
+	existing := slices.Clone(animals)
+	slices.SortFunc(existing, compareAnimals)
+
+	// The planned animals are taken out first so that they are placed against each other as well as the residents
+	for _, animal := range existing {
+		simulation.remove(animal)
+	}
+
+	var pending []*Animal
+
+	for _, animal := range existing {
+		from := simulation.enclosureOf(animal)
+		if from == nil || from.CheckPlacement(animal, sc) != nil {
+			pending = append(pending, animal)
+			continue
+		}
+
+		from.Occupancy.Animals[animal] = struct{}{}
+		fromID := from.ID
+		plan.Placements = append(plan.Placements, Placement{
+			Animal: animal,
+			From:   &fromID,
+			To:     fromID,
+			Fit:    sc.HabitatFit(animal, from),
+		})
+	}
+
+	newAnimals := make(map[*Animal]bool, len(arrivals))
+	for _, animal := range arrivals {
+		newAnimals[animal] = true
+		pending = append(pending, animal)
+	}
+
+	candidates := make(map[*Animal]int, len(pending))
+	for _, animal := range pending {
+		candidates[animal] = len(sc.RankEnclosures(animal, simulation.enclosures))
+	}
+
+	slices.SortStableFunc(pending, func(a, b *Animal) int {
+		return cmp.Or(cmp.Compare(candidates[a], candidates[b]), compareAnimals(a, b))
+	})
+
+	for _, animal := range pending {
+		ranked := sc.RankEnclosures(animal, simulation.enclosures)
+		if len(ranked) == 0 {
+			plan.Unplaced = append(plan.Unplaced, UnplacedAnimal{
+				Animal: animal,
+				New:    newAnimals[animal],
+				Reason: ErrNoSuitableEnclosure,
+			})
+
+			// An existing animal that cannot be moved keeps its place
+			if from := simulation.enclosureOf(animal); from != nil {
+				from.Occupancy.Animals[animal] = struct{}{}
+			}
+
+			continue
+		}
+
+		best := ranked[0]
+		best.Enclosure.Occupancy.Animals[animal] = struct{}{}
+
+		placement := Placement{
+			Animal: animal,
+			New:    newAnimals[animal],
+			To:     best.Enclosure.ID,
+			Fit:    best.Fit,
+		}
+
+		if animal.Enclosure != nil {
+			from := animal.Enclosure.ID
+			placement.From = &from
+		}
+
+		plan.Placements = append(plan.Placements, placement)
+	}
+
+	plan.Placements = sc.orderPlacements(plan.Placements, enclosures)
+
+	return plan
+}
+
+// orderPlacements orders the placements so that every one of them can be carried out once the previous
+// ones are: animals leave their enclosures before others take their place. Placements that depend on
+// each other in a cycle cannot be ordered and are left at the end.
+func (sc SpeciesCatalog) orderPlacements(placements []Placement, enclosures []*Enclosure) []Placement {
+	simulation := newPlacementSimulation(enclosures)
+	ordered := make([]Placement, 0, len(placements))
+	pending := make([]Placement, 0, len(placements))
+
+	for _, placement := range placements {
+		if placement.Stays() {
+			ordered = append(ordered, placement)
+		} else {
+			pending = append(pending, placement)
+		}
+	}
+
+	for progress := true; progress && len(pending) > 0; {
+		progress = false
+		remaining := pending[:0]
+
+		for _, placement := range pending {
+			to := simulation.byID[placement.To]
+			from := simulation.enclosureOf(placement.Animal)
+
+			simulation.remove(placement.Animal)
+
+			if to != nil && to.CheckPlacement(placement.Animal, sc) == nil {
+				to.Occupancy.Animals[placement.Animal] = struct{}{}
+				ordered = append(ordered, placement)
+				progress = true
+
+				continue
+			}
+
+			if from != nil {
+				from.Occupancy.Animals[placement.Animal] = struct{}{}
+			}
+
+			remaining = append(remaining, placement)
+		}
+
+		pending = remaining
+	}
+
+	return append(ordered, pending...)
+}
+
+// placementSimulation is a copy of the enclosures' occupancy that the planner is free to change.
+type placementSimulation struct {
+	enclosures []*Enclosure
+	byID       map[EnclosureID]*Enclosure
+}
+
+func newPlacementSimulation(enclosures []*Enclosure) *placementSimulation {
+	s := &placementSimulation{
+		enclosures: make([]*Enclosure, len(enclosures)),
+		byID:       make(map[EnclosureID]*Enclosure, len(enclosures)),
+	}
+
+	for i, enclosure := range enclosures {
+		cloned := *enclosure
+		cloned.Occupancy.Animals = maps.Clone(enclosure.Occupancy.Animals)
+
+		s.enclosures[i] = &cloned
+		s.byID[cloned.ID] = &cloned
+	}
+
+	return s
+}
+
+// enclosureOf returns the simulated enclosure the animal currently belongs to, nil if it has none.
+func (s *placementSimulation) enclosureOf(animal *Animal) *Enclosure {
+	for _, enclosure := range s.enclosures {
+		for resident := range enclosure.Occupancy.Animals {
+			if resident.ID == animal.ID {
+				return enclosure
+			}
+		}
+	}
+
+	if animal.Enclosure != nil {
+		return s.byID[animal.Enclosure.ID]
+	}
+
+	return nil
+}
+
+func (s *placementSimulation) remove(animal *Animal) {
+	for _, enclosure := range s.enclosures {
+		for resident := range enclosure.Occupancy.Animals {
+			if resident.ID == animal.ID {
+				delete(enclosure.Occupancy.Animals, resident)
+			}
+		}
+	}
+}
+
+func compareAnimals(a, b *Animal) int {
+	return strings.Compare(a.ID.String(), b.ID.String())
+}
@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testAnimalID returns an animal ID that sorts by n, so that the planner's tie-breaks are predictable.
+func testAnimalID(n byte) AnimalID {
+	return AnimalID(uuid.UUID{15: n})
+}
+
+func newTestEnclosure(n byte, enclosureType EnclosureType, capacity int) *Enclosure {
+	return &Enclosure{
+		ID:        EnclosureID(uuid.UUID{15: n}),
+		Type:      enclosureType,
+		Size:      1000,
+		Occupancy: EnclosureOccupancy{Capacity: capacity, Animals: make(map[*Animal]struct{})},
+	}
+}
+
+func newPlacedAnimal(n byte, species AnimalSpecies, enclosure *Enclosure) *Animal {
+	animal := &Animal{ID: testAnimalID(n), Species: species, Gender: Female, Status: AnimalStatusHealthy}
+
+	if enclosure != nil {
+		animal.Enclosure = enclosure
+		enclosure.Occupancy.Animals[animal] = struct{}{}
+	}
+
+	return animal
+}
+
+// placementsOf describes the placements as "animal->enclosure" pairs of their test numbers.
+func placementsOf(placements []Placement) [][2]byte {
+	described := make([][2]byte, len(placements))
+	for i, placement := range placements {
+		described[i] = [2]byte{uuid.UUID(placement.Animal.ID)[15], uuid.UUID(placement.To)[15]}
+	}
+
+	return described
+}
+
+func TestOrderPlacements(t *testing.T) {
+	t.Run("animals leave before others take their place", func(t *testing.T) {
+		a := newTestEnclosure(1, EnclosureTypeSavanna, 1)
+		b := newTestEnclosure(2, EnclosureTypeSavanna, 1)
+		c := newTestEnclosure(3, EnclosureTypeSavanna, 1)
+		x := newPlacedAnimal(1, "Zebra", a)
+		y := newPlacedAnimal(2, "Zebra", b)
+
+		ordered := SpeciesCatalog{}.orderPlacements([]Placement{
+			{Animal: x, From: &a.ID, To: b.ID},
+			{Animal: y, From: &b.ID, To: c.ID},
+		}, []*Enclosure{a, b, c})
+
+		assert.Equal(t, [][2]byte{{2, 3}, {1, 2}}, placementsOf(ordered))
+	})
+
+	t.Run("arrivals wait for the animals they replace", func(t *testing.T) {
+		a := newTestEnclosure(1, EnclosureTypeSavanna, 1)
+		b := newTestEnclosure(2, EnclosureTypeSavanna, 1)
+		x := newPlacedAnimal(1, "Zebra", a)
+		arrival := newPlacedAnimal(2, "Zebra", nil)
+
+		ordered := SpeciesCatalog{}.orderPlacements([]Placement{
+			{Animal: arrival, New: true, To: a.ID},
+			{Animal: x, From: &a.ID, To: b.ID},
+		}, []*Enclosure{a, b})
+
+		assert.Equal(t, [][2]byte{{1, 2}, {2, 1}}, placementsOf(ordered))
+	})
+
+	t.Run("animals leave before a species they cannot live with arrives", func(t *testing.T) {
+		catalog := NewSpeciesCatalog([]*Species{{Name: "Lion", Predator: true}})
+
+		a := newTestEnclosure(1, EnclosureTypeSavanna, 2)
+		b := newTestEnclosure(2, EnclosureTypeSavanna, 2)
+		zebra := newPlacedAnimal(1, "Zebra", a)
+		lion := newPlacedAnimal(2, "Lion", nil)
+
+		ordered := catalog.orderPlacements([]Placement{
+			{Animal: lion, New: true, To: a.ID},
+			{Animal: zebra, From: &a.ID, To: b.ID},
+		}, []*Enclosure{a, b})
+
+		assert.Equal(t, [][2]byte{{1, 2}, {2, 1}}, placementsOf(ordered))
+	})
+
+	t.Run("staying animals come first and a swap is left at the end", func(t *testing.T) {
+		a := newTestEnclosure(1, EnclosureTypeSavanna, 1)
+		b := newTestEnclosure(2, EnclosureTypeSavanna, 1)
+		c := newTestEnclosure(3, EnclosureTypeSavanna, 1)
+		x := newPlacedAnimal(1, "Zebra", a)
+		y := newPlacedAnimal(2, "Zebra", b)
+		z := newPlacedAnimal(3, "Zebra", c)
+
+		ordered := SpeciesCatalog{}.orderPlacements([]Placement{
+			{Animal: x, From: &a.ID, To: b.ID},
+			{Animal: y, From: &b.ID, To: a.ID},
+			{Animal: z, From: &c.ID, To: c.ID},
+		}, []*Enclosure{a, b, c})
+
+		assert.Equal(t, [][2]byte{{3, 3}, {1, 2}, {2, 1}}, placementsOf(ordered))
+	})
+
+	t.Run("enclosures are not modified", func(t *testing.T) {
+		a := newTestEnclosure(1, EnclosureTypeSavanna, 1)
+		b := newTestEnclosure(2, EnclosureTypeSavanna, 1)
+		x := newPlacedAnimal(1, "Zebra", a)
+
+		SpeciesCatalog{}.orderPlacements([]Placement{{Animal: x, From: &a.ID, To: b.ID}}, []*Enclosure{a, b})
+
+		assert.Contains(t, a.Occupancy.Animals, x)
+		assert.Empty(t, b.Occupancy.Animals)
+	})
+}
+
+func TestPlanPlacement(t *testing.T) {
+	catalog := NewSpeciesCatalog([]*Species{
+		{Name: "Camel", Habitat: HabitatRequirements{Climates: []Climate{ClimateArid}}},
+		{Name: "Giraffe", Habitat: HabitatRequirements{Climates: []Climate{ClimateTropical}}},
+		{Name: "Lion", Predator: true},
+	})
+
+	t.Run("animals that fit their enclosure stay", func(t *testing.T) {
+		savanna := newTestEnclosure(1, EnclosureTypeSavanna, 2)
+		desert := newTestEnclosure(2, EnclosureTypeDesert, 2)
+		giraffe := newPlacedAnimal(1, "Giraffe", savanna)
+		camel := newPlacedAnimal(2, "Camel", desert)
+
+		plan := catalog.PlanPlacement([]*Animal{giraffe, camel}, nil, []*Enclosure{savanna, desert})
+
+		assert.Equal(t, [][2]byte{{1, 1}, {2, 2}}, placementsOf(plan.Placements))
+		assert.Zero(t, plan.Moves())
+		assert.Empty(t, plan.Unplaced)
+	})
+
+	t.Run("moved animal leaves before an arrival takes its place", func(t *testing.T) {
+		savanna := newTestEnclosure(1, EnclosureTypeSavanna, 1)
+		desert := newTestEnclosure(2, EnclosureTypeDesert, 1)
+		// The camel no longer suits the savanna, the arriving giraffe suits only the savanna
+		camel := newPlacedAnimal(2, "Camel", savanna)
+		giraffe := newPlacedAnimal(1, "Giraffe", nil)
+
+		plan := catalog.PlanPlacement([]*Animal{camel}, []*Animal{giraffe}, []*Enclosure{savanna, desert})
+
+		require.Len(t, plan.Placements, 2)
+		assert.Equal(t, [][2]byte{{2, 2}, {1, 1}}, placementsOf(plan.Placements))
+		assert.True(t, plan.Placements[0].IsMove())
+		assert.Equal(t, savanna.ID, *plan.Placements[0].From)
+		assert.True(t, plan.Placements[1].New)
+		assert.Equal(t, 1, plan.Moves())
+
+		// The plan is only a proposal
+		assert.Contains(t, savanna.Occupancy.Animals, camel)
+		assert.Empty(t, desert.Occupancy.Animals)
+	})
+
+	t.Run("animals with fewer suitable enclosures are placed first", func(t *testing.T) {
+		savanna := newTestEnclosure(1, EnclosureTypeSavanna, 1)
+		rainforest := newTestEnclosure(2, EnclosureTypeRainforest, 1)
+		desert := newTestEnclosure(3, EnclosureTypeDesert, 1)
+		// The zebra suits every enclosure and sorts first, but is placed after the giraffes
+		zebra := newPlacedAnimal(1, "Zebra", nil)
+		first := newPlacedAnimal(2, "Giraffe", nil)
+		second := newPlacedAnimal(3, "Giraffe", nil)
+
+		plan := catalog.PlanPlacement(nil, []*Animal{zebra, first, second}, []*Enclosure{savanna, rainforest, desert})
+
+		assert.Equal(t, [][2]byte{{2, 1}, {3, 2}, {1, 3}}, placementsOf(plan.Placements))
+		assert.Empty(t, plan.Unplaced)
+	})
+
+	t.Run("animals without a suitable enclosure are unplaced and keep their place", func(t *testing.T) {
+		savanna := newTestEnclosure(1, EnclosureTypeSavanna, 2)
+		camel := newPlacedAnimal(1, "Camel", savanna)
+		lion := newPlacedAnimal(2, "Lion", nil)
+		zebra := newPlacedAnimal(3, "Zebra", nil)
+
+		plan := catalog.PlanPlacement([]*Animal{camel}, []*Animal{lion, zebra}, []*Enclosure{savanna})
+
+		// The camel keeps its place, so the zebra fits next to it and the lion does not
+		assert.Equal(t, [][2]byte{{3, 1}}, placementsOf(plan.Placements))
+		require.Len(t, plan.Unplaced, 2)
+
+		for _, unplaced := range plan.Unplaced {
+			assert.ErrorIs(t, unplaced.Reason, ErrNoSuitableEnclosure)
+			assert.Equal(t, unplaced.Animal == lion, unplaced.New)
+		}
+	})
+}
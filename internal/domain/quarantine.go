@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEmptyQuarantineReason       = NewInvariantError("empty_quarantine_reason", "quarantine reason cannot be empty")
+	ErrNotQuarantineEnclosure      = NewInvariantError("not_quarantine_enclosure", "enclosure is not a quarantine enclosure")
+	ErrNoQuarantineEnclosure       = NewConflictError("no_quarantine_enclosure", "no quarantine enclosure has free space")
+	ErrAnimalQuarantined           = NewConflictError("animal_quarantined", "animal is quarantined until cleared by a vet")
+	ErrAnimalNotQuarantined        = NewConflictError("animal_not_quarantined", "animal is not quarantined")
+	ErrQuarantineEnclosureReserved = NewConflictError("quarantine_enclosure_reserved", "only quarantined animals can be placed in a quarantine enclosure")
+	ErrQuarantineEnded             = NewConflictError("quarantine_ended", "quarantine has already been cleared")
+	ErrNoReleaseEnclosure          = NewInvariantError("no_release_enclosure", "animal had no enclosure before the quarantine, a target enclosure is required")
+)
+
+type (
+	QuarantineID     uuid.UUID
+	QuarantineReason string
+)
+
+func (qid QuarantineID) String() string {
+	return uuid.UUID(qid).String()
+}
+
+func (qid QuarantineID) UUID() uuid.UUID {
+	return uuid.UUID(qid)
+}
+
+func (qid QuarantineID) MarshalText() ([]byte, error) {
+	return uuid.UUID(qid).MarshalText()
+}
+
+func (qid *QuarantineID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(qid).UnmarshalText(data)
+}
+
+// Quarantine tracks the isolation of an animal from the moment it is moved into a quarantine
+// enclosure until a vet clears it.
+type Quarantine struct {
+	ID          QuarantineID
+	AnimalID    AnimalID
+	EnclosureID EnclosureID
+	// FromEnclosureID is the enclosure the animal was taken from, the zero ID if it had none.
+	FromEnclosureID EnclosureID
+	Reason          QuarantineReason
+	OrderedBy       VetName
+	StartedAt       time.Time
+	// EndedAt and ClearedBy are set once the quarantine is cleared.
+	EndedAt   *time.Time
+	ClearedBy VetName
+}
+
+func NewQuarantine(
+	id QuarantineID,
+	animalID AnimalID,
+	enclosureID EnclosureID,
+	fromEnclosureID EnclosureID,
+	reason QuarantineReason,
+	orderedBy VetName,
+	startedAt time.Time,
+) (*Quarantine, error) {
+	if reason == "" {
+		return nil, ErrEmptyQuarantineReason
+	}
+
+	if orderedBy == "" {
+		return nil, ErrEmptyVet
+	}
+
+	return &Quarantine{
+		ID:              id,
+		AnimalID:        animalID,
+		EnclosureID:     enclosureID,
+		FromEnclosureID: fromEnclosureID,
+		Reason:          reason,
+		OrderedBy:       orderedBy,
+		StartedAt:       startedAt,
+	}, nil
+}
+
+func (q *Quarantine) IsActive() bool {
+	return q.EndedAt == nil
+}
+
+// Clear ends the quarantine.
+func (q *Quarantine) Clear(vet VetName, at time.Time) error {
+	if !q.IsActive() {
+		return ErrQuarantineEnded
+	}
+
+	if vet == "" {
+		return ErrEmptyVet
+	}
+
+	q.EndedAt = &at
+	q.ClearedBy = vet
+
+	return nil
+}
+
+// ReleaseEnclosure returns the enclosure the animal leaves the quarantine for: the target, if given,
+// otherwise the enclosure it was taken from.
+func (q *Quarantine) ReleaseEnclosure(target *EnclosureID) (EnclosureID, error) {
+	if target != nil {
+		return *target, nil
+	}
+
+	if q.FromEnclosureID == EnclosureID(uuid.Nil) {
+		return EnclosureID{}, ErrNoReleaseEnclosure
+	}
+
+	return q.FromEnclosureID, nil
+}
+
+// Duration returns how long the quarantine lasted, or has lasted so far at now.
+func (q *Quarantine) Duration(now time.Time) time.Duration {
+	if q.EndedAt != nil {
+		return q.EndedAt.Sub(q.StartedAt)
+	}
+
+	return now.Sub(q.StartedAt)
+}
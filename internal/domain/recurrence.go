@@ -0,0 +1,313 @@
+package domain
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidRecurrence = NewInvariantError("invalid_recurrence", "recurrence rule is not valid")
+	ErrUnknownTimeZone   = NewInvariantError("unknown_time_zone", "time zone is not known")
+)
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceFrequencyHourly RecurrenceFrequency = "HOURLY"
+	RecurrenceFrequencyDaily  RecurrenceFrequency = "DAILY"
+	RecurrenceFrequencyWeekly RecurrenceFrequency = "WEEKLY"
+)
+
+// recurrenceUntilLayout is the RFC 5545 UTC date-time format used by UNTIL.
+const recurrenceUntilLayout = "20060102T150405Z"
+
+// maxRecurrenceGap bounds the number of consecutive candidates a rule may reject with BYDAY before
+// it is considered to have no more occurrences. Within a week's worth of hourly steps every weekday
+// a rule can ever produce comes up at least once.
+const maxRecurrenceGap = 7 * 24
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Value Object. Recurrence is a subset of an RFC 5545 RRULE: FREQ (HOURLY, DAILY or WEEKLY),
+// INTERVAL, BYDAY, COUNT and UNTIL. Occurrences keep the wall-clock time of the first one
+// in the rule's time zone, so a daily feeding stays at the same local hour across DST changes.
+type Recurrence struct {
+	Frequency RecurrenceFrequency
+	// Interval is the number of hours, days or weeks between occurrences.
+	Interval int
+	// ByDay limits occurrences to the weekdays. For weekly rules it lists the days of each week
+	// and defaults to the weekday of the first occurrence.
+	ByDay []time.Weekday
+	// Count is the total number of occurrences, 0 if unlimited.
+	Count int
+	// Until is the last moment an occurrence may happen, zero if unlimited.
+	Until    time.Time
+	Location *time.Location
+}
+
+// ParseRecurrence parses an RRULE such as "FREQ=WEEKLY;BYDAY=MO,TH" in the IANA time zone.
+// The "RRULE:" prefix is optional and an empty time zone means UTC.
+func ParseRecurrence(rule, timeZone string) (*Recurrence, error) {
+	location, err := LoadLocation(timeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Recurrence{Interval: 1, Location: location}
+
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	if rule == "" {
+		return nil, fmt.Errorf("%w: rule is empty", ErrInvalidRecurrence)
+	}
+
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidRecurrence, part)
+		}
+
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.Frequency = RecurrenceFrequency(strings.ToUpper(value))
+		case "INTERVAL":
+			if r.Interval, err = strconv.Atoi(value); err != nil || r.Interval < 1 {
+				return nil, fmt.Errorf("%w: INTERVAL must be a positive integer", ErrInvalidRecurrence)
+			}
+		case "COUNT":
+			if r.Count, err = strconv.Atoi(value); err != nil || r.Count < 1 {
+				return nil, fmt.Errorf("%w: COUNT must be a positive integer", ErrInvalidRecurrence)
+			}
+		case "UNTIL":
+			if r.Until, err = time.Parse(recurrenceUntilLayout, value); err != nil {
+				return nil, fmt.Errorf("%w: UNTIL must be a UTC date-time like 20250131T000000Z", ErrInvalidRecurrence)
+			}
+		case "BYDAY":
+			for _, code := range strings.Split(value, ",") {
+				day, ok := weekdayCodes[strings.ToUpper(code)]
+				if !ok {
+					return nil, fmt.Errorf("%w: unknown weekday %q", ErrInvalidRecurrence, code)
+				}
+
+				if !slices.Contains(r.ByDay, day) {
+					r.ByDay = append(r.ByDay, day)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("%w: %s is not supported", ErrInvalidRecurrence, key)
+		}
+	}
+
+	switch r.Frequency {
+	case RecurrenceFrequencyHourly, RecurrenceFrequencyDaily, RecurrenceFrequencyWeekly:
+	case "":
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalidRecurrence)
+	default:
+		return nil, fmt.Errorf("%w: FREQ must be one of HOURLY, DAILY, WEEKLY", ErrInvalidRecurrence)
+	}
+
+	if r.Count > 0 && !r.Until.IsZero() {
+		return nil, fmt.Errorf("%w: COUNT and UNTIL cannot be combined", ErrInvalidRecurrence)
+	}
+
+	slices.SortFunc(r.ByDay, func(a, b time.Weekday) int { return mondayOffset(a) - mondayOffset(b) })
+
+	return r, nil
+}
+
+// LoadLocation returns the IANA time zone, UTC if it is empty.
+func LoadLocation(timeZone string) (*time.Location, error) {
+	if timeZone == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownTimeZone, timeZone)
+	}
+
+	return location, nil
+}
+
+// String returns the rule in its canonical RRULE form, without the time zone.
+func (r *Recurrence) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if len(r.ByDay) > 0 {
+		codes := make([]string, len(r.ByDay))
+		for i, day := range r.ByDay {
+			codes[i] = WeekdayCode(day)
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(recurrenceUntilLayout))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// TimeZone returns the name of the time zone occurrences are generated in.
+func (r *Recurrence) TimeZone() string {
+	return r.Location.String()
+}
+
+// IsFinite reports whether the rule ends by itself.
+func (r *Recurrence) IsFinite() bool {
+	return r.Count > 0 || !r.Until.IsZero()
+}
+
+// Clone returns a deep copy of the rule.
+func (r *Recurrence) Clone() *Recurrence {
+	if r == nil {
+		return nil
+	}
+
+	cloned := *r
+	cloned.ByDay = slices.Clone(r.ByDay)
+
+	return &cloned
+}
+
+// Occurrences yields the occurrences of the rule starting at start in chronological order.
+// The sequence is infinite for rules without COUNT or UNTIL.
+func (r *Recurrence) Occurrences(start time.Time) iter.Seq[time.Time] {
+	return r.OccurrencesFrom(start, start)
+}
+
+// OccurrencesFrom yields the occurrences of the rule starting at start that are not before from.
+// Rules without COUNT jump close to from instead of walking from start, since no occurrence before it has to be counted.
+func (r *Recurrence) OccurrencesFrom(start, from time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		start = start.In(r.Location)
+		produced, gap := 0, 0
+
+		steps := 0
+		if r.Count == 0 {
+			steps = r.stepsBefore(start, from)
+		}
+
+		for candidate := range r.candidates(start, steps) {
+			if !r.Until.IsZero() && candidate.After(r.Until) {
+				return
+			}
+
+			if r.Frequency != RecurrenceFrequencyWeekly && len(r.ByDay) > 0 && !slices.Contains(r.ByDay, candidate.Weekday()) {
+				if gap++; gap > maxRecurrenceGap {
+					return
+				}
+
+				continue
+			}
+
+			gap = 0
+
+			if !candidate.Before(from) && !yield(candidate) {
+				return
+			}
+
+			if produced++; r.Count > 0 && produced >= r.Count {
+				return
+			}
+		}
+	}
+}
+
+// stepsBefore returns a number of intervals after start that still ends before the first occurrence not before from.
+// It errs on the early side, so that wall-clock shifts across DST changes cannot skip an occurrence.
+func (r *Recurrence) stepsBefore(start, from time.Time) int {
+	if !from.After(start) {
+		return 0
+	}
+
+	var elapsed int
+
+	switch r.Frequency {
+	case RecurrenceFrequencyHourly:
+		elapsed = int(from.Sub(start) / time.Hour)
+	case RecurrenceFrequencyDaily:
+		elapsed = civilDays(from.In(r.Location)) - civilDays(start)
+	case RecurrenceFrequencyWeekly:
+		elapsed = (civilDays(from.In(r.Location)) - civilDays(start)) / 7
+	}
+
+	return max((elapsed-1)/r.Interval, 0)
+}
+
+// candidates yields the times the rule's frequency and interval produce, before BYDAY, COUNT and UNTIL apply.
+// The first steps intervals after start are left out.
+func (r *Recurrence) candidates(start time.Time, steps int) iter.Seq[time.Time] {
+	wallClock := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), r.Location)
+	}
+
+	return func(yield func(time.Time) bool) {
+		switch r.Frequency {
+		case RecurrenceFrequencyHourly:
+			// Hours are elapsed time, so they are counted in UTC and only displayed in the time zone
+			step := time.Duration(r.Interval) * time.Hour
+			for t := start.Add(time.Duration(steps) * step); ; t = t.Add(step) {
+				if !yield(t.In(r.Location)) {
+					return
+				}
+			}
+		case RecurrenceFrequencyDaily:
+			for day := steps * r.Interval; ; day += r.Interval {
+				if !yield(wallClock(start.Year(), start.Month(), start.Day()+day)) {
+					return
+				}
+			}
+		case RecurrenceFrequencyWeekly:
+			days := r.ByDay
+			if len(days) == 0 {
+				days = []time.Weekday{start.Weekday()}
+			}
+
+			// Weeks start on Monday as the RFC 5545 default WKST
+			monday := start.Day() - mondayOffset(start.Weekday())
+
+			for week := steps * r.Interval; ; week += r.Interval {
+				for _, day := range days {
+					t := wallClock(start.Year(), start.Month(), monday+7*week+mondayOffset(day))
+					if t.Before(start) {
+						continue
+					}
+
+					if !yield(t) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// civilDays returns the number of calendar days from the Unix epoch to the date of t in its own location.
+func civilDays(t time.Time) int {
+	return int(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60))
+}
+
+func mondayOffset(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
@@ -0,0 +1,228 @@
+package domain
+
+import (
+	"iter"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// take returns up to n first values of the sequence.
+func take(seq iter.Seq[time.Time], n int) []time.Time {
+	var values []time.Time
+
+	for t := range seq {
+		if len(values) == n {
+			break
+		}
+
+		values = append(values, t)
+	}
+
+	return values
+}
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+
+	return parsed
+}
+
+func TestRecurrenceOccurrencesFrom(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     string
+		timeZone string
+		start    string
+		from     string
+		want     []string
+		// ends reports that the rule has no occurrences after want
+		ends bool
+	}{
+		{
+			name:     "daily keeps the local hour across spring DST change",
+			rule:     "FREQ=DAILY",
+			timeZone: "Europe/Berlin",
+			start:    "2025-03-29T09:00:00+01:00",
+			from:     "2025-03-29T09:00:00+01:00",
+			want:     []string{"2025-03-29T09:00:00+01:00", "2025-03-30T09:00:00+02:00", "2025-03-31T09:00:00+02:00"},
+		},
+		{
+			name:     "daily jump to from lands on the local hour after DST change",
+			rule:     "FREQ=DAILY",
+			timeZone: "Europe/Berlin",
+			start:    "2025-03-01T09:00:00+01:00",
+			from:     "2025-04-01T00:00:00+02:00",
+			want:     []string{"2025-04-01T09:00:00+02:00", "2025-04-02T09:00:00+02:00"},
+		},
+		{
+			name:     "hourly counts elapsed hours through the skipped hour",
+			rule:     "FREQ=HOURLY",
+			timeZone: "Europe/Berlin",
+			start:    "2025-03-30T00:30:00+01:00",
+			from:     "2025-03-30T00:30:00+01:00",
+			want:     []string{"2025-03-30T00:30:00+01:00", "2025-03-30T01:30:00+01:00", "2025-03-30T03:30:00+02:00"},
+		},
+		{
+			name:     "weekly keeps the local hour across autumn DST change",
+			rule:     "FREQ=WEEKLY",
+			timeZone: "Europe/Berlin",
+			start:    "2025-10-20T09:00:00+02:00",
+			from:     "2025-10-20T09:00:00+02:00",
+			want:     []string{"2025-10-20T09:00:00+02:00", "2025-10-27T09:00:00+01:00"},
+		},
+		{
+			name:  "daily with BYDAY skips other weekdays",
+			rule:  "FREQ=DAILY;BYDAY=MO,WE",
+			start: "2025-01-01T10:00:00Z", // Wednesday
+			from:  "2025-01-01T10:00:00Z",
+			want:  []string{"2025-01-01T10:00:00Z", "2025-01-06T10:00:00Z", "2025-01-08T10:00:00Z", "2025-01-13T10:00:00Z"},
+		},
+		{
+			name:  "daily with BYDAY the interval never reaches ends",
+			rule:  "FREQ=DAILY;INTERVAL=7;BYDAY=MO",
+			start: "2025-01-01T10:00:00Z", // Wednesday
+			from:  "2025-01-01T10:00:00Z",
+			want:  nil,
+			ends:  true,
+		},
+		{
+			name:  "hourly with BYDAY the interval never reaches ends",
+			rule:  "FREQ=HOURLY;INTERVAL=168;BYDAY=MO",
+			start: "2025-01-01T10:00:00Z",
+			from:  "2025-01-01T10:00:00Z",
+			want:  nil,
+			ends:  true,
+		},
+		{
+			name:  "weekly with BYDAY",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR",
+			start: "2025-01-01T10:00:00Z", // Wednesday
+			from:  "2025-01-01T10:00:00Z",
+			want:  []string{"2025-01-03T10:00:00Z", "2025-01-13T10:00:00Z", "2025-01-17T10:00:00Z"},
+		},
+		{
+			name:  "COUNT counts the occurrences before from",
+			rule:  "FREQ=DAILY;COUNT=3",
+			start: "2025-01-01T10:00:00Z",
+			from:  "2025-01-02T12:00:00Z",
+			want:  []string{"2025-01-03T10:00:00Z"},
+			ends:  true,
+		},
+		{
+			name:  "COUNT counts only occurrences BYDAY lets through",
+			rule:  "FREQ=DAILY;BYDAY=SA,SU;COUNT=3",
+			start: "2025-01-01T10:00:00Z",
+			from:  "2025-01-01T10:00:00Z",
+			want:  []string{"2025-01-04T10:00:00Z", "2025-01-05T10:00:00Z", "2025-01-11T10:00:00Z"},
+			ends:  true,
+		},
+		{
+			name:  "UNTIL is inclusive",
+			rule:  "FREQ=DAILY;UNTIL=20250103T100000Z",
+			start: "2025-01-01T10:00:00Z",
+			from:  "2025-01-01T10:00:00Z",
+			want:  []string{"2025-01-01T10:00:00Z", "2025-01-02T10:00:00Z", "2025-01-03T10:00:00Z"},
+			ends:  true,
+		},
+		{
+			name:  "UNTIL with from after start",
+			rule:  "FREQ=DAILY;UNTIL=20250103T100000Z",
+			start: "2025-01-01T10:00:00Z",
+			from:  "2025-01-02T12:00:00Z",
+			want:  []string{"2025-01-03T10:00:00Z"},
+			ends:  true,
+		},
+		{
+			name:  "from before start",
+			rule:  "FREQ=DAILY;COUNT=2",
+			start: "2025-01-01T10:00:00Z",
+			from:  "2024-12-01T00:00:00Z",
+			want:  []string{"2025-01-01T10:00:00Z", "2025-01-02T10:00:00Z"},
+			ends:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recurrence, err := ParseRecurrence(tt.rule, tt.timeZone)
+			require.NoError(t, err)
+
+			start, from := mustParseTime(t, tt.start), mustParseTime(t, tt.from)
+
+			n := len(tt.want)
+			if tt.ends {
+				n++
+			}
+
+			got := take(recurrence.OccurrencesFrom(start, from), n)
+			require.Len(t, got, len(tt.want))
+
+			for i, want := range tt.want {
+				assert.True(t, mustParseTime(t, want).Equal(got[i]), "occurrence %d: want %s, got %s", i, want, got[i])
+			}
+
+			// Jumping close to from must give the same occurrences as walking from start
+			var walked []time.Time
+
+			for occurrence := range recurrence.Occurrences(start) {
+				if len(walked) == len(got) {
+					break
+				}
+
+				if !occurrence.Before(from) {
+					walked = append(walked, occurrence)
+				}
+			}
+
+			assert.Equal(t, walked, got)
+		})
+	}
+}
+
+func TestRecurrenceStepsBefore(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     string
+		timeZone string
+		start    string
+		from     string
+		want     int
+	}{
+		{name: "from before start", rule: "FREQ=DAILY", start: "2025-01-10T10:00:00Z", from: "2025-01-01T10:00:00Z", want: 0},
+		{name: "from at start", rule: "FREQ=DAILY", start: "2025-01-01T10:00:00Z", from: "2025-01-01T10:00:00Z", want: 0},
+		{name: "hourly", rule: "FREQ=HOURLY", start: "2025-01-01T00:00:00Z", from: "2025-01-01T10:30:00Z", want: 9},
+		{name: "hourly with interval", rule: "FREQ=HOURLY;INTERVAL=4", start: "2025-01-01T00:00:00Z", from: "2025-01-01T10:30:00Z", want: 2},
+		{name: "daily with interval", rule: "FREQ=DAILY;INTERVAL=2", start: "2025-01-01T10:00:00Z", from: "2025-01-11T09:00:00Z", want: 4},
+		{name: "weekly", rule: "FREQ=WEEKLY", start: "2025-01-01T10:00:00Z", from: "2025-01-29T10:00:00Z", want: 3},
+		{
+			name:     "daily counts calendar days of the time zone",
+			rule:     "FREQ=DAILY",
+			timeZone: "Asia/Tokyo",
+			start:    "2025-01-01T23:00:00+09:00",
+			from:     "2025-01-03T00:30:00+09:00",
+			want:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recurrence, err := ParseRecurrence(tt.rule, tt.timeZone)
+			require.NoError(t, err)
+
+			start := mustParseTime(t, tt.start).In(recurrence.Location)
+
+			assert.Equal(t, tt.want, recurrence.stepsBefore(start, mustParseTime(t, tt.from)))
+		})
+	}
+}
+
+func TestParseRecurrenceRejectsCountWithUntil(t *testing.T) {
+	_, err := ParseRecurrence("FREQ=DAILY;COUNT=3;UNTIL=20250103T100000Z", "")
+	require.ErrorIs(t, err, ErrInvalidRecurrence)
+}
@@ -15,6 +15,7 @@ type AnimalRepository interface {
 	CountAnimals(ctx context.Context) (count int, err error)
 	GetAnimalsByEnclosure(ctx context.Context, enclosureID EnclosureID) ([]*Animal, error)
 	CountHealthyAnimals(ctx context.Context) (count int, err error)
+	// CountSickAnimals returns the number of animals whose status IsSick.
 	CountSickAnimals(ctx context.Context) (count int, err error)
 }
 
@@ -33,6 +34,7 @@ type EnclosureRepository interface {
 
 type FeedingScheduleRepository interface {
 	GetFeedingSchedule(ctx context.Context, id FeedingScheduleID) (feedingSchedule *FeedingSchedule, err error)
+	GetFeedingScheduleWithHistory(ctx context.Context, id FeedingScheduleID, startTime, endTime time.Time) (*FeedingSchedule, error)
 	AddFeedingSchedule(ctx context.Context, feedingSchedule *FeedingSchedule) error
 	DeleteFeedingSchedule(ctx context.Context, id FeedingScheduleID) error
 	UpdateFeedingSchedule(ctx context.Context, feedingSchedule *FeedingSchedule) error
@@ -43,6 +45,106 @@ type FeedingScheduleRepository interface {
 	GetCompletedFeedingSchedules(ctx context.Context) ([]*FeedingSchedule, error)
 	GetPendingFeedingSchedules(ctx context.Context) ([]*FeedingSchedule, error)
 	GetFeedingSchedulesForTimeRange(ctx context.Context, startTime, endTime time.Time) ([]*FeedingSchedule, error)
+	GetDueFeedingSchedules(ctx context.Context, now time.Time) ([]*FeedingSchedule, error)
 	CountCompletedFeedingsToday(ctx context.Context, now time.Time) (int, error)
 	CountPendingFeedingsToday(ctx context.Context, now time.Time) (int, error)
 }
+
+type MedicalRecordRepository interface {
+	GetMedicalRecord(ctx context.Context, animalID AnimalID) (record *MedicalRecord, err error)
+	AddMedicalRecord(ctx context.Context, record *MedicalRecord) error
+	UpdateMedicalRecord(ctx context.Context, record *MedicalRecord) error
+}
+
+type WeightRecordRepository interface {
+	GetWeightRecord(ctx context.Context, animalID AnimalID) (record *WeightRecord, err error)
+	AddWeightRecord(ctx context.Context, record *WeightRecord) error
+	UpdateWeightRecord(ctx context.Context, record *WeightRecord) error
+}
+
+type VetAppointmentRepository interface {
+	GetVetAppointment(ctx context.Context, id VetAppointmentID) (appointment *VetAppointment, err error)
+	AddVetAppointment(ctx context.Context, appointment *VetAppointment) error
+	UpdateVetAppointment(ctx context.Context, appointment *VetAppointment) error
+	DeleteVetAppointment(ctx context.Context, id VetAppointmentID) error
+	// GetAllVetAppointments returns all appointments ordered by their start.
+	GetAllVetAppointments(ctx context.Context) ([]*VetAppointment, error)
+	// GetVetAppointmentsForTimeRange returns the appointments overlapping [startTime, endTime) ordered by their start.
+	GetVetAppointmentsForTimeRange(ctx context.Context, startTime, endTime time.Time) ([]*VetAppointment, error)
+}
+
+type QuarantineRepository interface {
+	GetQuarantine(ctx context.Context, id QuarantineID) (quarantine *Quarantine, err error)
+	AddQuarantine(ctx context.Context, quarantine *Quarantine) error
+	UpdateQuarantine(ctx context.Context, quarantine *Quarantine) error
+	GetAllQuarantines(ctx context.Context) (quarantines []*Quarantine, err error)
+
+	// GetActiveQuarantine returns the quarantine the animal is in, ErrQuarantineNotFound if there is none.
+	GetActiveQuarantine(ctx context.Context, animalID AnimalID) (*Quarantine, error)
+	GetActiveQuarantines(ctx context.Context) ([]*Quarantine, error)
+}
+
+type SpeciesRepository interface {
+	GetSpecies(ctx context.Context, name AnimalSpecies) (species *Species, err error)
+	AddSpecies(ctx context.Context, species *Species) error
+	DeleteSpecies(ctx context.Context, name AnimalSpecies) error
+	UpdateSpecies(ctx context.Context, species *Species) error
+	GetAllSpecies(ctx context.Context) (species []*Species, err error)
+}
+
+type DrugRepository interface {
+	GetDrug(ctx context.Context, name DrugName) (drug *Drug, err error)
+	AddDrug(ctx context.Context, drug *Drug) error
+	DeleteDrug(ctx context.Context, name DrugName) error
+	UpdateDrug(ctx context.Context, drug *Drug) error
+	// GetAllDrugs returns the formulary ordered by drug name.
+	GetAllDrugs(ctx context.Context) (drugs []*Drug, err error)
+}
+
+type KeeperRepository interface {
+	GetKeeper(ctx context.Context, id KeeperID) (keeper *Keeper, err error)
+	AddKeeper(ctx context.Context, keeper *Keeper) error
+	UpdateKeeper(ctx context.Context, keeper *Keeper) error
+	DeleteKeeper(ctx context.Context, id KeeperID) error
+	// GetAllKeepers returns all keepers ordered by name.
+	GetAllKeepers(ctx context.Context) (keepers []*Keeper, err error)
+}
+
+type ShiftRepository interface {
+	GetShift(ctx context.Context, name ShiftName) (shift *Shift, err error)
+	AddShift(ctx context.Context, shift *Shift) error
+	UpdateShift(ctx context.Context, shift *Shift) error
+	DeleteShift(ctx context.Context, name ShiftName) error
+	// GetAllShifts returns all shifts ordered by name.
+	GetAllShifts(ctx context.Context) (shifts []*Shift, err error)
+}
+
+type CareTaskRepository interface {
+	GetCareTask(ctx context.Context, id CareTaskID) (task *CareTask, err error)
+	AddCareTask(ctx context.Context, task *CareTask) error
+	UpdateCareTask(ctx context.Context, task *CareTask) error
+	DeleteCareTask(ctx context.Context, id CareTaskID) error
+	// GetAllCareTasks returns all care tasks ordered by the time of their first occurrence.
+	GetAllCareTasks(ctx context.Context) (tasks []*CareTask, err error)
+}
+
+type FoodStockRepository interface {
+	GetFoodStock(ctx context.Context, food Food) (stock *FoodStock, err error)
+	AddFoodStock(ctx context.Context, stock *FoodStock) error
+	DeleteFoodStock(ctx context.Context, food Food) error
+	UpdateFoodStock(ctx context.Context, stock *FoodStock) error
+	// GetAllFoodStocks returns the inventory ordered by food name.
+	GetAllFoodStocks(ctx context.Context) (stocks []*FoodStock, err error)
+}
+
+type WebhookRepository interface {
+	GetWebhook(ctx context.Context, id WebhookID) (webhook *WebhookSubscription, err error)
+	AddWebhook(ctx context.Context, webhook *WebhookSubscription) error
+	DeleteWebhook(ctx context.Context, id WebhookID) error
+	GetAllWebhooks(ctx context.Context) (webhooks []*WebhookSubscription, err error)
+
+	GetWebhooksForEvent(ctx context.Context, eventType string) ([]*WebhookSubscription, error)
+	AddDeliveryAttempt(ctx context.Context, attempt *WebhookDeliveryAttempt) error
+	// GetDeliveryAttempts returns attempts to deliver events to the webhook, oldest first.
+	GetDeliveryAttempts(ctx context.Context, webhookID WebhookID) ([]*WebhookDeliveryAttempt, error)
+}
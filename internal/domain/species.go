@@ -0,0 +1,229 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrEmptySpeciesName       = NewInvariantError("empty_species_name", "species name cannot be empty")
+	ErrInvalidMaxPerEnclosure = NewInvariantError("invalid_max_per_enclosure", "max animals per enclosure cannot be negative")
+	ErrInvalidMinArea         = NewInvariantError("invalid_min_area", "minimum area per animal cannot be negative")
+	ErrIncompatibleSpecies    = NewConflictError("incompatible_species", "species cannot share the enclosure")
+)
+
+// CompatibilityRule names a rule of the species catalog.
+type CompatibilityRule string
+
+const (
+	// RulePredatorPrey keeps predators apart from every other species.
+	RulePredatorPrey CompatibilityRule = "predator_prey"
+	// RuleSolitary keeps solitary animals alone in their enclosure.
+	RuleSolitary CompatibilityRule = "solitary"
+	// RuleMaxPerSpecies limits the number of animals of a species in one enclosure.
+	RuleMaxPerSpecies CompatibilityRule = "max_per_species"
+	// RuleGenderMixing keeps males and females of a species apart unless mixing is allowed.
+	RuleGenderMixing CompatibilityRule = "gender_mixing"
+)
+
+// Species is an entry of the species catalog describing how animals of a species may be kept.
+// Species missing from the catalog are not restricted.
+type Species struct {
+	Name     AnimalSpecies
+	Predator bool
+	Solitary bool
+	// MaxPerEnclosure is the maximum number of animals of the species in one enclosure, 0 if unlimited.
+	MaxPerEnclosure int
+	// MixedGenders allows males and females of the species to share an enclosure.
+	MixedGenders bool
+	Habitat      HabitatRequirements
+	// MinArea is the enclosure area every animal of the species needs, 0 if it has no requirement.
+	MinArea     EnclosureSize
+	WeightNorms WeightNorms
+}
+
+func NewSpecies(
+	name AnimalSpecies,
+	predator, solitary bool,
+	maxPerEnclosure int,
+	mixedGenders bool,
+	habitat HabitatRequirements,
+	minArea EnclosureSize,
+	weightNorms WeightNorms,
+) (*Species, error) {
+	if strings.TrimSpace(string(name)) == "" {
+		return nil, ErrEmptySpeciesName
+	}
+
+	if maxPerEnclosure < 0 {
+		return nil, ErrInvalidMaxPerEnclosure
+	}
+
+	if minArea < 0 {
+		return nil, ErrInvalidMinArea
+	}
+
+	if err := habitat.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := weightNorms.validate(); err != nil {
+		return nil, err
+	}
+
+	habitat.Climates = slices.Clone(habitat.Climates)
+
+	return &Species{
+		Name:            name,
+		Predator:        predator,
+		Solitary:        solitary,
+		MaxPerEnclosure: maxPerEnclosure,
+		MixedGenders:    mixedGenders,
+		Habitat:         habitat,
+		MinArea:         minArea,
+		WeightNorms:     weightNorms,
+	}, nil
+}
+
+// Clone returns a deep copy of the species.
+func (s *Species) Clone() *Species {
+	cloned := *s
+	cloned.Habitat.Climates = slices.Clone(s.Habitat.Climates)
+
+	return &cloned
+}
+
+// IncompatibleSpeciesError reports the animals that prevent an animal from joining an enclosure.
+// It wraps ErrIncompatibleSpecies.
+type IncompatibleSpeciesError struct {
+	AnimalID           AnimalID
+	Species            AnimalSpecies
+	Rule               CompatibilityRule
+	ConflictingAnimals []AnimalID
+}
+
+func (e *IncompatibleSpeciesError) Error() string {
+	ids := make([]string, len(e.ConflictingAnimals))
+	for i, id := range e.ConflictingAnimals {
+		ids[i] = id.String()
+	}
+
+	return fmt.Sprintf("%s: %s animal %s violates the %s rule with animals %s",
+		ErrIncompatibleSpecies.Message, e.Species, e.AnimalID, e.Rule, strings.Join(ids, ", "))
+}
+
+func (e *IncompatibleSpeciesError) Unwrap() error {
+	return ErrIncompatibleSpecies
+}
+
+// SpeciesCatalog holds the rules of every catalogued species. The zero value imposes no rules.
+type SpeciesCatalog map[AnimalSpecies]*Species
+
+func NewSpeciesCatalog(species []*Species) SpeciesCatalog {
+	catalog := make(SpeciesCatalog, len(species))
+	for _, s := range species {
+		catalog[s.Name] = s
+	}
+
+	return catalog
+}
+
+// LoadSpeciesCatalog builds the catalog from every species stored in the repository.
+func LoadSpeciesCatalog(ctx context.Context, repository SpeciesRepository) (SpeciesCatalog, error) {
+	species, err := repository.GetAllSpecies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting species catalog: %w", err)
+	}
+
+	return NewSpeciesCatalog(species), nil
+}
+
+// CheckPlacement returns an *IncompatibleSpeciesError if the animal cannot share an enclosure with the residents.
+func (sc SpeciesCatalog) CheckPlacement(animal *Animal, residents []*Animal) error {
+	others := slices.DeleteFunc(slices.Clone(residents), func(r *Animal) bool { return r == animal })
+	if len(others) == 0 {
+		return nil
+	}
+
+	// Residents are sorted so that the reported conflicts do not depend on map iteration order
+	slices.SortFunc(others, func(a, b *Animal) int { return strings.Compare(a.ID.String(), b.ID.String()) })
+
+	species := sc[animal.Species]
+
+	checks := []struct {
+		rule     CompatibilityRule
+		conflict func(resident *Animal) bool
+	}{
+		{RuleSolitary, func(resident *Animal) bool {
+			return (species != nil && species.Solitary) || sc.isSolitary(resident.Species)
+		}},
+		{RulePredatorPrey, func(resident *Animal) bool {
+			if resident.Species == animal.Species {
+				return false
+			}
+
+			return (species != nil && species.Predator) || sc.isPredator(resident.Species)
+		}},
+		{RuleGenderMixing, func(resident *Animal) bool {
+			return species != nil && !species.MixedGenders &&
+				resident.Species == animal.Species && resident.Gender != animal.Gender
+		}},
+	}
+
+	for _, check := range checks {
+		if conflicting := conflictingAnimals(others, check.conflict); len(conflicting) > 0 {
+			return incompatibleSpecies(animal, check.rule, conflicting)
+		}
+	}
+
+	if species != nil && species.MaxPerEnclosure > 0 {
+		sameSpecies := conflictingAnimals(others, func(resident *Animal) bool { return resident.Species == animal.Species })
+		if len(sameSpecies) >= species.MaxPerEnclosure {
+			return incompatibleSpecies(animal, RuleMaxPerSpecies, sameSpecies)
+		}
+	}
+
+	return nil
+}
+
+// MinArea returns the enclosure area an animal of the species needs, 0 for species missing from the catalog.
+func (sc SpeciesCatalog) MinArea(name AnimalSpecies) EnclosureSize {
+	if species, ok := sc[name]; ok {
+		return species.MinArea
+	}
+
+	return 0
+}
+
+func (sc SpeciesCatalog) isSolitary(name AnimalSpecies) bool {
+	species, ok := sc[name]
+	return ok && species.Solitary
+}
+
+func (sc SpeciesCatalog) isPredator(name AnimalSpecies) bool {
+	species, ok := sc[name]
+	return ok && species.Predator
+}
+
+func incompatibleSpecies(animal *Animal, rule CompatibilityRule, conflicting []AnimalID) error {
+	return &IncompatibleSpeciesError{
+		AnimalID:           animal.ID,
+		Species:            animal.Species,
+		Rule:               rule,
+		ConflictingAnimals: conflicting,
+	}
+}
+
+func conflictingAnimals(residents []*Animal, conflict func(*Animal) bool) []AnimalID {
+	var ids []AnimalID
+
+	for _, resident := range residents {
+		if conflict(resident) {
+			ids = append(ids, resident.ID)
+		}
+	}
+
+	return ids
+}
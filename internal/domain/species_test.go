@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSpecies(t *testing.T) {
+	tests := []struct {
+		name            string
+		species         AnimalSpecies
+		maxPerEnclosure int
+		habitat         HabitatRequirements
+		minArea         EnclosureSize
+		wantErr         error
+	}{
+		{name: "valid", species: "Zebra", maxPerEnclosure: 5, habitat: HabitatRequirements{Climates: []Climate{ClimateTropical}}, minArea: 20},
+		{name: "blank name", species: "  ", wantErr: ErrEmptySpeciesName},
+		{name: "negative max per enclosure", species: "Zebra", maxPerEnclosure: -1, wantErr: ErrInvalidMaxPerEnclosure},
+		{name: "negative min area", species: "Zebra", minArea: -1, wantErr: ErrInvalidMinArea},
+		{name: "unknown climate", species: "Zebra", habitat: HabitatRequirements{Climates: []Climate{"Humid"}}, wantErr: ErrUnknownClimate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			species, err := NewSpecies(tt.species, false, false, tt.maxPerEnclosure, false, tt.habitat, tt.minArea, WeightNorms{})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.species, species.Name)
+		})
+	}
+}
+
+func TestSpeciesCatalogCheckPlacement(t *testing.T) {
+	catalog := NewSpeciesCatalog([]*Species{
+		{Name: "Lion", Predator: true, MixedGenders: true},
+		{Name: "Tiger", Predator: true, MixedGenders: true},
+		{Name: "Leopard", Predator: true, Solitary: true},
+		{Name: "Zebra", MaxPerEnclosure: 2, MixedGenders: true},
+		{Name: "Giraffe"},
+	})
+
+	animal := func(n byte, species AnimalSpecies, gender Gender) *Animal {
+		a := newPlacedAnimal(n, species, nil)
+		a.Gender = gender
+
+		return a
+	}
+
+	tests := []struct {
+		name      string
+		animal    *Animal
+		residents []*Animal
+		// wantRule is empty if the animal can join the residents
+		wantRule        CompatibilityRule
+		wantConflicting []AnimalID
+	}{
+		{
+			name:   "empty enclosure",
+			animal: animal(1, "Leopard", Male),
+		},
+		{
+			name:      "species missing from the catalog are not restricted",
+			animal:    animal(1, "Okapi", Male),
+			residents: []*Animal{animal(2, "Okapi", Female), animal(3, "Giraffe", Male)},
+		},
+		{
+			name:      "predators of a species live together",
+			animal:    animal(1, "Lion", Male),
+			residents: []*Animal{animal(2, "Lion", Female)},
+		},
+		{
+			name:            "predator cannot join prey",
+			animal:          animal(1, "Lion", Male),
+			residents:       []*Animal{animal(3, "Zebra", Male), animal(2, "Giraffe", Male)},
+			wantRule:        RulePredatorPrey,
+			wantConflicting: []AnimalID{testAnimalID(2), testAnimalID(3)},
+		},
+		{
+			name:            "prey cannot join a predator",
+			animal:          animal(1, "Zebra", Male),
+			residents:       []*Animal{animal(2, "Lion", Male), animal(3, "Zebra", Female)},
+			wantRule:        RulePredatorPrey,
+			wantConflicting: []AnimalID{testAnimalID(2)},
+		},
+		{
+			name:            "predators of different species are kept apart",
+			animal:          animal(1, "Tiger", Male),
+			residents:       []*Animal{animal(2, "Lion", Male)},
+			wantRule:        RulePredatorPrey,
+			wantConflicting: []AnimalID{testAnimalID(2)},
+		},
+		{
+			name:            "solitary animal cannot join others",
+			animal:          animal(1, "Leopard", Male),
+			residents:       []*Animal{animal(2, "Leopard", Male)},
+			wantRule:        RuleSolitary,
+			wantConflicting: []AnimalID{testAnimalID(2)},
+		},
+		{
+			name:            "nobody can join a solitary animal",
+			animal:          animal(1, "Giraffe", Male),
+			residents:       []*Animal{animal(2, "Leopard", Male), animal(3, "Giraffe", Female)},
+			wantRule:        RuleSolitary,
+			wantConflicting: []AnimalID{testAnimalID(2)},
+		},
+		{
+			name:            "genders are kept apart unless mixing is allowed",
+			animal:          animal(1, "Giraffe", Male),
+			residents:       []*Animal{animal(2, "Giraffe", Female), animal(3, "Giraffe", Male)},
+			wantRule:        RuleGenderMixing,
+			wantConflicting: []AnimalID{testAnimalID(2)},
+		},
+		{
+			name:      "genders mix when allowed",
+			animal:    animal(1, "Zebra", Male),
+			residents: []*Animal{animal(2, "Zebra", Female)},
+		},
+		{
+			name:            "max per species",
+			animal:          animal(1, "Zebra", Male),
+			residents:       []*Animal{animal(3, "Zebra", Female), animal(2, "Zebra", Male), animal(4, "Giraffe", Male)},
+			wantRule:        RuleMaxPerSpecies,
+			wantConflicting: []AnimalID{testAnimalID(2), testAnimalID(3)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The animal itself among the residents is ignored
+			err := catalog.CheckPlacement(tt.animal, append(tt.residents, tt.animal))
+			if tt.wantRule == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, ErrIncompatibleSpecies)
+
+			var incompatible *IncompatibleSpeciesError
+			require.True(t, errors.As(err, &incompatible))
+			assert.Equal(t, tt.animal.ID, incompatible.AnimalID)
+			assert.Equal(t, tt.wantRule, incompatible.Rule)
+			assert.Equal(t, tt.wantConflicting, incompatible.ConflictingAnimals)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEmptyTransferBatch = NewInvariantError("empty_transfer_batch", "transfer batch must contain at least one transfer")
+	ErrDuplicateTransfer  = NewInvariantError("duplicate_transfer", "animal is transferred more than once in the batch")
+)
+
+type TransferBatchID uuid.UUID
+
+func (tid TransferBatchID) String() string {
+	return uuid.UUID(tid).String()
+}
+
+func (tid TransferBatchID) UUID() uuid.UUID {
+	return uuid.UUID(tid)
+}
+
+func (tid TransferBatchID) MarshalText() ([]byte, error) {
+	return uuid.UUID(tid).MarshalText()
+}
+
+func (tid *TransferBatchID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(tid).UnmarshalText(data)
+}
+
+// Transfer moves an animal into an enclosure as a part of a batch.
+type Transfer struct {
+	Animal *Animal
+	To     *Enclosure
+}
+
+// Value Object. TransferRecord describes a completed transfer.
+// FromEnclosureID is the zero ID when the animal had no enclosure before.
+type TransferRecord struct {
+	AnimalID        AnimalID
+	FromEnclosureID EnclosureID
+	ToEnclosureID   EnclosureID
+}
+
+// Value Object. TransferBatch is a set of transfers carried out together.
+type TransferBatch struct {
+	ID            TransferBatchID
+	Transfers     []TransferRecord
+	TransferredAt time.Time
+}
+
+// TransferAnimals carries out the transfers as one step: every animal leaves its enclosure before any
+// is placed, so animals can swap places even when their enclosures are full. On error the animals and
+// enclosures are left partially changed and must be discarded.
+func (sc SpeciesCatalog) TransferAnimals(transfers []Transfer) ([]TransferRecord, error) {
+	if len(transfers) == 0 {
+		return nil, ErrEmptyTransferBatch
+	}
+
+	records := make([]TransferRecord, len(transfers))
+	seen := make(map[AnimalID]struct{}, len(transfers))
+
+	for i, transfer := range transfers {
+		if _, ok := seen[transfer.Animal.ID]; ok {
+			return nil, fmt.Errorf("%w: animal %s", ErrDuplicateTransfer, transfer.Animal.ID)
+		}
+
+		seen[transfer.Animal.ID] = struct{}{}
+
+		records[i] = TransferRecord{
+			AnimalID:      transfer.Animal.ID,
+			ToEnclosureID: transfer.To.ID,
+		}
+
+		if from := transfer.Animal.Enclosure; from != nil {
+			records[i].FromEnclosureID = from.ID
+
+			if err := from.RemoveAnimal(transfer.Animal); err != nil {
+				return nil, fmt.Errorf("transferring animal %s: %w", transfer.Animal.ID, err)
+			}
+		}
+	}
+
+	for _, transfer := range transfers {
+		if err := transfer.To.AddAnimal(transfer.Animal, sc); err != nil {
+			return nil, fmt.Errorf("transferring animal %s: %w", transfer.Animal.ID, err)
+		}
+
+		if err := transfer.Animal.MoveToEnclosure(transfer.To); err != nil {
+			return nil, fmt.Errorf("transferring animal %s: %w", transfer.Animal.ID, err)
+		}
+	}
+
+	return records, nil
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maklybae/ddd-zoo/pkg/events"
+)
+
+// Repositories gives access to every aggregate repository inside a single unit of work.
+type Repositories interface {
+	Animals() AnimalRepository
+	Enclosures() EnclosureRepository
+	FeedingSchedules() FeedingScheduleRepository
+	MedicalRecords() MedicalRecordRepository
+	Quarantines() QuarantineRepository
+	Species() SpeciesRepository
+	FoodStocks() FoodStockRepository
+	WeightRecords() WeightRecordRepository
+	VetAppointments() VetAppointmentRepository
+	Drugs() DrugRepository
+	Keepers() KeeperRepository
+	Shifts() ShiftRepository
+	CareTasks() CareTaskRepository
+	Webhooks() WebhookRepository
+	// Outbox records events that are published once the unit of work is committed.
+	Outbox() events.Outbox
+}
+
+// UnitOfWork runs a function transactionally: either every change made through the
+// provided repositories is committed, or none of them is.
+//
+// Calling Do with a context that already belongs to a unit of work joins it instead of
+// starting a new one, so services can be composed inside a single transaction.
+//
+// View runs a read-only function against a consistent state of the repositories without
+// the cost of a transaction that may write. The function must not change anything through
+// the repositories nor modify the aggregates it reads, and must not call Do.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context, repos Repositories) error) error
+	View(ctx context.Context, fn func(ctx context.Context, repos Repositories) error) error
+}
+
+// ErrWriteInView is returned by Do called with the context of a read-only view.
+var ErrWriteInView = errors.New("unit of work: cannot write inside a read-only view")
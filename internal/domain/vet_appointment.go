@@ -0,0 +1,260 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidTimeSlot          = NewInvariantError("invalid_time_slot", "time slot must end after it starts")
+	ErrEmptyProcedure           = NewInvariantError("empty_procedure", "procedure cannot be empty")
+	ErrEmptyOutcome             = NewInvariantError("empty_outcome", "outcome cannot be empty")
+	ErrAppointmentInPast        = NewInvariantError("appointment_in_past", "appointment cannot be scheduled in the past")
+	ErrAppointmentNotScheduled  = NewConflictError("appointment_not_scheduled", "appointment is already completed or cancelled")
+	ErrVetDoubleBooked          = NewConflictError("vet_double_booked", "vet has another appointment at that time")
+	ErrAnimalDoubleBooked       = NewConflictError("animal_double_booked", "animal has another appointment at that time")
+	ErrAppointmentDuringFeeding = NewConflictError("appointment_during_feeding", "appointment collides with a feeding of the animal")
+)
+
+// FastingPeriod is how long an animal may not be fed before a procedure under anesthesia.
+const FastingPeriod = 12 * time.Hour
+
+type (
+	VetAppointmentID     uuid.UUID
+	VetAppointmentStatus string
+)
+
+const (
+	VetAppointmentStatusScheduled VetAppointmentStatus = "Scheduled"
+	VetAppointmentStatusCompleted VetAppointmentStatus = "Completed"
+	VetAppointmentStatusCancelled VetAppointmentStatus = "Cancelled"
+)
+
+func (id VetAppointmentID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func (id VetAppointmentID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+// Value Object. TimeSlot is the period [Start, End).
+type TimeSlot struct {
+	Start time.Time
+	End   time.Time
+}
+
+func NewTimeSlot(start, end time.Time) (TimeSlot, error) {
+	if !end.After(start) {
+		return TimeSlot{}, ErrInvalidTimeSlot
+	}
+
+	return TimeSlot{Start: start, End: end}, nil
+}
+
+// Overlaps reports whether the slots share any moment.
+func (ts TimeSlot) Overlaps(other TimeSlot) bool {
+	return ts.Start.Before(other.End) && other.Start.Before(ts.End)
+}
+
+// Contains reports whether the moment falls within the slot.
+func (ts TimeSlot) Contains(t time.Time) bool {
+	return !t.Before(ts.Start) && t.Before(ts.End)
+}
+
+// VetAppointment is a visit of a vet to an animal.
+type VetAppointment struct {
+	ID        VetAppointmentID
+	AnimalID  AnimalID
+	Vet       VetName
+	Slot      TimeSlot
+	Procedure TreatmentDescription
+	// Anesthesia requires the animal to fast for FastingPeriod before the appointment.
+	Anesthesia bool
+	Status     VetAppointmentStatus
+	// Outcome is the vet's conclusion, set once the appointment is completed.
+	Outcome string
+}
+
+func NewVetAppointment(
+	animalID AnimalID,
+	vet VetName,
+	slot TimeSlot,
+	procedure TreatmentDescription,
+	anesthesia bool,
+) (*VetAppointment, error) {
+	appointment := &VetAppointment{
+		ID:       VetAppointmentID(uuid.New()),
+		AnimalID: animalID,
+		Status:   VetAppointmentStatusScheduled,
+	}
+
+	if err := appointment.Reschedule(vet, slot, procedure, anesthesia); err != nil {
+		return nil, err
+	}
+
+	return appointment, nil
+}
+
+// Reschedule changes the vet, time and procedure of a scheduled appointment.
+func (va *VetAppointment) Reschedule(vet VetName, slot TimeSlot, procedure TreatmentDescription, anesthesia bool) error {
+	if va.Status != VetAppointmentStatusScheduled {
+		return ErrAppointmentNotScheduled
+	}
+
+	if strings.TrimSpace(string(vet)) == "" {
+		return ErrEmptyVet
+	}
+
+	if strings.TrimSpace(string(procedure)) == "" {
+		return ErrEmptyProcedure
+	}
+
+	if !slot.End.After(slot.Start) {
+		return ErrInvalidTimeSlot
+	}
+
+	va.Vet = vet
+	va.Slot = slot
+	va.Procedure = procedure
+	va.Anesthesia = anesthesia
+
+	return nil
+}
+
+func (va *VetAppointment) Complete(outcome string) error {
+	if va.Status != VetAppointmentStatusScheduled {
+		return ErrAppointmentNotScheduled
+	}
+
+	if strings.TrimSpace(outcome) == "" {
+		return ErrEmptyOutcome
+	}
+
+	va.Status = VetAppointmentStatusCompleted
+	va.Outcome = outcome
+
+	return nil
+}
+
+func (va *VetAppointment) Cancel() error {
+	if va.Status != VetAppointmentStatusScheduled {
+		return ErrAppointmentNotScheduled
+	}
+
+	va.Status = VetAppointmentStatusCancelled
+
+	return nil
+}
+
+// NoFeedingPeriod returns the period the animal may not be fed: the appointment itself
+// and, under anesthesia, the fasting period before it.
+func (va *VetAppointment) NoFeedingPeriod() TimeSlot {
+	period := va.Slot
+	if va.Anesthesia {
+		period.Start = period.Start.Add(-FastingPeriod)
+	}
+
+	return period
+}
+
+// CheckConflicts returns an error if the scheduled appointment overlaps another scheduled appointment
+// of the same vet or animal, or a pending feeding of the animal falls within its no-feeding period.
+// The other appointments and the schedules may include ones that do not concern it.
+func (va *VetAppointment) CheckConflicts(appointments []*VetAppointment, schedules []*FeedingSchedule) error {
+	for _, other := range appointments {
+		if other.ID == va.ID || other.Status != VetAppointmentStatusScheduled || !other.Slot.Overlaps(va.Slot) {
+			continue
+		}
+
+		if other.Vet == va.Vet {
+			return fmt.Errorf("%w: %s is booked by appointment %s from %s to %s",
+				ErrVetDoubleBooked, va.Vet, other.ID, other.Slot.Start.Format(time.RFC3339), other.Slot.End.Format(time.RFC3339))
+		}
+
+		if other.AnimalID == va.AnimalID {
+			return fmt.Errorf("%w: appointment %s from %s to %s",
+				ErrAnimalDoubleBooked, other.ID, other.Slot.Start.Format(time.RFC3339), other.Slot.End.Format(time.RFC3339))
+		}
+	}
+
+	period := va.NoFeedingPeriod()
+
+	for _, schedule := range schedules {
+		if schedule.Animal == nil || schedule.Animal.ID != va.AnimalID {
+			continue
+		}
+
+		for _, occurrence := range schedule.Occurrences(period.Start, period.End) {
+			if occurrence.Status != FeedingOccurrenceStatusPending {
+				continue
+			}
+
+			reason := "during the appointment"
+			if !va.Slot.Contains(occurrence.Time) {
+				reason = "within the fasting period before anesthesia"
+			}
+
+			return fmt.Errorf("%w: feeding schedule %s feeds the animal at %s %s",
+				ErrAppointmentDuringFeeding, schedule.ID, occurrence.Time.Format(time.RFC3339), reason)
+		}
+	}
+
+	return nil
+}
+
+// CheckVetConflicts returns an error if a pending feeding of the schedule falls within the no-feeding period
+// of a scheduled appointment of its animal. The appointments may include ones that do not concern it.
+func (fs *FeedingSchedule) CheckVetConflicts(appointments []*VetAppointment) error {
+	for _, appointment := range appointments {
+		if appointment.Status != VetAppointmentStatusScheduled {
+			continue
+		}
+
+		if err := appointment.CheckConflicts(nil, []*FeedingSchedule{fs}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// VetCalendarDay lists the appointments starting on a day.
+type VetCalendarDay struct {
+	// Date is the midnight the day starts at.
+	Date         time.Time
+	Appointments []*VetAppointment
+}
+
+// NewVetCalendar groups the appointments by the day they start on, for the given number of days from the
+// day of from in its location. Days without appointments are included, appointments outside them are not.
+func NewVetCalendar(appointments []*VetAppointment, from time.Time, days int) []VetCalendarDay {
+	calendar := make([]VetCalendarDay, days)
+	location := from.Location()
+	first := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, location)
+
+	for i := range calendar {
+		// Days are counted by the calendar, so that days around DST changes still start at midnight
+		calendar[i] = VetCalendarDay{
+			Date:         first.AddDate(0, 0, i),
+			Appointments: make([]*VetAppointment, 0),
+		}
+	}
+
+	for _, appointment := range appointments {
+		start := appointment.Slot.Start.In(location)
+		day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, location)
+
+		for i := range calendar {
+			if calendar[i].Date.Equal(day) {
+				calendar[i].Appointments = append(calendar[i].Appointments, appointment)
+				break
+			}
+		}
+	}
+
+	return calendar
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/pkg/events"
+)
+
+var (
+	ErrInvalidWebhookURL   = NewInvariantError("invalid_webhook_url", "webhook url must be an absolute http or https url")
+	ErrNoWebhookEvents     = NewInvariantError("no_webhook_events", "webhook must subscribe to at least one event")
+	ErrUnknownWebhookEvent = NewInvariantError("unknown_webhook_event", "webhook subscribes to an unknown event")
+	ErrEmptyWebhookSecret  = NewInvariantError("empty_webhook_secret", "webhook secret cannot be empty")
+)
+
+type (
+	WebhookID          uuid.UUID
+	WebhookDeliveryID  uuid.UUID
+	WebhookSecret      string
+	WebhookEventFilter []string
+)
+
+func (wid WebhookID) String() string {
+	return uuid.UUID(wid).String()
+}
+
+func (wid WebhookID) UUID() uuid.UUID {
+	return uuid.UUID(wid)
+}
+
+func (wid WebhookID) MarshalText() ([]byte, error) {
+	return uuid.UUID(wid).MarshalText()
+}
+
+func (wid *WebhookID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(wid).UnmarshalText(data)
+}
+
+func (wdid WebhookDeliveryID) String() string {
+	return uuid.UUID(wdid).String()
+}
+
+func (wdid WebhookDeliveryID) UUID() uuid.UUID {
+	return uuid.UUID(wdid)
+}
+
+func (wdid WebhookDeliveryID) MarshalText() ([]byte, error) {
+	return uuid.UUID(wdid).MarshalText()
+}
+
+func (wdid *WebhookDeliveryID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(wdid).UnmarshalText(data)
+}
+
+// Matches reports whether the filter selects events with the given name; events.AllEvents selects every event.
+func (f WebhookEventFilter) Matches(eventType string) bool {
+	return slices.Contains(f, eventType) || slices.Contains(f, events.AllEvents)
+}
+
+// WebhookSubscription is an integrator's endpoint that receives the events it subscribed to.
+type WebhookSubscription struct {
+	ID        WebhookID
+	URL       string
+	Events    WebhookEventFilter
+	Secret    WebhookSecret
+	CreatedAt time.Time
+}
+
+func NewWebhookSubscription(
+	id WebhookID,
+	rawURL string,
+	eventTypes []string,
+	secret WebhookSecret,
+	createdAt time.Time,
+) (*WebhookSubscription, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, ErrInvalidWebhookURL
+	}
+
+	if len(eventTypes) == 0 {
+		return nil, ErrNoWebhookEvents
+	}
+
+	for _, eventType := range eventTypes {
+		if eventType != events.AllEvents && !slices.Contains(EventNames(), eventType) {
+			return nil, ErrUnknownWebhookEvent
+		}
+	}
+
+	if secret == "" {
+		return nil, ErrEmptyWebhookSecret
+	}
+
+	return &WebhookSubscription{
+		ID:        id,
+		URL:       rawURL,
+		Events:    WebhookEventFilter(slices.Clone(eventTypes)),
+		Secret:    secret,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// WebhookDeliveryAttempt records a single attempt to deliver an event to a webhook.
+// All attempts to deliver the same event to the same webhook share a DeliveryID.
+type WebhookDeliveryAttempt struct {
+	ID          uuid.UUID
+	WebhookID   WebhookID
+	DeliveryID  WebhookDeliveryID
+	EventType   string
+	Attempt     int
+	StatusCode  int // zero if no response was received
+	Error       string
+	Succeeded   bool
+	AttemptedAt time.Time
+	Duration    time.Duration
+}
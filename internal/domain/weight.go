@@ -0,0 +1,258 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+var (
+	ErrInvalidWeight             = NewInvariantError("invalid_weight", "weight must be positive")
+	ErrInvalidBodyConditionScore = NewInvariantError("invalid_body_condition_score", "body condition score must be between 1 and 9")
+	ErrMeasurementInFuture       = NewInvariantError("measurement_in_future", "measurement cannot be taken in the future")
+	ErrInvalidWeightNorms        = NewInvariantError("invalid_weight_norms", "weight norms cannot be negative and the minimum weight cannot exceed the maximum")
+)
+
+const (
+	// DefaultWeightWindow is the rolling window weight changes are measured over unless the species sets one.
+	DefaultWeightWindow = 30 * 24 * time.Hour
+	// DefaultMaxWeightChangePercent is the largest gain or loss within the window considered normal
+	// unless the species sets one.
+	DefaultMaxWeightChangePercent = 10.0
+)
+
+// BodyConditionScore rates the body condition of an animal on the 9-point scale:
+// 1 is emaciated, 4 to 6 is ideal and 9 is obese. The zero value means it was not assessed.
+type BodyConditionScore int
+
+const (
+	MinBodyConditionScore         BodyConditionScore = 1
+	MinIdealBodyConditionScore    BodyConditionScore = 4
+	MaxIdealBodyConditionScore    BodyConditionScore = 6
+	MaxBodyConditionScore         BodyConditionScore = 9
+	bodyConditionScoreNotAssessed BodyConditionScore = 0
+)
+
+func (s BodyConditionScore) IsValid() bool {
+	return s == bodyConditionScoreNotAssessed || (s >= MinBodyConditionScore && s <= MaxBodyConditionScore)
+}
+
+// Value Object. WeightMeasurement is a weighing of an animal, in kilograms.
+type WeightMeasurement struct {
+	Weight float64
+	// BodyConditionScore is the score assessed at the weighing, zero if it was not assessed.
+	BodyConditionScore BodyConditionScore
+	MeasuredBy         ReporterName
+	MeasuredAt         time.Time
+}
+
+func (m WeightMeasurement) validate() error {
+	if m.Weight <= 0 {
+		return ErrInvalidWeight
+	}
+
+	if !m.BodyConditionScore.IsValid() {
+		return fmt.Errorf("%w: %d", ErrInvalidBodyConditionScore, m.BodyConditionScore)
+	}
+
+	return nil
+}
+
+// WeightRecord is the weight history of a single animal. It is identified by the animal's ID.
+type WeightRecord struct {
+	AnimalID AnimalID
+	OpenedAt time.Time
+	// Measurements are ordered by the time they were taken.
+	Measurements []WeightMeasurement
+}
+
+func NewWeightRecord(animalID AnimalID, openedAt time.Time) *WeightRecord {
+	return &WeightRecord{
+		AnimalID: animalID,
+		OpenedAt: openedAt,
+	}
+}
+
+// AddMeasurement adds the measurement in chronological order, so earlier weighings may be recorded late.
+func (wr *WeightRecord) AddMeasurement(measurement WeightMeasurement) error {
+	if err := measurement.validate(); err != nil {
+		return err
+	}
+
+	i := len(wr.Measurements)
+	for i > 0 && wr.Measurements[i-1].MeasuredAt.After(measurement.MeasuredAt) {
+		i--
+	}
+
+	wr.Measurements = slices.Insert(wr.Measurements, i, measurement)
+
+	return nil
+}
+
+// Between returns the measurements taken within [from, to).
+func (wr *WeightRecord) Between(from, to time.Time) []WeightMeasurement {
+	measurements := make([]WeightMeasurement, 0)
+
+	for _, measurement := range wr.Measurements {
+		if !measurement.MeasuredAt.Before(from) && measurement.MeasuredAt.Before(to) {
+			measurements = append(measurements, measurement)
+		}
+	}
+
+	return measurements
+}
+
+// Latest returns the last measurement taken at or before at.
+func (wr *WeightRecord) Latest(at time.Time) (WeightMeasurement, bool) {
+	for i := len(wr.Measurements) - 1; i >= 0; i-- {
+		if !wr.Measurements[i].MeasuredAt.After(at) {
+			return wr.Measurements[i], true
+		}
+	}
+
+	return WeightMeasurement{}, false
+}
+
+// Clone returns a deep copy of the record.
+func (wr *WeightRecord) Clone() *WeightRecord {
+	cloned := *wr
+	cloned.Measurements = slices.Clone(wr.Measurements)
+
+	return &cloned
+}
+
+// Value Object. WeightNorms describe the normal weight of a species. Zero values fall back to no bounds
+// and the default window and change.
+type WeightNorms struct {
+	// MinWeight and MaxWeight bound the normal weight of an adult in kilograms, 0 if unbounded.
+	MinWeight float64
+	MaxWeight float64
+	// MaxChangePercent is the largest gain or loss within the window considered normal.
+	MaxChangePercent float64
+	Window           time.Duration
+}
+
+func (wn WeightNorms) validate() error {
+	if wn.MinWeight < 0 || wn.MaxWeight < 0 || wn.MaxChangePercent < 0 || wn.Window < 0 {
+		return ErrInvalidWeightNorms
+	}
+
+	if wn.MaxWeight > 0 && wn.MinWeight > wn.MaxWeight {
+		return ErrInvalidWeightNorms
+	}
+
+	return nil
+}
+
+func (wn WeightNorms) window() time.Duration {
+	if wn.Window == 0 {
+		return DefaultWeightWindow
+	}
+
+	return wn.Window
+}
+
+func (wn WeightNorms) maxChangePercent() float64 {
+	if wn.MaxChangePercent == 0 {
+		return DefaultMaxWeightChangePercent
+	}
+
+	return wn.MaxChangePercent
+}
+
+type WeightAnomaly string
+
+const (
+	WeightAnomalyLoss                WeightAnomaly = "WeightLoss"
+	WeightAnomalyGain                WeightAnomaly = "WeightGain"
+	WeightAnomalyUnderweight         WeightAnomaly = "Underweight"
+	WeightAnomalyOverweight          WeightAnomaly = "Overweight"
+	WeightAnomalyPoorBodyCondition   WeightAnomaly = "PoorBodyCondition"
+	WeightAnomalyExcessBodyCondition WeightAnomaly = "ExcessBodyCondition"
+)
+
+// Value Object. WeightTrend is the analysis of the latest measurement against the earliest one
+// within the rolling window before it and against the norms of the species.
+type WeightTrend struct {
+	// Latest is the analysed measurement, nil if the animal has not been weighed.
+	Latest *WeightMeasurement
+	// Baseline is the earliest other measurement within the window, nil if there is none.
+	Baseline *WeightMeasurement
+	Window   time.Duration
+	// Change is the weight change from the baseline to the latest measurement in kilograms.
+	Change        float64
+	ChangePercent float64
+	Anomalies     []WeightAnomaly
+}
+
+// IsAbnormal reports whether the latest measurement shows any anomaly.
+func (wt WeightTrend) IsAbnormal() bool {
+	return len(wt.Anomalies) > 0
+}
+
+// WeightNorms returns the weight norms of the species, the defaults for species missing from the catalog.
+func (sc SpeciesCatalog) WeightNorms(name AnimalSpecies) WeightNorms {
+	if species, ok := sc[name]; ok {
+		return species.WeightNorms
+	}
+
+	return WeightNorms{}
+}
+
+// AnalyzeWeight analyses the animal's last measurement taken at or before at.
+func (sc SpeciesCatalog) AnalyzeWeight(animal *Animal, record *WeightRecord, at time.Time) WeightTrend {
+	norms := sc.WeightNorms(animal.Species)
+	trend := WeightTrend{Window: norms.window()}
+
+	latest := -1
+	for i, measurement := range record.Measurements {
+		if measurement.MeasuredAt.After(at) {
+			break
+		}
+
+		latest = i
+	}
+
+	if latest < 0 {
+		return trend
+	}
+
+	trend.Latest = &record.Measurements[latest]
+
+	windowStart := trend.Latest.MeasuredAt.Add(-trend.Window)
+	for i := range latest {
+		if !record.Measurements[i].MeasuredAt.Before(windowStart) {
+			trend.Baseline = &record.Measurements[i]
+			break
+		}
+	}
+
+	if trend.Baseline != nil {
+		trend.Change = trend.Latest.Weight - trend.Baseline.Weight
+		trend.ChangePercent = trend.Change / trend.Baseline.Weight * 100
+
+		switch maxChange := norms.maxChangePercent(); {
+		case trend.ChangePercent < -maxChange:
+			trend.Anomalies = append(trend.Anomalies, WeightAnomalyLoss)
+		case trend.ChangePercent > maxChange:
+			trend.Anomalies = append(trend.Anomalies, WeightAnomalyGain)
+		}
+	}
+
+	switch weight := trend.Latest.Weight; {
+	case norms.MinWeight > 0 && weight < norms.MinWeight:
+		trend.Anomalies = append(trend.Anomalies, WeightAnomalyUnderweight)
+	case norms.MaxWeight > 0 && weight > norms.MaxWeight:
+		trend.Anomalies = append(trend.Anomalies, WeightAnomalyOverweight)
+	}
+
+	switch score := trend.Latest.BodyConditionScore; {
+	case score == bodyConditionScoreNotAssessed:
+	case score < MinIdealBodyConditionScore:
+		trend.Anomalies = append(trend.Anomalies, WeightAnomalyPoorBodyCondition)
+	case score > MaxIdealBodyConditionScore:
+		trend.Anomalies = append(trend.Anomalies, WeightAnomalyExcessBodyCondition)
+	}
+
+	return trend
+}
@@ -29,7 +29,7 @@ func (r *AnimalRepository) GetAnimal(ctx context.Context, id domain.AnimalID) (*
 
 	animal, exists := r.animals[id]
 	if !exists {
-		return nil, fmt.Errorf("animal with id %s not found", id)
+		return nil, fmt.Errorf("%w: id %s", domain.ErrAnimalNotFound, id)
 	}
 
 	return animal, nil
@@ -37,14 +37,14 @@ func (r *AnimalRepository) GetAnimal(ctx context.Context, id domain.AnimalID) (*
 
 func (r *AnimalRepository) AddAnimal(ctx context.Context, animal *domain.Animal) error {
 	if animal.ID == domain.AnimalID(uuid.Nil) {
-		return fmt.Errorf("animal id cannot be nil")
+		return fmt.Errorf("animal: %w", domain.ErrNilID)
 	}
 
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
 	if _, exists := r.animals[animal.ID]; exists {
-		return fmt.Errorf("animal with id %s already exists", animal.ID)
+		return fmt.Errorf("%w: id %s", domain.ErrAnimalAlreadyExists, animal.ID)
 	}
 
 	r.animals[animal.ID] = animal
@@ -56,7 +56,7 @@ func (r *AnimalRepository) DeleteAnimal(ctx context.Context, id domain.AnimalID)
 	defer r.mutex.Unlock()
 
 	if _, exists := r.animals[id]; !exists {
-		return fmt.Errorf("animal with id %s not found", id)
+		return fmt.Errorf("%w: id %s", domain.ErrAnimalNotFound, id)
 	}
 
 	delete(r.animals, id)
@@ -68,7 +68,7 @@ func (r *AnimalRepository) UpdateAnimal(ctx context.Context, animal *domain.Anim
 	defer r.mutex.Unlock()
 
 	if _, exists := r.animals[animal.ID]; !exists {
-		return fmt.Errorf("animal with id %s not found", animal.ID)
+		return fmt.Errorf("%w: id %s", domain.ErrAnimalNotFound, animal.ID)
 	}
 
 	r.animals[animal.ID] = animal
@@ -124,14 +124,14 @@ func (r *AnimalRepository) CountHealthyAnimals(ctx context.Context) (int, error)
 	return count, nil
 }
 
-// CountSickAnimals возвращает количество больных животных
+// CountSickAnimals возвращает количество животных, которые считаются больными (см. domain.AnimalStatus.IsSick)
 func (r *AnimalRepository) CountSickAnimals(ctx context.Context) (int, error) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
 	count := 0
 	for _, animal := range r.animals {
-		if animal.Status == domain.AnimalStatusSick {
+		if animal.Status.IsSick() {
 			count++
 		}
 	}
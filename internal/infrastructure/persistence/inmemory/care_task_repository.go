@@ -0,0 +1,99 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Статическая проверка реализации интерфейса
+var _ domain.CareTaskRepository = (*CareTaskRepository)(nil)
+
+type CareTaskRepository struct {
+	tasks map[domain.CareTaskID]*domain.CareTask
+	mutex sync.RWMutex
+}
+
+func NewCareTaskRepository() *CareTaskRepository {
+	return &CareTaskRepository{
+		tasks: make(map[domain.CareTaskID]*domain.CareTask),
+	}
+}
+
+func (r *CareTaskRepository) GetCareTask(ctx context.Context, id domain.CareTaskID) (*domain.CareTask, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	task, exists := r.tasks[id]
+	if !exists {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrCareTaskNotFound, id)
+	}
+
+	return task, nil
+}
+
+func (r *CareTaskRepository) AddCareTask(ctx context.Context, task *domain.CareTask) error {
+	if task.ID == domain.CareTaskID(uuid.Nil) {
+		return fmt.Errorf("care task: %w", domain.ErrNilID)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.tasks[task.ID]; exists {
+		return fmt.Errorf("%w: id %s", domain.ErrCareTaskAlreadyExists, task.ID)
+	}
+
+	r.tasks[task.ID] = task
+	return nil
+}
+
+func (r *CareTaskRepository) UpdateCareTask(ctx context.Context, task *domain.CareTask) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.tasks[task.ID]; !exists {
+		return fmt.Errorf("%w: id %s", domain.ErrCareTaskNotFound, task.ID)
+	}
+
+	r.tasks[task.ID] = task
+	return nil
+}
+
+func (r *CareTaskRepository) DeleteCareTask(ctx context.Context, id domain.CareTaskID) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.tasks[id]; !exists {
+		return fmt.Errorf("%w: id %s", domain.ErrCareTaskNotFound, id)
+	}
+
+	delete(r.tasks, id)
+	return nil
+}
+
+// GetAllCareTasks возвращает все задачи по уходу, упорядоченные по времени первого выполнения
+func (r *CareTaskRepository) GetAllCareTasks(ctx context.Context) ([]*domain.CareTask, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	tasks := make([]*domain.CareTask, 0, len(r.tasks))
+	for _, task := range r.tasks {
+		tasks = append(tasks, task)
+	}
+
+	// Задачи с одинаковым временем упорядочены по ID, чтобы порядок не зависел от обхода map
+	sort.Slice(tasks, func(i, j int) bool {
+		if !tasks[i].Time.Equal(tasks[j].Time) {
+			return tasks[i].Time.Before(tasks[j].Time)
+		}
+
+		return tasks[i].ID.String() < tasks[j].ID.String()
+	})
+
+	return tasks, nil
+}
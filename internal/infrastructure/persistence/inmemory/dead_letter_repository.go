@@ -0,0 +1,66 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/pkg/events"
+)
+
+// Статическая проверка реализации интерфейса
+var _ events.DeadLetterStore = (*DeadLetterRepository)(nil)
+
+type DeadLetterRepository struct {
+	letters []*events.DeadLetter
+	mutex   sync.RWMutex
+}
+
+func NewDeadLetterRepository() *DeadLetterRepository {
+	return &DeadLetterRepository{}
+}
+
+func (r *DeadLetterRepository) AddDeadLetter(ctx context.Context, letter *events.DeadLetter) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.letters = append(r.letters, letter)
+
+	return nil
+}
+
+func (r *DeadLetterRepository) GetDeadLetter(ctx context.Context, id uuid.UUID) (*events.DeadLetter, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, letter := range r.letters {
+		if letter.ID == id {
+			return letter, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: id %s", events.ErrDeadLetterNotFound, id)
+}
+
+func (r *DeadLetterRepository) GetAllDeadLetters(ctx context.Context) ([]*events.DeadLetter, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return slices.Clone(r.letters), nil
+}
+
+func (r *DeadLetterRepository) DeleteDeadLetter(ctx context.Context, id uuid.UUID) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, letter := range r.letters {
+		if letter.ID == id {
+			r.letters = slices.Delete(r.letters, i, i+1)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: id %s", events.ErrDeadLetterNotFound, id)
+}
@@ -0,0 +1,93 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Статическая проверка реализации интерфейса
+var _ domain.DrugRepository = (*DrugRepository)(nil)
+
+type DrugRepository struct {
+	drugs map[domain.DrugName]*domain.Drug
+	mutex sync.RWMutex
+}
+
+func NewDrugRepository() *DrugRepository {
+	return &DrugRepository{
+		drugs: make(map[domain.DrugName]*domain.Drug),
+	}
+}
+
+func (r *DrugRepository) GetDrug(ctx context.Context, name domain.DrugName) (*domain.Drug, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	drug, exists := r.drugs[name]
+	if !exists {
+		return nil, fmt.Errorf("%w: name %s", domain.ErrDrugNotFound, name)
+	}
+
+	return drug, nil
+}
+
+func (r *DrugRepository) AddDrug(ctx context.Context, drug *domain.Drug) error {
+	if drug.Name == "" {
+		return fmt.Errorf("drug: %w", domain.ErrEmptyDrugName)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.drugs[drug.Name]; exists {
+		return fmt.Errorf("%w: name %s", domain.ErrDrugAlreadyExists, drug.Name)
+	}
+
+	r.drugs[drug.Name] = drug
+	return nil
+}
+
+func (r *DrugRepository) DeleteDrug(ctx context.Context, name domain.DrugName) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.drugs[name]; !exists {
+		return fmt.Errorf("%w: name %s", domain.ErrDrugNotFound, name)
+	}
+
+	delete(r.drugs, name)
+	return nil
+}
+
+func (r *DrugRepository) UpdateDrug(ctx context.Context, drug *domain.Drug) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.drugs[drug.Name]; !exists {
+		return fmt.Errorf("%w: name %s", domain.ErrDrugNotFound, drug.Name)
+	}
+
+	r.drugs[drug.Name] = drug
+	return nil
+}
+
+// GetAllDrugs возвращает формуляр, упорядоченный по названию препарата
+func (r *DrugRepository) GetAllDrugs(ctx context.Context) ([]*domain.Drug, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	drugs := make([]*domain.Drug, 0, len(r.drugs))
+	for _, drug := range r.drugs {
+		drugs = append(drugs, drug)
+	}
+
+	sort.Slice(drugs, func(i, j int) bool {
+		return drugs[i].Name < drugs[j].Name
+	})
+
+	return drugs, nil
+}
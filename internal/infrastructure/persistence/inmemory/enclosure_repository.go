@@ -29,7 +29,7 @@ func (r *EnclosureRepository) GetEnclosure(ctx context.Context, id domain.Enclos
 
 	enclosure, exists := r.enclosures[id]
 	if !exists {
-		return nil, fmt.Errorf("enclosure with id %s not found", id)
+		return nil, fmt.Errorf("%w: id %s", domain.ErrEnclosureNotFound, id)
 	}
 
 	return enclosure, nil
@@ -37,14 +37,14 @@ func (r *EnclosureRepository) GetEnclosure(ctx context.Context, id domain.Enclos
 
 func (r *EnclosureRepository) AddEnclosure(ctx context.Context, enclosure *domain.Enclosure) error {
 	if enclosure.ID == domain.EnclosureID(uuid.Nil) {
-		return fmt.Errorf("enclosure id cannot be nil")
+		return fmt.Errorf("enclosure: %w", domain.ErrNilID)
 	}
 
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
 	if _, exists := r.enclosures[enclosure.ID]; exists {
-		return fmt.Errorf("enclosure with id %s already exists", enclosure.ID)
+		return fmt.Errorf("%w: id %s", domain.ErrEnclosureAlreadyExists, enclosure.ID)
 	}
 
 	r.enclosures[enclosure.ID] = enclosure
@@ -57,12 +57,12 @@ func (r *EnclosureRepository) DeleteEnclosure(ctx context.Context, id domain.Enc
 
 	enclosure, exists := r.enclosures[id]
 	if !exists {
-		return fmt.Errorf("enclosure with id %s not found", id)
+		return fmt.Errorf("%w: id %s", domain.ErrEnclosureNotFound, id)
 	}
 
 	// Проверяем, содержит ли вольер животных
 	if enclosure.Occupancy.CountAnimals() > 0 {
-		return fmt.Errorf("cannot delete enclosure with id %s because it contains animals", id)
+		return fmt.Errorf("%w: id %s", domain.ErrEnclosureNotEmpty, id)
 	}
 
 	delete(r.enclosures, id)
@@ -74,7 +74,7 @@ func (r *EnclosureRepository) UpdateEnclosure(ctx context.Context, enclosure *do
 	defer r.mutex.Unlock()
 
 	if _, exists := r.enclosures[enclosure.ID]; !exists {
-		return fmt.Errorf("enclosure with id %s not found", enclosure.ID)
+		return fmt.Errorf("%w: id %s", domain.ErrEnclosureNotFound, enclosure.ID)
 	}
 
 	r.enclosures[enclosure.ID] = enclosure
@@ -3,6 +3,7 @@ package inmemory
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -15,12 +16,16 @@ var _ domain.FeedingScheduleRepository = (*FeedingScheduleRepository)(nil)
 
 type FeedingScheduleRepository struct {
 	schedules map[domain.FeedingScheduleID]*domain.FeedingSchedule
-	mutex     sync.RWMutex
+	// history хранит выполненные и пропущенные кормления повторяющихся расписаний в хронологическом порядке.
+	// Срезы не изменяются на месте, а заменяются новыми
+	history map[domain.FeedingScheduleID][]domain.FeedingOccurrence
+	mutex   sync.RWMutex
 }
 
 func NewFeedingScheduleRepository() *FeedingScheduleRepository {
 	return &FeedingScheduleRepository{
 		schedules: make(map[domain.FeedingScheduleID]*domain.FeedingSchedule),
+		history:   make(map[domain.FeedingScheduleID][]domain.FeedingOccurrence),
 	}
 }
 
@@ -30,25 +35,44 @@ func (r *FeedingScheduleRepository) GetFeedingSchedule(ctx context.Context, id d
 
 	schedule, exists := r.schedules[id]
 	if !exists {
-		return nil, fmt.Errorf("feeding schedule with id %s not found", id)
+		return nil, fmt.Errorf("%w: id %s", domain.ErrFeedingScheduleNotFound, id)
 	}
 
 	return schedule, nil
 }
 
+// GetFeedingScheduleWithHistory возвращает расписание вместе с историей кормлений в заданном временном диапазоне
+func (r *FeedingScheduleRepository) GetFeedingScheduleWithHistory(
+	ctx context.Context,
+	id domain.FeedingScheduleID,
+	startTime, endTime time.Time,
+) (*domain.FeedingSchedule, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	schedule, exists := r.schedules[id]
+	if !exists {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrFeedingScheduleNotFound, id)
+	}
+
+	return r.withHistory(schedule, startTime, endTime), nil
+}
+
 func (r *FeedingScheduleRepository) AddFeedingSchedule(ctx context.Context, schedule *domain.FeedingSchedule) error {
 	if schedule.ID == domain.FeedingScheduleID(uuid.Nil) {
-		return fmt.Errorf("feeding schedule id cannot be nil")
+		return fmt.Errorf("feeding schedule: %w", domain.ErrNilID)
 	}
 
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
 	if _, exists := r.schedules[schedule.ID]; exists {
-		return fmt.Errorf("feeding schedule with id %s already exists", schedule.ID)
+		return fmt.Errorf("%w: id %s", domain.ErrFeedingScheduleAlreadyExists, schedule.ID)
 	}
 
 	r.schedules[schedule.ID] = schedule
+	r.record(schedule)
+
 	return nil
 }
 
@@ -57,10 +81,12 @@ func (r *FeedingScheduleRepository) DeleteFeedingSchedule(ctx context.Context, i
 	defer r.mutex.Unlock()
 
 	if _, exists := r.schedules[id]; !exists {
-		return fmt.Errorf("feeding schedule with id %s not found", id)
+		return fmt.Errorf("%w: id %s", domain.ErrFeedingScheduleNotFound, id)
 	}
 
 	delete(r.schedules, id)
+	delete(r.history, id)
+
 	return nil
 }
 
@@ -69,13 +95,60 @@ func (r *FeedingScheduleRepository) UpdateFeedingSchedule(ctx context.Context, s
 	defer r.mutex.Unlock()
 
 	if _, exists := r.schedules[schedule.ID]; !exists {
-		return fmt.Errorf("feeding schedule with id %s not found", schedule.ID)
+		return fmt.Errorf("%w: id %s", domain.ErrFeedingScheduleNotFound, schedule.ID)
 	}
 
 	r.schedules[schedule.ID] = schedule
+	r.record(schedule)
+
 	return nil
 }
 
+// record дописывает в историю последнее выполненное и пропущенные кормления расписания
+func (r *FeedingScheduleRepository) record(schedule *domain.FeedingSchedule) {
+	settled := make([]domain.FeedingOccurrence, 0, len(schedule.Skipped)+1)
+	if !schedule.FedThrough.IsZero() {
+		settled = append(settled, domain.FeedingOccurrence{Time: schedule.FedThrough, Status: domain.FeedingOccurrenceStatusDone})
+	}
+
+	for _, t := range schedule.Skipped {
+		settled = append(settled, domain.FeedingOccurrence{Time: t, Status: domain.FeedingOccurrenceStatusSkipped})
+	}
+
+	history := r.history[schedule.ID]
+
+	for _, occurrence := range settled {
+		i, found := slices.BinarySearchFunc(history, occurrence.Time, compareOccurrenceTime)
+		if !found {
+			history = slices.Insert(slices.Clone(history), i, occurrence)
+		}
+	}
+
+	r.history[schedule.ID] = history
+}
+
+// withHistory возвращает копию расписания с историей кормлений в диапазоне [startTime, endTime]
+func (r *FeedingScheduleRepository) withHistory(schedule *domain.FeedingSchedule, startTime, endTime time.Time) *domain.FeedingSchedule {
+	history := r.history[schedule.ID]
+
+	from, _ := slices.BinarySearchFunc(history, startTime, compareOccurrenceTime)
+	to, found := slices.BinarySearchFunc(history, endTime, compareOccurrenceTime)
+	if found {
+		to++
+	}
+
+	cloned := schedule.Clone()
+	cloned.History = slices.DeleteFunc(slices.Clone(history[from:to]), func(o domain.FeedingOccurrence) bool {
+		return !o.Time.Before(schedule.FedThrough)
+	})
+
+	return cloned
+}
+
+func compareOccurrenceTime(o domain.FeedingOccurrence, t time.Time) int {
+	return o.Time.Compare(t)
+}
+
 func (r *FeedingScheduleRepository) GetAllFeedingSchedules(ctx context.Context) ([]*domain.FeedingSchedule, error) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
@@ -140,59 +213,58 @@ func (r *FeedingScheduleRepository) GetPendingFeedingSchedules(ctx context.Conte
 	return schedules, nil
 }
 
-// GetFeedingSchedulesForTimeRange возвращает все расписания кормлений в заданном временном диапазоне
+// GetFeedingSchedulesForTimeRange возвращает все расписания кормлений, у которых есть кормления в заданном временном диапазоне
 func (r *FeedingScheduleRepository) GetFeedingSchedulesForTimeRange(ctx context.Context, startTime, endTime time.Time) ([]*domain.FeedingSchedule, error) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
 	var schedules []*domain.FeedingSchedule
 	for _, schedule := range r.schedules {
-		scheduleTime := time.Time(schedule.Time)
-		if (scheduleTime.Equal(startTime) || scheduleTime.After(startTime)) &&
-			(scheduleTime.Equal(endTime) || scheduleTime.Before(endTime)) {
-			schedules = append(schedules, schedule)
+		// Правая граница диапазона включается
+		if len(schedule.Occurrences(startTime, endTime.Add(time.Nanosecond))) > 0 {
+			schedules = append(schedules, r.withHistory(schedule, startTime, endTime))
 		}
 	}
 
 	return schedules, nil
 }
 
-// CountCompletedFeedingsToday возвращает количество выполненных кормлений за сегодня
-func (r *FeedingScheduleRepository) CountCompletedFeedingsToday(ctx context.Context, now time.Time) (int, error) {
+// GetDueFeedingSchedules возвращает расписания, у которых есть ожидающие кормления до заданного момента
+func (r *FeedingScheduleRepository) GetDueFeedingSchedules(ctx context.Context, now time.Time) ([]*domain.FeedingSchedule, error) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
-	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-	endOfDay := startOfDay.Add(24 * time.Hour)
-
-	count := 0
+	var schedules []*domain.FeedingSchedule
 	for _, schedule := range r.schedules {
-		scheduleTime := time.Time(schedule.Time)
-		if schedule.Status == domain.FeedingStatusDone &&
-			scheduleTime.After(startOfDay) && scheduleTime.Before(endOfDay) {
-			count++
+		if next, ok := schedule.NextOccurrence(); ok && next.Before(now) {
+			schedules = append(schedules, schedule)
 		}
 	}
 
-	return count, nil
+	return schedules, nil
 }
 
-// CountPendingFeedingsToday возвращает количество ожидающих кормлений на сегодня
+// CountCompletedFeedingsToday возвращает количество выполненных за сегодня кормлений, включая повторяющиеся
+func (r *FeedingScheduleRepository) CountCompletedFeedingsToday(ctx context.Context, now time.Time) (int, error) {
+	return r.countFeedingsToday(now, domain.FeedingOccurrenceStatusDone), nil
+}
+
+// CountPendingFeedingsToday возвращает количество ожидающих кормлений на сегодня, включая повторяющиеся
 func (r *FeedingScheduleRepository) CountPendingFeedingsToday(ctx context.Context, now time.Time) (int, error) {
+	return r.countFeedingsToday(now, domain.FeedingOccurrenceStatusPending), nil
+}
+
+func (r *FeedingScheduleRepository) countFeedingsToday(now time.Time, status domain.FeedingOccurrenceStatus) int {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
 	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	endOfDay := startOfDay.Add(24 * time.Hour)
 
-	count := 0
+	schedules := make([]*domain.FeedingSchedule, 0, len(r.schedules))
 	for _, schedule := range r.schedules {
-		scheduleTime := time.Time(schedule.Time)
-		if schedule.Status == domain.FeedingStatusNotDone &&
-			scheduleTime.After(startOfDay) && scheduleTime.Before(endOfDay) {
-			count++
-		}
+		schedules = append(schedules, r.withHistory(schedule, startOfDay, endOfDay))
 	}
 
-	return count, nil
+	return domain.CountFeedingOccurrences(schedules, startOfDay, endOfDay)[status]
 }
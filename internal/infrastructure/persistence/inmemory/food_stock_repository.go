@@ -0,0 +1,93 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Статическая проверка реализации интерфейса
+var _ domain.FoodStockRepository = (*FoodStockRepository)(nil)
+
+type FoodStockRepository struct {
+	stocks map[domain.Food]*domain.FoodStock
+	mutex  sync.RWMutex
+}
+
+func NewFoodStockRepository() *FoodStockRepository {
+	return &FoodStockRepository{
+		stocks: make(map[domain.Food]*domain.FoodStock),
+	}
+}
+
+func (r *FoodStockRepository) GetFoodStock(ctx context.Context, food domain.Food) (*domain.FoodStock, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	stock, exists := r.stocks[food]
+	if !exists {
+		return nil, fmt.Errorf("%w: food %s", domain.ErrFoodStockNotFound, food)
+	}
+
+	return stock, nil
+}
+
+func (r *FoodStockRepository) AddFoodStock(ctx context.Context, stock *domain.FoodStock) error {
+	if stock.Food == "" {
+		return fmt.Errorf("food stock: %w", domain.ErrEmptyFoodName)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.stocks[stock.Food]; exists {
+		return fmt.Errorf("%w: food %s", domain.ErrFoodStockAlreadyExists, stock.Food)
+	}
+
+	r.stocks[stock.Food] = stock
+	return nil
+}
+
+func (r *FoodStockRepository) DeleteFoodStock(ctx context.Context, food domain.Food) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.stocks[food]; !exists {
+		return fmt.Errorf("%w: food %s", domain.ErrFoodStockNotFound, food)
+	}
+
+	delete(r.stocks, food)
+	return nil
+}
+
+func (r *FoodStockRepository) UpdateFoodStock(ctx context.Context, stock *domain.FoodStock) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.stocks[stock.Food]; !exists {
+		return fmt.Errorf("%w: food %s", domain.ErrFoodStockNotFound, stock.Food)
+	}
+
+	r.stocks[stock.Food] = stock
+	return nil
+}
+
+// GetAllFoodStocks возвращает склад кормов, упорядоченный по названию корма
+func (r *FoodStockRepository) GetAllFoodStocks(ctx context.Context) ([]*domain.FoodStock, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	stocks := make([]*domain.FoodStock, 0, len(r.stocks))
+	for _, stock := range r.stocks {
+		stocks = append(stocks, stock)
+	}
+
+	sort.Slice(stocks, func(i, j int) bool {
+		return stocks[i].Food < stocks[j].Food
+	})
+
+	return stocks, nil
+}
@@ -0,0 +1,99 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Статическая проверка реализации интерфейса
+var _ domain.KeeperRepository = (*KeeperRepository)(nil)
+
+type KeeperRepository struct {
+	keepers map[domain.KeeperID]*domain.Keeper
+	mutex   sync.RWMutex
+}
+
+func NewKeeperRepository() *KeeperRepository {
+	return &KeeperRepository{
+		keepers: make(map[domain.KeeperID]*domain.Keeper),
+	}
+}
+
+func (r *KeeperRepository) GetKeeper(ctx context.Context, id domain.KeeperID) (*domain.Keeper, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	keeper, exists := r.keepers[id]
+	if !exists {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrKeeperNotFound, id)
+	}
+
+	return keeper, nil
+}
+
+func (r *KeeperRepository) AddKeeper(ctx context.Context, keeper *domain.Keeper) error {
+	if keeper.ID == domain.KeeperID(uuid.Nil) {
+		return fmt.Errorf("keeper: %w", domain.ErrNilID)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.keepers[keeper.ID]; exists {
+		return fmt.Errorf("%w: id %s", domain.ErrKeeperAlreadyExists, keeper.ID)
+	}
+
+	r.keepers[keeper.ID] = keeper
+	return nil
+}
+
+func (r *KeeperRepository) UpdateKeeper(ctx context.Context, keeper *domain.Keeper) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.keepers[keeper.ID]; !exists {
+		return fmt.Errorf("%w: id %s", domain.ErrKeeperNotFound, keeper.ID)
+	}
+
+	r.keepers[keeper.ID] = keeper
+	return nil
+}
+
+func (r *KeeperRepository) DeleteKeeper(ctx context.Context, id domain.KeeperID) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.keepers[id]; !exists {
+		return fmt.Errorf("%w: id %s", domain.ErrKeeperNotFound, id)
+	}
+
+	delete(r.keepers, id)
+	return nil
+}
+
+// GetAllKeepers возвращает всех смотрителей, упорядоченных по имени
+func (r *KeeperRepository) GetAllKeepers(ctx context.Context) ([]*domain.Keeper, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	keepers := make([]*domain.Keeper, 0, len(r.keepers))
+	for _, keeper := range r.keepers {
+		keepers = append(keepers, keeper)
+	}
+
+	// Смотрители с одинаковыми именами упорядочены по ID, чтобы порядок не зависел от обхода map
+	sort.Slice(keepers, func(i, j int) bool {
+		if keepers[i].Name != keepers[j].Name {
+			return keepers[i].Name < keepers[j].Name
+		}
+
+		return keepers[i].ID.String() < keepers[j].ID.String()
+	})
+
+	return keepers, nil
+}
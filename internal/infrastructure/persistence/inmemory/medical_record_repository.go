@@ -0,0 +1,64 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Статическая проверка реализации интерфейса
+var _ domain.MedicalRecordRepository = (*MedicalRecordRepository)(nil)
+
+type MedicalRecordRepository struct {
+	records map[domain.AnimalID]*domain.MedicalRecord
+	mutex   sync.RWMutex
+}
+
+func NewMedicalRecordRepository() *MedicalRecordRepository {
+	return &MedicalRecordRepository{
+		records: make(map[domain.AnimalID]*domain.MedicalRecord),
+	}
+}
+
+func (r *MedicalRecordRepository) GetMedicalRecord(ctx context.Context, animalID domain.AnimalID) (*domain.MedicalRecord, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	record, exists := r.records[animalID]
+	if !exists {
+		return nil, fmt.Errorf("%w: animal id %s", domain.ErrMedicalRecordNotFound, animalID)
+	}
+
+	return record, nil
+}
+
+func (r *MedicalRecordRepository) AddMedicalRecord(ctx context.Context, record *domain.MedicalRecord) error {
+	if record.AnimalID == domain.AnimalID(uuid.Nil) {
+		return fmt.Errorf("medical record: %w", domain.ErrNilID)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.records[record.AnimalID]; exists {
+		return fmt.Errorf("%w: animal id %s", domain.ErrMedicalRecordAlreadyExists, record.AnimalID)
+	}
+
+	r.records[record.AnimalID] = record
+	return nil
+}
+
+func (r *MedicalRecordRepository) UpdateMedicalRecord(ctx context.Context, record *domain.MedicalRecord) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.records[record.AnimalID]; !exists {
+		return fmt.Errorf("%w: animal id %s", domain.ErrMedicalRecordNotFound, record.AnimalID)
+	}
+
+	r.records[record.AnimalID] = record
+	return nil
+}
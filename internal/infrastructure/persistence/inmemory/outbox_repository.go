@@ -0,0 +1,119 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/pkg/events"
+)
+
+// Статическая проверка реализации интерфейсов
+var (
+	_ events.Outbox      = (*OutboxRepository)(nil)
+	_ events.OutboxStore = (*OutboxRepository)(nil)
+)
+
+type OutboxRepository struct {
+	messages []*events.OutboxMessage
+	mutex    sync.RWMutex
+}
+
+func NewOutboxRepository() *OutboxRepository {
+	return &OutboxRepository{}
+}
+
+func (r *OutboxRepository) Record(ctx context.Context, event events.Event, occurredAt time.Time) error {
+	payload, err := events.Encode(event)
+	if err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.messages = append(r.messages, &events.OutboxMessage{
+		ID:         uuid.New(),
+		EventType:  event.Name(),
+		Payload:    payload,
+		OccurredAt: occurredAt,
+		Delivered:  make(map[string]struct{}),
+	})
+
+	return nil
+}
+
+// PendingMessages возвращает копии сообщений, ещё не доставленных всем обработчикам
+func (r *OutboxRepository) PendingMessages(ctx context.Context, limit int) ([]*events.OutboxMessage, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	n := min(limit, len(r.messages))
+	messages := make([]*events.OutboxMessage, 0, n)
+
+	for _, message := range r.messages[:n] {
+		cloned := *message
+		cloned.Delivered = make(map[string]struct{}, len(message.Delivered))
+
+		for handler := range message.Delivered {
+			cloned.Delivered[handler] = struct{}{}
+		}
+
+		messages = append(messages, &cloned)
+	}
+
+	return messages, nil
+}
+
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, messageID uuid.UUID, handler string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, message := range r.messages {
+		if message.ID == messageID {
+			message.Delivered[handler] = struct{}{}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("outbox message with id %s not found", messageID)
+}
+
+func (r *OutboxRepository) RecordAttempt(ctx context.Context, messageID uuid.UUID) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, message := range r.messages {
+		if message.ID == messageID {
+			message.Attempts++
+			return nil
+		}
+	}
+
+	return fmt.Errorf("outbox message with id %s not found", messageID)
+}
+
+// MarkProcessed удаляет сообщение из outbox: после доставки всем обработчикам оно больше не нужно
+func (r *OutboxRepository) MarkProcessed(ctx context.Context, messageID uuid.UUID) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, message := range r.messages {
+		if message.ID == messageID {
+			r.messages = append(r.messages[:i], r.messages[i+1:]...)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("outbox message with id %s not found", messageID)
+}
+
+// append добавляет сообщения, записанные в рамках зафиксированной транзакции
+func (r *OutboxRepository) append(messages []*events.OutboxMessage) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.messages = append(r.messages, messages...)
+}
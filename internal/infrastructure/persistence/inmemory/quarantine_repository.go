@@ -0,0 +1,107 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Статическая проверка реализации интерфейса
+var _ domain.QuarantineRepository = (*QuarantineRepository)(nil)
+
+type QuarantineRepository struct {
+	quarantines map[domain.QuarantineID]*domain.Quarantine
+	mutex       sync.RWMutex
+}
+
+func NewQuarantineRepository() *QuarantineRepository {
+	return &QuarantineRepository{
+		quarantines: make(map[domain.QuarantineID]*domain.Quarantine),
+	}
+}
+
+func (r *QuarantineRepository) GetQuarantine(ctx context.Context, id domain.QuarantineID) (*domain.Quarantine, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	quarantine, exists := r.quarantines[id]
+	if !exists {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrQuarantineNotFound, id)
+	}
+
+	return quarantine, nil
+}
+
+func (r *QuarantineRepository) AddQuarantine(ctx context.Context, quarantine *domain.Quarantine) error {
+	if quarantine.ID == domain.QuarantineID(uuid.Nil) {
+		return fmt.Errorf("quarantine: %w", domain.ErrNilID)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.quarantines[quarantine.ID]; exists {
+		return fmt.Errorf("%w: id %s", domain.ErrQuarantineAlreadyExists, quarantine.ID)
+	}
+
+	r.quarantines[quarantine.ID] = quarantine
+	return nil
+}
+
+func (r *QuarantineRepository) UpdateQuarantine(ctx context.Context, quarantine *domain.Quarantine) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.quarantines[quarantine.ID]; !exists {
+		return fmt.Errorf("%w: id %s", domain.ErrQuarantineNotFound, quarantine.ID)
+	}
+
+	r.quarantines[quarantine.ID] = quarantine
+	return nil
+}
+
+// GetAllQuarantines возвращает все карантины, начиная с самого раннего
+func (r *QuarantineRepository) GetAllQuarantines(ctx context.Context) ([]*domain.Quarantine, error) {
+	return r.filter(func(*domain.Quarantine) bool { return true }), nil
+}
+
+// GetActiveQuarantine возвращает текущий карантин животного
+func (r *QuarantineRepository) GetActiveQuarantine(ctx context.Context, animalID domain.AnimalID) (*domain.Quarantine, error) {
+	active := r.filter(func(q *domain.Quarantine) bool {
+		return q.AnimalID == animalID && q.IsActive()
+	})
+
+	if len(active) == 0 {
+		return nil, fmt.Errorf("%w: no active quarantine for animal %s", domain.ErrQuarantineNotFound, animalID)
+	}
+
+	return active[0], nil
+}
+
+// GetActiveQuarantines возвращает карантины, которые ещё не сняты
+func (r *QuarantineRepository) GetActiveQuarantines(ctx context.Context) ([]*domain.Quarantine, error) {
+	return r.filter(func(q *domain.Quarantine) bool { return q.IsActive() }), nil
+}
+
+func (r *QuarantineRepository) filter(keep func(*domain.Quarantine) bool) []*domain.Quarantine {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	quarantines := make([]*domain.Quarantine, 0)
+
+	for _, quarantine := range r.quarantines {
+		if keep(quarantine) {
+			quarantines = append(quarantines, quarantine)
+		}
+	}
+
+	sort.Slice(quarantines, func(i, j int) bool {
+		return quarantines[i].StartedAt.Before(quarantines[j].StartedAt)
+	})
+
+	return quarantines
+}
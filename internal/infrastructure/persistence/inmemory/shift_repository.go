@@ -0,0 +1,93 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Статическая проверка реализации интерфейса
+var _ domain.ShiftRepository = (*ShiftRepository)(nil)
+
+type ShiftRepository struct {
+	shifts map[domain.ShiftName]*domain.Shift
+	mutex  sync.RWMutex
+}
+
+func NewShiftRepository() *ShiftRepository {
+	return &ShiftRepository{
+		shifts: make(map[domain.ShiftName]*domain.Shift),
+	}
+}
+
+func (r *ShiftRepository) GetShift(ctx context.Context, name domain.ShiftName) (*domain.Shift, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	shift, exists := r.shifts[name]
+	if !exists {
+		return nil, fmt.Errorf("%w: name %s", domain.ErrShiftNotFound, name)
+	}
+
+	return shift, nil
+}
+
+func (r *ShiftRepository) AddShift(ctx context.Context, shift *domain.Shift) error {
+	if shift.Name == "" {
+		return fmt.Errorf("shift: %w", domain.ErrEmptyShiftName)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.shifts[shift.Name]; exists {
+		return fmt.Errorf("%w: name %s", domain.ErrShiftAlreadyExists, shift.Name)
+	}
+
+	r.shifts[shift.Name] = shift
+	return nil
+}
+
+func (r *ShiftRepository) UpdateShift(ctx context.Context, shift *domain.Shift) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.shifts[shift.Name]; !exists {
+		return fmt.Errorf("%w: name %s", domain.ErrShiftNotFound, shift.Name)
+	}
+
+	r.shifts[shift.Name] = shift
+	return nil
+}
+
+func (r *ShiftRepository) DeleteShift(ctx context.Context, name domain.ShiftName) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.shifts[name]; !exists {
+		return fmt.Errorf("%w: name %s", domain.ErrShiftNotFound, name)
+	}
+
+	delete(r.shifts, name)
+	return nil
+}
+
+// GetAllShifts возвращает все смены, упорядоченные по названию
+func (r *ShiftRepository) GetAllShifts(ctx context.Context) ([]*domain.Shift, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	shifts := make([]*domain.Shift, 0, len(r.shifts))
+	for _, shift := range r.shifts {
+		shifts = append(shifts, shift)
+	}
+
+	sort.Slice(shifts, func(i, j int) bool {
+		return shifts[i].Name < shifts[j].Name
+	})
+
+	return shifts, nil
+}
@@ -0,0 +1,93 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Статическая проверка реализации интерфейса
+var _ domain.SpeciesRepository = (*SpeciesRepository)(nil)
+
+type SpeciesRepository struct {
+	species map[domain.AnimalSpecies]*domain.Species
+	mutex   sync.RWMutex
+}
+
+func NewSpeciesRepository() *SpeciesRepository {
+	return &SpeciesRepository{
+		species: make(map[domain.AnimalSpecies]*domain.Species),
+	}
+}
+
+func (r *SpeciesRepository) GetSpecies(ctx context.Context, name domain.AnimalSpecies) (*domain.Species, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	species, exists := r.species[name]
+	if !exists {
+		return nil, fmt.Errorf("%w: name %s", domain.ErrSpeciesNotFound, name)
+	}
+
+	return species, nil
+}
+
+func (r *SpeciesRepository) AddSpecies(ctx context.Context, species *domain.Species) error {
+	if species.Name == "" {
+		return fmt.Errorf("species: %w", domain.ErrEmptySpeciesName)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.species[species.Name]; exists {
+		return fmt.Errorf("%w: name %s", domain.ErrSpeciesAlreadyExists, species.Name)
+	}
+
+	r.species[species.Name] = species
+	return nil
+}
+
+func (r *SpeciesRepository) DeleteSpecies(ctx context.Context, name domain.AnimalSpecies) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.species[name]; !exists {
+		return fmt.Errorf("%w: name %s", domain.ErrSpeciesNotFound, name)
+	}
+
+	delete(r.species, name)
+	return nil
+}
+
+func (r *SpeciesRepository) UpdateSpecies(ctx context.Context, species *domain.Species) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.species[species.Name]; !exists {
+		return fmt.Errorf("%w: name %s", domain.ErrSpeciesNotFound, species.Name)
+	}
+
+	r.species[species.Name] = species
+	return nil
+}
+
+// GetAllSpecies возвращает каталог видов, упорядоченный по названию
+func (r *SpeciesRepository) GetAllSpecies(ctx context.Context) ([]*domain.Species, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	species := make([]*domain.Species, 0, len(r.species))
+	for _, s := range r.species {
+		species = append(species, s)
+	}
+
+	sort.Slice(species, func(i, j int) bool {
+		return species[i].Name < species[j].Name
+	})
+
+	return species, nil
+}
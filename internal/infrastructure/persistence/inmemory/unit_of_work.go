@@ -0,0 +1,575 @@
+package inmemory
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	"github.com/maklybae/ddd-zoo/pkg/events"
+)
+
+// Статическая проверка реализации интерфейса
+var _ domain.UnitOfWork = (*UnitOfWork)(nil)
+
+// UnitOfWork реализует транзакции поверх in-memory репозиториев по схеме copy-on-write:
+// транзакция работает с глубокими копиями репозиториев, к которым обращается, при успехе
+// копии подменяют содержимое этих репозиториев, при ошибке — просто отбрасываются.
+// Репозитории, к которым транзакция не обращалась, не копируются.
+//
+// Транзакции выполняются последовательно. Фиксация подменяет содержимое репозиториев
+// целиком, поэтому все изменения должны проходить через Do: запись в обход UnitOfWork
+// во время выполнения транзакции была бы потеряна.
+//
+// View работает с текущим содержимым репозиториев без копирования. Зафиксированные агрегаты
+// никогда не изменяются на месте, поэтому под блокировкой на чтение они согласованы.
+type UnitOfWork struct {
+	mutex            sync.RWMutex
+	animals          *AnimalRepository
+	enclosures       *EnclosureRepository
+	feedingSchedules *FeedingScheduleRepository
+	medicalRecords   *MedicalRecordRepository
+	quarantines      *QuarantineRepository
+	species          *SpeciesRepository
+	foodStocks       *FoodStockRepository
+	weightRecords    *WeightRecordRepository
+	vetAppointments  *VetAppointmentRepository
+	drugs            *DrugRepository
+	keepers          *KeeperRepository
+	shifts           *ShiftRepository
+	careTasks        *CareTaskRepository
+	webhooks         *WebhookRepository
+	outbox           *OutboxRepository
+}
+
+func NewUnitOfWork(
+	animals *AnimalRepository,
+	enclosures *EnclosureRepository,
+	feedingSchedules *FeedingScheduleRepository,
+	medicalRecords *MedicalRecordRepository,
+	quarantines *QuarantineRepository,
+	species *SpeciesRepository,
+	foodStocks *FoodStockRepository,
+	weightRecords *WeightRecordRepository,
+	vetAppointments *VetAppointmentRepository,
+	drugs *DrugRepository,
+	keepers *KeeperRepository,
+	shifts *ShiftRepository,
+	careTasks *CareTaskRepository,
+	webhooks *WebhookRepository,
+	outbox *OutboxRepository,
+) *UnitOfWork {
+	return &UnitOfWork{
+		animals:          animals,
+		enclosures:       enclosures,
+		feedingSchedules: feedingSchedules,
+		medicalRecords:   medicalRecords,
+		quarantines:      quarantines,
+		species:          species,
+		foodStocks:       foodStocks,
+		weightRecords:    weightRecords,
+		vetAppointments:  vetAppointments,
+		drugs:            drugs,
+		keepers:          keepers,
+		shifts:           shifts,
+		careTasks:        careTasks,
+		webhooks:         webhooks,
+		outbox:           outbox,
+	}
+}
+
+type (
+	txKey struct {
+		uow *UnitOfWork
+	}
+	viewKey struct {
+		uow *UnitOfWork
+	}
+)
+
+// repositories — репозитории транзакции или чтения. Транзакция получает копию репозитория
+// из source при первом обращении к нему; при чтении все репозитории заданы сразу.
+type repositories struct {
+	source *UnitOfWork
+	// mutex защищает копирование репозиториев при первом обращении
+	mutex sync.Mutex
+
+	animals          *AnimalRepository
+	enclosures       *EnclosureRepository
+	feedingSchedules *FeedingScheduleRepository
+	medicalRecords   *MedicalRecordRepository
+	quarantines      *QuarantineRepository
+	species          *SpeciesRepository
+	foodStocks       *FoodStockRepository
+	weightRecords    *WeightRecordRepository
+	vetAppointments  *VetAppointmentRepository
+	drugs            *DrugRepository
+	keepers          *KeeperRepository
+	shifts           *ShiftRepository
+	careTasks        *CareTaskRepository
+	webhooks         *WebhookRepository
+	outbox           *OutboxRepository
+}
+
+func (r *repositories) Animals() domain.AnimalRepository {
+	r.cloneGraph()
+	return r.animals
+}
+
+func (r *repositories) Enclosures() domain.EnclosureRepository {
+	r.cloneGraph()
+	return r.enclosures
+}
+
+func (r *repositories) FeedingSchedules() domain.FeedingScheduleRepository {
+	r.cloneGraph()
+	return r.feedingSchedules
+}
+
+func (r *repositories) MedicalRecords() domain.MedicalRecordRepository {
+	return cloneOnce(r, &r.medicalRecords, r.source.cloneMedicalRecords)
+}
+
+func (r *repositories) Quarantines() domain.QuarantineRepository {
+	return cloneOnce(r, &r.quarantines, r.source.cloneQuarantines)
+}
+
+func (r *repositories) Species() domain.SpeciesRepository {
+	return cloneOnce(r, &r.species, r.source.cloneSpecies)
+}
+
+func (r *repositories) FoodStocks() domain.FoodStockRepository {
+	return cloneOnce(r, &r.foodStocks, r.source.cloneFoodStocks)
+}
+
+func (r *repositories) WeightRecords() domain.WeightRecordRepository {
+	return cloneOnce(r, &r.weightRecords, r.source.cloneWeightRecords)
+}
+
+func (r *repositories) VetAppointments() domain.VetAppointmentRepository {
+	return cloneOnce(r, &r.vetAppointments, r.source.cloneVetAppointments)
+}
+
+func (r *repositories) Drugs() domain.DrugRepository {
+	return cloneOnce(r, &r.drugs, r.source.cloneDrugs)
+}
+
+func (r *repositories) Keepers() domain.KeeperRepository {
+	return cloneOnce(r, &r.keepers, r.source.cloneKeepers)
+}
+
+func (r *repositories) Shifts() domain.ShiftRepository {
+	return cloneOnce(r, &r.shifts, r.source.cloneShifts)
+}
+
+func (r *repositories) CareTasks() domain.CareTaskRepository {
+	return cloneOnce(r, &r.careTasks, r.source.cloneCareTasks)
+}
+
+func (r *repositories) Webhooks() domain.WebhookRepository {
+	return cloneOnce(r, &r.webhooks, r.source.cloneWebhooks)
+}
+
+func (r *repositories) Outbox() events.Outbox {
+	return r.outbox
+}
+
+// cloneOnce возвращает репозиторий транзакции, копируя его при первом обращении
+func cloneOnce[T any](r *repositories, repository **T, clone func() *T) *T {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if *repository == nil {
+		*repository = clone()
+	}
+
+	return *repository
+}
+
+// cloneGraph копирует животных, вольеры и расписания кормлений вместе: они ссылаются друг на друга
+func (r *repositories) cloneGraph() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.animals == nil {
+		r.animals, r.enclosures, r.feedingSchedules = r.source.cloneGraph()
+	}
+}
+
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, repos domain.Repositories) error) error {
+	if tx, ok := ctx.Value(txKey{u}).(*repositories); ok {
+		return fn(ctx, tx)
+	}
+
+	// Транзакция внутри View ждала бы сама себя
+	if ctx.Value(viewKey{u}) != nil {
+		return domain.ErrWriteInView
+	}
+
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	tx := &repositories{
+		source: u,
+		// Транзакция видит только собственные события, при фиксации они дописываются в outbox
+		outbox: NewOutboxRepository(),
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{u}, tx), tx); err != nil {
+		return err
+	}
+
+	u.commit(tx)
+
+	return nil
+}
+
+func (u *UnitOfWork) View(ctx context.Context, fn func(ctx context.Context, repos domain.Repositories) error) error {
+	if tx, ok := ctx.Value(txKey{u}).(*repositories); ok {
+		return fn(ctx, tx)
+	}
+
+	if view, ok := ctx.Value(viewKey{u}).(*repositories); ok {
+		return fn(ctx, view)
+	}
+
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	view := &repositories{
+		animals:          u.animals,
+		enclosures:       u.enclosures,
+		feedingSchedules: u.feedingSchedules,
+		medicalRecords:   u.medicalRecords,
+		quarantines:      u.quarantines,
+		species:          u.species,
+		foodStocks:       u.foodStocks,
+		weightRecords:    u.weightRecords,
+		vetAppointments:  u.vetAppointments,
+		drugs:            u.drugs,
+		keepers:          u.keepers,
+		shifts:           u.shifts,
+		careTasks:        u.careTasks,
+		webhooks:         u.webhooks,
+		// Чтение не порождает событий
+		outbox: NewOutboxRepository(),
+	}
+
+	return fn(context.WithValue(ctx, viewKey{u}, view), view)
+}
+
+// cloneGraph делает глубокую копию животных, вольеров и расписаний кормлений, сохраняя связи между ними
+func (u *UnitOfWork) cloneGraph() (*AnimalRepository, *EnclosureRepository, *FeedingScheduleRepository) {
+	u.animals.mutex.RLock()
+	defer u.animals.mutex.RUnlock()
+
+	u.enclosures.mutex.RLock()
+	defer u.enclosures.mutex.RUnlock()
+
+	u.feedingSchedules.mutex.RLock()
+	defer u.feedingSchedules.mutex.RUnlock()
+
+	c := newGraphCloner()
+
+	animals := NewAnimalRepository()
+	enclosures := NewEnclosureRepository()
+	feedingSchedules := NewFeedingScheduleRepository()
+
+	for id, enclosure := range u.enclosures.enclosures {
+		enclosures.enclosures[id] = c.enclosure(enclosure)
+	}
+
+	for id, animal := range u.animals.animals {
+		animals.animals[id] = c.animal(animal)
+	}
+
+	for id, schedule := range u.feedingSchedules.schedules {
+		cloned := schedule.Clone()
+		cloned.Animal = c.animal(schedule.Animal)
+		feedingSchedules.schedules[id] = cloned
+	}
+
+	// История кормлений заменяется, а не изменяется, поэтому достаточно скопировать отображение
+	feedingSchedules.history = maps.Clone(u.feedingSchedules.history)
+
+	return animals, enclosures, feedingSchedules
+}
+
+func (u *UnitOfWork) cloneMedicalRecords() *MedicalRecordRepository {
+	u.medicalRecords.mutex.RLock()
+	defer u.medicalRecords.mutex.RUnlock()
+
+	cloned := NewMedicalRecordRepository()
+	for id, record := range u.medicalRecords.records {
+		cloned.records[id] = record.Clone()
+	}
+
+	return cloned
+}
+
+func (u *UnitOfWork) cloneQuarantines() *QuarantineRepository {
+	u.quarantines.mutex.RLock()
+	defer u.quarantines.mutex.RUnlock()
+
+	cloned := NewQuarantineRepository()
+	for id, quarantine := range u.quarantines.quarantines {
+		copied := *quarantine
+		cloned.quarantines[id] = &copied
+	}
+
+	return cloned
+}
+
+func (u *UnitOfWork) cloneSpecies() *SpeciesRepository {
+	u.species.mutex.RLock()
+	defer u.species.mutex.RUnlock()
+
+	cloned := NewSpeciesRepository()
+	for name, species := range u.species.species {
+		cloned.species[name] = species.Clone()
+	}
+
+	return cloned
+}
+
+func (u *UnitOfWork) cloneFoodStocks() *FoodStockRepository {
+	u.foodStocks.mutex.RLock()
+	defer u.foodStocks.mutex.RUnlock()
+
+	cloned := NewFoodStockRepository()
+	for food, stock := range u.foodStocks.stocks {
+		copied := *stock
+		cloned.stocks[food] = &copied
+	}
+
+	return cloned
+}
+
+func (u *UnitOfWork) cloneWeightRecords() *WeightRecordRepository {
+	u.weightRecords.mutex.RLock()
+	defer u.weightRecords.mutex.RUnlock()
+
+	cloned := NewWeightRecordRepository()
+	for id, record := range u.weightRecords.records {
+		cloned.records[id] = record.Clone()
+	}
+
+	return cloned
+}
+
+func (u *UnitOfWork) cloneVetAppointments() *VetAppointmentRepository {
+	u.vetAppointments.mutex.RLock()
+	defer u.vetAppointments.mutex.RUnlock()
+
+	cloned := NewVetAppointmentRepository()
+	for id, appointment := range u.vetAppointments.appointments {
+		copied := *appointment
+		cloned.appointments[id] = &copied
+	}
+
+	return cloned
+}
+
+func (u *UnitOfWork) cloneDrugs() *DrugRepository {
+	u.drugs.mutex.RLock()
+	defer u.drugs.mutex.RUnlock()
+
+	cloned := NewDrugRepository()
+	for name, drug := range u.drugs.drugs {
+		cloned.drugs[name] = drug.Clone()
+	}
+
+	return cloned
+}
+
+func (u *UnitOfWork) cloneKeepers() *KeeperRepository {
+	u.keepers.mutex.RLock()
+	defer u.keepers.mutex.RUnlock()
+
+	cloned := NewKeeperRepository()
+	for id, keeper := range u.keepers.keepers {
+		cloned.keepers[id] = keeper.Clone()
+	}
+
+	return cloned
+}
+
+func (u *UnitOfWork) cloneShifts() *ShiftRepository {
+	u.shifts.mutex.RLock()
+	defer u.shifts.mutex.RUnlock()
+
+	cloned := NewShiftRepository()
+	for name, shift := range u.shifts.shifts {
+		cloned.shifts[name] = shift.Clone()
+	}
+
+	return cloned
+}
+
+func (u *UnitOfWork) cloneCareTasks() *CareTaskRepository {
+	u.careTasks.mutex.RLock()
+	defer u.careTasks.mutex.RUnlock()
+
+	cloned := NewCareTaskRepository()
+	for id, task := range u.careTasks.tasks {
+		cloned.tasks[id] = task.Clone()
+	}
+
+	return cloned
+}
+
+func (u *UnitOfWork) cloneWebhooks() *WebhookRepository {
+	u.webhooks.mutex.RLock()
+	defer u.webhooks.mutex.RUnlock()
+
+	cloned := NewWebhookRepository()
+	for id, webhook := range u.webhooks.webhooks {
+		copied := *webhook
+		cloned.webhooks[id] = &copied
+	}
+
+	// Попытки доставки только дописываются. Срезы обрезаются по длине, чтобы дописывание в транзакции
+	// выделяло новый массив, а не писало в общий: так история не копируется целиком
+	for id, attempts := range u.webhooks.attempts {
+		cloned.attempts[id] = slices.Clip(attempts)
+	}
+
+	return cloned
+}
+
+// commit подменяет содержимое репозиториев, к которым обращалась транзакция, их копиями
+func (u *UnitOfWork) commit(tx *repositories) {
+	if tx.animals != nil {
+		u.animals.mutex.Lock()
+		u.animals.animals = tx.animals.animals
+		u.animals.mutex.Unlock()
+
+		u.enclosures.mutex.Lock()
+		u.enclosures.enclosures = tx.enclosures.enclosures
+		u.enclosures.mutex.Unlock()
+
+		u.feedingSchedules.mutex.Lock()
+		u.feedingSchedules.schedules = tx.feedingSchedules.schedules
+		u.feedingSchedules.history = tx.feedingSchedules.history
+		u.feedingSchedules.mutex.Unlock()
+	}
+
+	if tx.medicalRecords != nil {
+		u.medicalRecords.mutex.Lock()
+		u.medicalRecords.records = tx.medicalRecords.records
+		u.medicalRecords.mutex.Unlock()
+	}
+
+	if tx.quarantines != nil {
+		u.quarantines.mutex.Lock()
+		u.quarantines.quarantines = tx.quarantines.quarantines
+		u.quarantines.mutex.Unlock()
+	}
+
+	if tx.species != nil {
+		u.species.mutex.Lock()
+		u.species.species = tx.species.species
+		u.species.mutex.Unlock()
+	}
+
+	if tx.foodStocks != nil {
+		u.foodStocks.mutex.Lock()
+		u.foodStocks.stocks = tx.foodStocks.stocks
+		u.foodStocks.mutex.Unlock()
+	}
+
+	if tx.weightRecords != nil {
+		u.weightRecords.mutex.Lock()
+		u.weightRecords.records = tx.weightRecords.records
+		u.weightRecords.mutex.Unlock()
+	}
+
+	if tx.vetAppointments != nil {
+		u.vetAppointments.mutex.Lock()
+		u.vetAppointments.appointments = tx.vetAppointments.appointments
+		u.vetAppointments.mutex.Unlock()
+	}
+
+	if tx.drugs != nil {
+		u.drugs.mutex.Lock()
+		u.drugs.drugs = tx.drugs.drugs
+		u.drugs.mutex.Unlock()
+	}
+
+	if tx.keepers != nil {
+		u.keepers.mutex.Lock()
+		u.keepers.keepers = tx.keepers.keepers
+		u.keepers.mutex.Unlock()
+	}
+
+	if tx.shifts != nil {
+		u.shifts.mutex.Lock()
+		u.shifts.shifts = tx.shifts.shifts
+		u.shifts.mutex.Unlock()
+	}
+
+	if tx.careTasks != nil {
+		u.careTasks.mutex.Lock()
+		u.careTasks.tasks = tx.careTasks.tasks
+		u.careTasks.mutex.Unlock()
+	}
+
+	if tx.webhooks != nil {
+		u.webhooks.mutex.Lock()
+		u.webhooks.webhooks = tx.webhooks.webhooks
+		u.webhooks.attempts = tx.webhooks.attempts
+		u.webhooks.mutex.Unlock()
+	}
+
+	u.outbox.append(tx.outbox.messages)
+}
+
+// graphCloner копирует животных и вольеры, ссылающихся друг на друга, ровно по одному разу
+type graphCloner struct {
+	animals    map[*domain.Animal]*domain.Animal
+	enclosures map[*domain.Enclosure]*domain.Enclosure
+}
+
+func newGraphCloner() *graphCloner {
+	return &graphCloner{
+		animals:    make(map[*domain.Animal]*domain.Animal),
+		enclosures: make(map[*domain.Enclosure]*domain.Enclosure),
+	}
+}
+
+func (c *graphCloner) animal(a *domain.Animal) *domain.Animal {
+	if a == nil {
+		return nil
+	}
+
+	if cloned, ok := c.animals[a]; ok {
+		return cloned
+	}
+
+	cloned := *a
+	c.animals[a] = &cloned
+	cloned.Enclosure = c.enclosure(a.Enclosure)
+
+	return &cloned
+}
+
+func (c *graphCloner) enclosure(e *domain.Enclosure) *domain.Enclosure {
+	if e == nil {
+		return nil
+	}
+
+	if cloned, ok := c.enclosures[e]; ok {
+		return cloned
+	}
+
+	cloned := *e
+	c.enclosures[e] = &cloned
+	cloned.Occupancy.Animals = make(map[*domain.Animal]struct{}, len(e.Occupancy.Animals))
+
+	for animal := range e.Occupancy.Animals {
+		cloned.Occupancy.Animals[c.animal(animal)] = struct{}{}
+	}
+
+	return &cloned
+}
@@ -0,0 +1,129 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestUnitOfWork() *UnitOfWork {
+	return NewUnitOfWork(
+		NewAnimalRepository(),
+		NewEnclosureRepository(),
+		NewFeedingScheduleRepository(),
+		NewMedicalRecordRepository(),
+		NewQuarantineRepository(),
+		NewSpeciesRepository(),
+		NewFoodStockRepository(),
+		NewWeightRecordRepository(),
+		NewVetAppointmentRepository(),
+		NewDrugRepository(),
+		NewKeeperRepository(),
+		NewShiftRepository(),
+		NewCareTaskRepository(),
+		NewWebhookRepository(),
+		NewOutboxRepository(),
+	)
+}
+
+var errAbort = errors.New("abort")
+
+func TestUnitOfWorkCopiesOnlyTouchedRepositories(t *testing.T) {
+	ctx := context.Background()
+	unitOfWork := newTestUnitOfWork()
+
+	animal := &domain.Animal{ID: domain.AnimalID(uuid.New()), Name: "Zed", Species: "Zebra"}
+
+	err := unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Animals().AddAnimal(ctx, animal)
+	})
+	require.NoError(t, err)
+
+	animals := reflect.ValueOf(unitOfWork.animals.animals).UnsafePointer()
+
+	keeper, err := domain.NewKeeper("Ann", nil, 1)
+	require.NoError(t, err)
+
+	err = unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Keepers().AddKeeper(ctx, keeper)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, animals, reflect.ValueOf(unitOfWork.animals.animals).UnsafePointer())
+
+	err = unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		if _, err := repos.Keepers().GetKeeper(ctx, keeper.ID); err != nil {
+			return err
+		}
+
+		stored, err := repos.Animals().GetAnimal(ctx, animal.ID)
+		if err != nil {
+			return err
+		}
+
+		assert.Same(t, animal, stored)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestUnitOfWorkRollsBackFailedTransaction(t *testing.T) {
+	ctx := context.Background()
+	unitOfWork := newTestUnitOfWork()
+
+	webhook := &domain.WebhookSubscription{ID: domain.WebhookID(uuid.New())}
+	first := &domain.WebhookDeliveryAttempt{ID: uuid.New(), WebhookID: webhook.ID, Attempt: 1}
+
+	err := unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		if err := repos.Webhooks().AddWebhook(ctx, webhook); err != nil {
+			return err
+		}
+
+		return repos.Webhooks().AddDeliveryAttempt(ctx, first)
+	})
+	require.NoError(t, err)
+
+	keeper, err := domain.NewKeeper("Ann", nil, 1)
+	require.NoError(t, err)
+
+	failed := &domain.WebhookDeliveryAttempt{ID: uuid.New(), WebhookID: webhook.ID, Attempt: 2}
+
+	err = unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		if err := repos.Keepers().AddKeeper(ctx, keeper); err != nil {
+			return err
+		}
+
+		if err := repos.Webhooks().AddDeliveryAttempt(ctx, failed); err != nil {
+			return err
+		}
+
+		return errAbort
+	})
+	require.ErrorIs(t, err, errAbort)
+
+	second := &domain.WebhookDeliveryAttempt{ID: uuid.New(), WebhookID: webhook.ID, Attempt: 2}
+
+	err = unitOfWork.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Webhooks().AddDeliveryAttempt(ctx, second)
+	})
+	require.NoError(t, err)
+
+	err = unitOfWork.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		_, err := repos.Keepers().GetKeeper(ctx, keeper.ID)
+		assert.ErrorIs(t, err, domain.ErrKeeperNotFound)
+
+		attempts, err := repos.Webhooks().GetDeliveryAttempts(ctx, webhook.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []*domain.WebhookDeliveryAttempt{first, second}, attempts)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
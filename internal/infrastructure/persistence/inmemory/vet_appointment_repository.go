@@ -0,0 +1,110 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Статическая проверка реализации интерфейса
+var _ domain.VetAppointmentRepository = (*VetAppointmentRepository)(nil)
+
+type VetAppointmentRepository struct {
+	appointments map[domain.VetAppointmentID]*domain.VetAppointment
+	mutex        sync.RWMutex
+}
+
+func NewVetAppointmentRepository() *VetAppointmentRepository {
+	return &VetAppointmentRepository{
+		appointments: make(map[domain.VetAppointmentID]*domain.VetAppointment),
+	}
+}
+
+func (r *VetAppointmentRepository) GetVetAppointment(ctx context.Context, id domain.VetAppointmentID) (*domain.VetAppointment, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	appointment, exists := r.appointments[id]
+	if !exists {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrVetAppointmentNotFound, id)
+	}
+
+	return appointment, nil
+}
+
+func (r *VetAppointmentRepository) AddVetAppointment(ctx context.Context, appointment *domain.VetAppointment) error {
+	if appointment.ID == domain.VetAppointmentID(uuid.Nil) {
+		return fmt.Errorf("vet appointment: %w", domain.ErrNilID)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.appointments[appointment.ID]; exists {
+		return fmt.Errorf("%w: id %s", domain.ErrVetAppointmentAlreadyExists, appointment.ID)
+	}
+
+	r.appointments[appointment.ID] = appointment
+	return nil
+}
+
+func (r *VetAppointmentRepository) UpdateVetAppointment(ctx context.Context, appointment *domain.VetAppointment) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.appointments[appointment.ID]; !exists {
+		return fmt.Errorf("%w: id %s", domain.ErrVetAppointmentNotFound, appointment.ID)
+	}
+
+	r.appointments[appointment.ID] = appointment
+	return nil
+}
+
+func (r *VetAppointmentRepository) DeleteVetAppointment(ctx context.Context, id domain.VetAppointmentID) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.appointments[id]; !exists {
+		return fmt.Errorf("%w: id %s", domain.ErrVetAppointmentNotFound, id)
+	}
+
+	delete(r.appointments, id)
+	return nil
+}
+
+// GetAllVetAppointments возвращает все приёмы, упорядоченные по времени начала
+func (r *VetAppointmentRepository) GetAllVetAppointments(ctx context.Context) ([]*domain.VetAppointment, error) {
+	return r.filter(func(*domain.VetAppointment) bool { return true }), nil
+}
+
+// GetVetAppointmentsForTimeRange возвращает приёмы, пересекающиеся с диапазоном [startTime, endTime)
+func (r *VetAppointmentRepository) GetVetAppointmentsForTimeRange(ctx context.Context, startTime, endTime time.Time) ([]*domain.VetAppointment, error) {
+	period := domain.TimeSlot{Start: startTime, End: endTime}
+
+	return r.filter(func(appointment *domain.VetAppointment) bool {
+		return appointment.Slot.Overlaps(period)
+	}), nil
+}
+
+func (r *VetAppointmentRepository) filter(keep func(*domain.VetAppointment) bool) []*domain.VetAppointment {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	appointments := make([]*domain.VetAppointment, 0)
+	for _, appointment := range r.appointments {
+		if keep(appointment) {
+			appointments = append(appointments, appointment)
+		}
+	}
+
+	sort.Slice(appointments, func(i, j int) bool {
+		return appointments[i].Slot.Start.Before(appointments[j].Slot.Start)
+	})
+
+	return appointments
+}
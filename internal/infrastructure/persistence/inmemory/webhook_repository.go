@@ -0,0 +1,129 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Статическая проверка реализации интерфейса
+var _ domain.WebhookRepository = (*WebhookRepository)(nil)
+
+type WebhookRepository struct {
+	webhooks map[domain.WebhookID]*domain.WebhookSubscription
+	attempts map[domain.WebhookID][]*domain.WebhookDeliveryAttempt
+	mutex    sync.RWMutex
+}
+
+func NewWebhookRepository() *WebhookRepository {
+	return &WebhookRepository{
+		webhooks: make(map[domain.WebhookID]*domain.WebhookSubscription),
+		attempts: make(map[domain.WebhookID][]*domain.WebhookDeliveryAttempt),
+	}
+}
+
+func (r *WebhookRepository) GetWebhook(ctx context.Context, id domain.WebhookID) (*domain.WebhookSubscription, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	webhook, exists := r.webhooks[id]
+	if !exists {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrWebhookNotFound, id)
+	}
+
+	return webhook, nil
+}
+
+func (r *WebhookRepository) AddWebhook(ctx context.Context, webhook *domain.WebhookSubscription) error {
+	if webhook.ID == domain.WebhookID(uuid.Nil) {
+		return fmt.Errorf("webhook: %w", domain.ErrNilID)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.webhooks[webhook.ID]; exists {
+		return fmt.Errorf("%w: id %s", domain.ErrWebhookAlreadyExists, webhook.ID)
+	}
+
+	r.webhooks[webhook.ID] = webhook
+	return nil
+}
+
+// DeleteWebhook удаляет подписку вместе с историей доставок
+func (r *WebhookRepository) DeleteWebhook(ctx context.Context, id domain.WebhookID) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.webhooks[id]; !exists {
+		return fmt.Errorf("%w: id %s", domain.ErrWebhookNotFound, id)
+	}
+
+	delete(r.webhooks, id)
+	delete(r.attempts, id)
+	return nil
+}
+
+func (r *WebhookRepository) GetAllWebhooks(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	webhooks := make([]*domain.WebhookSubscription, 0, len(r.webhooks))
+	for _, webhook := range r.webhooks {
+		webhooks = append(webhooks, webhook)
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool {
+		return webhooks[i].CreatedAt.Before(webhooks[j].CreatedAt)
+	})
+
+	return webhooks, nil
+}
+
+// GetWebhooksForEvent возвращает подписки, получающие события с указанным именем
+func (r *WebhookRepository) GetWebhooksForEvent(ctx context.Context, eventType string) ([]*domain.WebhookSubscription, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var webhooks []*domain.WebhookSubscription
+
+	for _, webhook := range r.webhooks {
+		if webhook.Events.Matches(eventType) {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+
+	return webhooks, nil
+}
+
+func (r *WebhookRepository) AddDeliveryAttempt(ctx context.Context, attempt *domain.WebhookDeliveryAttempt) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// Попытки доставки на удалённую подписку не сохраняются
+	if _, exists := r.webhooks[attempt.WebhookID]; !exists {
+		return fmt.Errorf("%w: id %s", domain.ErrWebhookNotFound, attempt.WebhookID)
+	}
+
+	r.attempts[attempt.WebhookID] = append(r.attempts[attempt.WebhookID], attempt)
+	return nil
+}
+
+// GetDeliveryAttempts возвращает историю доставок подписки в порядке попыток
+func (r *WebhookRepository) GetDeliveryAttempts(ctx context.Context, webhookID domain.WebhookID) ([]*domain.WebhookDeliveryAttempt, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if _, exists := r.webhooks[webhookID]; !exists {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrWebhookNotFound, webhookID)
+	}
+
+	attempts := make([]*domain.WebhookDeliveryAttempt, len(r.attempts[webhookID]))
+	copy(attempts, r.attempts[webhookID])
+
+	return attempts, nil
+}
@@ -0,0 +1,64 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Статическая проверка реализации интерфейса
+var _ domain.WeightRecordRepository = (*WeightRecordRepository)(nil)
+
+type WeightRecordRepository struct {
+	records map[domain.AnimalID]*domain.WeightRecord
+	mutex   sync.RWMutex
+}
+
+func NewWeightRecordRepository() *WeightRecordRepository {
+	return &WeightRecordRepository{
+		records: make(map[domain.AnimalID]*domain.WeightRecord),
+	}
+}
+
+func (r *WeightRecordRepository) GetWeightRecord(ctx context.Context, animalID domain.AnimalID) (*domain.WeightRecord, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	record, exists := r.records[animalID]
+	if !exists {
+		return nil, fmt.Errorf("%w: animal id %s", domain.ErrWeightRecordNotFound, animalID)
+	}
+
+	return record, nil
+}
+
+func (r *WeightRecordRepository) AddWeightRecord(ctx context.Context, record *domain.WeightRecord) error {
+	if record.AnimalID == domain.AnimalID(uuid.Nil) {
+		return fmt.Errorf("weight record: %w", domain.ErrNilID)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.records[record.AnimalID]; exists {
+		return fmt.Errorf("%w: animal id %s", domain.ErrWeightRecordAlreadyExists, record.AnimalID)
+	}
+
+	r.records[record.AnimalID] = record
+	return nil
+}
+
+func (r *WeightRecordRepository) UpdateWeightRecord(ctx context.Context, record *domain.WeightRecord) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.records[record.AnimalID]; !exists {
+		return fmt.Errorf("%w: animal id %s", domain.ErrWeightRecordNotFound, record.AnimalID)
+	}
+
+	r.records[record.AnimalID] = record
+	return nil
+}
@@ -0,0 +1,130 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Static check that the interface is implemented.
+var _ domain.AnimalRepository = (*AnimalRepository)(nil)
+
+type AnimalRepository struct {
+	q querier
+}
+
+func NewAnimalRepository(db *sql.DB) *AnimalRepository {
+	return &AnimalRepository{q: db}
+}
+
+func (r *AnimalRepository) GetAnimal(ctx context.Context, id domain.AnimalID) (*domain.Animal, error) {
+	animals, err := newGraphLoader(r.q).loadAnimals(ctx, "WHERE a.id = ?", id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(animals) == 0 {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrAnimalNotFound, id)
+	}
+
+	return animals[0], nil
+}
+
+func (r *AnimalRepository) AddAnimal(ctx context.Context, animal *domain.Animal) error {
+	if animal.ID == domain.AnimalID(uuid.Nil) {
+		return fmt.Errorf("animal: %w", domain.ErrNilID)
+	}
+
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM animals WHERE id = ?", animal.ID.String())
+	if err != nil {
+		return fmt.Errorf("checking animal existence: %w", err)
+	}
+
+	if exists > 0 {
+		return fmt.Errorf("%w: id %s", domain.ErrAnimalAlreadyExists, animal.ID)
+	}
+
+	_, err = r.q.ExecContext(ctx, `INSERT INTO animals
+		(id, name, gender, species, birth_date, favorite_food, status, enclosure_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		animal.ID.String(),
+		string(animal.Name),
+		string(animal.Gender),
+		string(animal.Species),
+		toUnix(time.Time(animal.BirthDate)),
+		string(animal.FavoriteFood),
+		int(animal.Status),
+		enclosureIDOf(animal),
+	)
+	if err != nil {
+		return fmt.Errorf("inserting animal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AnimalRepository) DeleteAnimal(ctx context.Context, id domain.AnimalID) error {
+	res, err := r.q.ExecContext(ctx, "DELETE FROM animals WHERE id = ?", id.String())
+	if err != nil {
+		return fmt.Errorf("deleting animal: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: id %s", domain.ErrAnimalNotFound, id))
+}
+
+func (r *AnimalRepository) UpdateAnimal(ctx context.Context, animal *domain.Animal) error {
+	res, err := r.q.ExecContext(ctx, `UPDATE animals SET
+		name = ?, gender = ?, species = ?, birth_date = ?, favorite_food = ?, status = ?, enclosure_id = ?
+		WHERE id = ?`,
+		string(animal.Name),
+		string(animal.Gender),
+		string(animal.Species),
+		toUnix(time.Time(animal.BirthDate)),
+		string(animal.FavoriteFood),
+		int(animal.Status),
+		enclosureIDOf(animal),
+		animal.ID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("updating animal: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: id %s", domain.ErrAnimalNotFound, animal.ID))
+}
+
+func (r *AnimalRepository) GetAllAnimals(ctx context.Context) ([]*domain.Animal, error) {
+	return newGraphLoader(r.q).loadAnimals(ctx, "")
+}
+
+func (r *AnimalRepository) CountAnimals(ctx context.Context) (int, error) {
+	return count(ctx, r.q, "SELECT COUNT(*) FROM animals")
+}
+
+// GetAnimalsByEnclosure returns all animals living in the given enclosure.
+func (r *AnimalRepository) GetAnimalsByEnclosure(ctx context.Context, enclosureID domain.EnclosureID) ([]*domain.Animal, error) {
+	return newGraphLoader(r.q).loadAnimals(ctx, "WHERE a.enclosure_id = ?", enclosureID.String())
+}
+
+// CountHealthyAnimals returns the number of healthy animals.
+func (r *AnimalRepository) CountHealthyAnimals(ctx context.Context) (int, error) {
+	return count(ctx, r.q, "SELECT COUNT(*) FROM animals WHERE status = ?", int(domain.AnimalStatusHealthy))
+}
+
+// CountSickAnimals returns the number of animals whose status counts as sick, see domain.AnimalStatus.IsSick.
+func (r *AnimalRepository) CountSickAnimals(ctx context.Context) (int, error) {
+	statuses := domain.SickStatuses()
+
+	args := make([]any, len(statuses))
+	for i, status := range statuses {
+		args[i] = int(status)
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(args)), ", ")
+
+	return count(ctx, r.q, "SELECT COUNT(*) FROM animals WHERE status IN ("+placeholders+")", args...)
+}
@@ -0,0 +1,50 @@
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnimalRepositoryCountSickAnimals(t *testing.T) {
+	ctx := context.Background()
+	uow, _ := newTestUnitOfWork(t)
+
+	statuses := []domain.AnimalStatus{
+		domain.AnimalStatusHealthy,
+		domain.AnimalStatusUnderObservation,
+		domain.AnimalStatusSick,
+		domain.AnimalStatusQuarantined,
+		domain.AnimalStatusRecovering,
+	}
+
+	err := uow.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		for _, status := range statuses {
+			animal := newTestAnimal(domain.AnimalName(status.String()), nil)
+			animal.Status = status
+
+			if err := repos.Animals().AddAnimal(ctx, animal); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = uow.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		sick, err := repos.Animals().CountSickAnimals(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 3, sick)
+
+		healthy, err := repos.Animals().CountHealthyAnimals(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, healthy)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
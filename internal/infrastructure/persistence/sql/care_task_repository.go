@@ -0,0 +1,265 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Static check that the interface is implemented.
+var _ domain.CareTaskRepository = (*CareTaskRepository)(nil)
+
+type CareTaskRepository struct {
+	q querier
+}
+
+func NewCareTaskRepository(db *sql.DB) *CareTaskRepository {
+	return &CareTaskRepository{q: db}
+}
+
+func (r *CareTaskRepository) GetCareTask(ctx context.Context, id domain.CareTaskID) (*domain.CareTask, error) {
+	tasks, err := r.loadTasks(ctx, "WHERE t.id = ?", id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrCareTaskNotFound, id)
+	}
+
+	return tasks[0], nil
+}
+
+func (r *CareTaskRepository) AddCareTask(ctx context.Context, task *domain.CareTask) error {
+	if task.ID == domain.CareTaskID(uuid.Nil) {
+		return fmt.Errorf("care task: %w", domain.ErrNilID)
+	}
+
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM care_tasks WHERE id = ?", task.ID.String())
+	if err != nil {
+		return fmt.Errorf("checking care task existence: %w", err)
+	}
+
+	if exists > 0 {
+		return fmt.Errorf("%w: id %s", domain.ErrCareTaskAlreadyExists, task.ID)
+	}
+
+	recurrence, timeZone := encodeRecurrence(task.Recurrence)
+
+	_, err = r.q.ExecContext(ctx,
+		`INSERT INTO care_tasks (id, kind, enclosure_id, task_time, recurrence, time_zone, assignee_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		task.ID.String(),
+		string(task.Kind),
+		task.EnclosureID.String(),
+		toUnix(task.Time),
+		recurrence,
+		timeZone,
+		encodeAssignee(task.Assignee),
+	)
+	if err != nil {
+		return fmt.Errorf("inserting care task: %w", err)
+	}
+
+	return r.insertCompletions(ctx, task)
+}
+
+func (r *CareTaskRepository) UpdateCareTask(ctx context.Context, task *domain.CareTask) error {
+	recurrence, timeZone := encodeRecurrence(task.Recurrence)
+
+	res, err := r.q.ExecContext(ctx,
+		`UPDATE care_tasks
+		SET kind = ?, enclosure_id = ?, task_time = ?, recurrence = ?, time_zone = ?, assignee_id = ?
+		WHERE id = ?`,
+		string(task.Kind),
+		task.EnclosureID.String(),
+		toUnix(task.Time),
+		recurrence,
+		timeZone,
+		encodeAssignee(task.Assignee),
+		task.ID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("updating care task: %w", err)
+	}
+
+	if err := ensureAffected(res, fmt.Errorf("%w: id %s", domain.ErrCareTaskNotFound, task.ID)); err != nil {
+		return err
+	}
+
+	// Completions are replaced as a whole, like the occurrences of a feeding schedule
+	if _, err := r.q.ExecContext(ctx, "DELETE FROM care_task_completions WHERE task_id = ?", task.ID.String()); err != nil {
+		return fmt.Errorf("deleting care task completions: %w", err)
+	}
+
+	return r.insertCompletions(ctx, task)
+}
+
+func (r *CareTaskRepository) DeleteCareTask(ctx context.Context, id domain.CareTaskID) error {
+	res, err := r.q.ExecContext(ctx, "DELETE FROM care_tasks WHERE id = ?", id.String())
+	if err != nil {
+		return fmt.Errorf("deleting care task: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: id %s", domain.ErrCareTaskNotFound, id))
+}
+
+func (r *CareTaskRepository) GetAllCareTasks(ctx context.Context) ([]*domain.CareTask, error) {
+	return r.loadTasks(ctx, "")
+}
+
+func (r *CareTaskRepository) insertCompletions(ctx context.Context, task *domain.CareTask) error {
+	for _, t := range task.Completed {
+		_, err := r.q.ExecContext(ctx,
+			"INSERT INTO care_task_completions (task_id, occurrence_time) VALUES (?, ?)",
+			task.ID.String(),
+			toUnix(t),
+		)
+		if err != nil {
+			return fmt.Errorf("inserting care task completion: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// loadTasks loads tasks matching where together with their completions.
+// The where clause must reference the care_tasks table as "t".
+func (r *CareTaskRepository) loadTasks(ctx context.Context, where string, args ...any) ([]*domain.CareTask, error) {
+	rows, err := r.q.QueryContext(ctx,
+		`SELECT t.id, t.kind, t.enclosure_id, t.task_time, t.recurrence, t.time_zone, t.assignee_id
+		FROM care_tasks t `+where+` ORDER BY t.task_time, t.id`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying care tasks: %w", err)
+	}
+
+	tasks := make([]*domain.CareTask, 0)
+	byID := make(map[domain.CareTaskID]*domain.CareTask)
+
+	for rows.Next() {
+		task, err := scanCareTask(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		tasks = append(tasks, task)
+		byID[task.ID] = task
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying care tasks: %w", err)
+	}
+
+	rows, err = r.q.QueryContext(ctx,
+		`SELECT c.task_id, c.occurrence_time FROM care_task_completions c
+		WHERE c.task_id IN (SELECT t.id FROM care_tasks t `+where+`)
+		ORDER BY c.occurrence_time`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying care task completions: %w", err)
+	}
+
+	for rows.Next() {
+		var (
+			rawTaskID      string
+			occurrenceTime int64
+		)
+
+		if err := rows.Scan(&rawTaskID, &occurrenceTime); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scanning care task completion: %w", err)
+		}
+
+		taskID, err := parseUUID(rawTaskID)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		if task, ok := byID[domain.CareTaskID(taskID)]; ok {
+			task.Completed = append(task.Completed, fromUnix(occurrenceTime))
+		}
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying care task completions: %w", err)
+	}
+
+	return tasks, nil
+}
+
+func scanCareTask(rows *sql.Rows) (*domain.CareTask, error) {
+	var (
+		rawID          string
+		kind           string
+		rawEnclosureID string
+		taskTime       int64
+		recurrence     sql.NullString
+		timeZone       sql.NullString
+		rawAssigneeID  sql.NullString
+	)
+
+	if err := rows.Scan(&rawID, &kind, &rawEnclosureID, &taskTime, &recurrence, &timeZone, &rawAssigneeID); err != nil {
+		return nil, fmt.Errorf("scanning care task: %w", err)
+	}
+
+	id, err := parseUUID(rawID)
+	if err != nil {
+		return nil, err
+	}
+
+	enclosureID, err := parseUUID(rawEnclosureID)
+	if err != nil {
+		return nil, err
+	}
+
+	task := &domain.CareTask{
+		ID:          domain.CareTaskID(id),
+		Kind:        domain.TaskKind(kind),
+		EnclosureID: domain.EnclosureID(enclosureID),
+		Time:        fromUnix(taskTime),
+	}
+
+	if task.Assignee, err = decodeAssignee(rawAssigneeID); err != nil {
+		return nil, err
+	}
+
+	if recurrence.Valid {
+		if task.Recurrence, err = domain.ParseRecurrence(recurrence.String, timeZone.String); err != nil {
+			return nil, fmt.Errorf("parsing recurrence of care task %s: %w", task.ID, err)
+		}
+	}
+
+	return task, nil
+}
+
+// encodeAssignee returns the ID of the keeper a task is assigned to, NULL if it falls to the keeper on duty.
+func encodeAssignee(assignee *domain.KeeperID) sql.NullString {
+	if assignee == nil {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: assignee.String(), Valid: true}
+}
+
+func decodeAssignee(raw sql.NullString) (*domain.KeeperID, error) {
+	if !raw.Valid {
+		return nil, nil
+	}
+
+	id, err := parseUUID(raw.String)
+	if err != nil {
+		return nil, err
+	}
+
+	assignee := domain.KeeperID(id)
+
+	return &assignee, nil
+}
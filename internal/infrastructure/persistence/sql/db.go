@@ -0,0 +1,105 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	// Register the embedded SQLite driver.
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// querier is implemented by both *sql.DB and *sql.Tx.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Open opens (creating if necessary) the SQLite database file at path and applies pending migrations.
+//
+// Every transaction of the returned database takes the write lock up front, so read-only
+// units of work should use OpenReadOnly instead.
+func Open(ctx context.Context, path string) (*sql.DB, error) {
+	// Immediate transactions take the write lock up front, so concurrent units of work
+	// wait on busy_timeout instead of failing when upgrading a read lock.
+	dsn := fmt.Sprintf("file:%s?_foreign_keys=on&_busy_timeout=5000&_journal_mode=WAL&_txlock=immediate", path)
+
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("opening sqlite database: %w", err)
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("connecting to sqlite database: %w", err)
+	}
+
+	if err := Migrate(ctx, db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// OpenReadOnly opens the SQLite database file at path, already created by Open, for reading.
+// Its transactions are deferred: in WAL mode they neither take nor wait for the write lock.
+func OpenReadOnly(ctx context.Context, path string) (*sql.DB, error) {
+	dsn := fmt.Sprintf("file:%s?mode=ro&_foreign_keys=on&_busy_timeout=5000", path)
+
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("opening sqlite database for reading: %w", err)
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("connecting to sqlite database for reading: %w", err)
+	}
+
+	return db, nil
+}
+
+func toUnix(t time.Time) int64 {
+	return t.UnixNano()
+}
+
+func fromUnix(nanos int64) time.Time {
+	return time.Unix(0, nanos).UTC()
+}
+
+func parseUUID(s string) (uuid.UUID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("parsing id %q: %w", s, err)
+	}
+
+	return id, nil
+}
+
+// ensureAffected returns notFound when the statement did not touch any row.
+func ensureAffected(res sql.Result, notFound error) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking affected rows: %w", err)
+	}
+
+	if n == 0 {
+		return notFound
+	}
+
+	return nil
+}
+
+func count(ctx context.Context, q querier, query string, args ...any) (int, error) {
+	var n int
+	if err := q.QueryRowContext(ctx, query, args...).Scan(&n); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
@@ -0,0 +1,96 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestUnitOfWork opens a fresh database file in a temporary directory.
+func newTestUnitOfWork(t *testing.T) (*UnitOfWork, *sql.DB) {
+	t.Helper()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "zoo.db")
+
+	db, err := Open(ctx, path)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	readDB, err := OpenReadOnly(ctx, path)
+	require.NoError(t, err)
+	t.Cleanup(func() { readDB.Close() })
+
+	return NewUnitOfWork(db, readDB), db
+}
+
+func TestOpenAppliesAllMigrations(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "zoo.db")
+
+	migrations, err := loadMigrations()
+	require.NoError(t, err)
+	require.Len(t, migrations, 19)
+
+	for range 2 {
+		db, err := Open(ctx, path)
+		require.NoError(t, err)
+
+		applied, err := count(ctx, db, "SELECT COUNT(*) FROM schema_migrations")
+		require.NoError(t, err)
+		assert.Equal(t, len(migrations), applied)
+
+		require.NoError(t, db.Close())
+	}
+}
+
+func TestUnitOfWorkRollsBackOnError(t *testing.T) {
+	ctx := context.Background()
+	uow, _ := newTestUnitOfWork(t)
+
+	enclosure := newTestEnclosure(1)
+
+	errAbort := domain.NewConflictError("abort", "abort")
+	err := uow.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		require.NoError(t, repos.Enclosures().AddEnclosure(ctx, enclosure))
+		return errAbort
+	})
+	require.ErrorIs(t, err, errAbort)
+
+	err = uow.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		_, err := repos.Enclosures().GetEnclosure(ctx, enclosure.ID)
+		return err
+	})
+	assert.ErrorIs(t, err, domain.ErrEnclosureNotFound)
+}
+
+func TestUnitOfWorkRejectsWritesInView(t *testing.T) {
+	ctx := context.Background()
+	uow, _ := newTestUnitOfWork(t)
+
+	err := uow.View(ctx, func(ctx context.Context, _ domain.Repositories) error {
+		return uow.Do(ctx, func(context.Context, domain.Repositories) error { return nil })
+	})
+	assert.ErrorIs(t, err, domain.ErrWriteInView)
+}
+
+func TestViewDoesNotWaitForWriter(t *testing.T) {
+	ctx := context.Background()
+	uow, db := newTestUnitOfWork(t)
+
+	// An open immediate transaction holds the write lock
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	err = uow.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		_, err := repos.Enclosures().CountEnclosures(ctx)
+		return err
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,105 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/pkg/events"
+)
+
+// Static check that the interface is implemented.
+var _ events.DeadLetterStore = (*DeadLetterRepository)(nil)
+
+const deadLetterColumns = "id, event_type, handler, payload, error, attempts, failed_at"
+
+type DeadLetterRepository struct {
+	q querier
+}
+
+func NewDeadLetterRepository(db *sql.DB) *DeadLetterRepository {
+	return &DeadLetterRepository{q: db}
+}
+
+func (r *DeadLetterRepository) AddDeadLetter(ctx context.Context, letter *events.DeadLetter) error {
+	_, err := r.q.ExecContext(ctx,
+		"INSERT INTO dead_letters ("+deadLetterColumns+") VALUES (?, ?, ?, ?, ?, ?, ?)",
+		letter.ID.String(),
+		letter.EventType,
+		letter.Handler,
+		letter.Payload,
+		letter.Error,
+		letter.Attempts,
+		toUnix(letter.FailedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("inserting dead letter: %w", err)
+	}
+
+	return nil
+}
+
+func (r *DeadLetterRepository) GetDeadLetter(ctx context.Context, id uuid.UUID) (*events.DeadLetter, error) {
+	letters, err := r.loadDeadLetters(ctx, "WHERE id = ?", id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(letters) == 0 {
+		return nil, fmt.Errorf("%w: id %s", events.ErrDeadLetterNotFound, id)
+	}
+
+	return letters[0], nil
+}
+
+func (r *DeadLetterRepository) GetAllDeadLetters(ctx context.Context) ([]*events.DeadLetter, error) {
+	return r.loadDeadLetters(ctx, "")
+}
+
+func (r *DeadLetterRepository) DeleteDeadLetter(ctx context.Context, id uuid.UUID) error {
+	res, err := r.q.ExecContext(ctx, "DELETE FROM dead_letters WHERE id = ?", id.String())
+	if err != nil {
+		return fmt.Errorf("deleting dead letter: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: id %s", events.ErrDeadLetterNotFound, id))
+}
+
+func (r *DeadLetterRepository) loadDeadLetters(ctx context.Context, where string, args ...any) ([]*events.DeadLetter, error) {
+	rows, err := r.q.QueryContext(ctx, "SELECT "+deadLetterColumns+" FROM dead_letters "+where+" ORDER BY seq", args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying dead letters: %w", err)
+	}
+
+	var letters []*events.DeadLetter
+
+	for rows.Next() {
+		var (
+			rawID    string
+			letter   events.DeadLetter
+			failedAt int64
+		)
+
+		if err := rows.Scan(
+			&rawID, &letter.EventType, &letter.Handler, &letter.Payload, &letter.Error, &letter.Attempts, &failedAt,
+		); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scanning dead letter: %w", err)
+		}
+
+		if letter.ID, err = parseUUID(rawID); err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		letter.FailedAt = fromUnix(failedAt)
+		letters = append(letters, &letter)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying dead letters: %w", err)
+	}
+
+	return letters, nil
+}
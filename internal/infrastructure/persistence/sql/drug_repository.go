@@ -0,0 +1,217 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Static check that the interface is implemented.
+var _ domain.DrugRepository = (*DrugRepository)(nil)
+
+type DrugRepository struct {
+	q querier
+}
+
+func NewDrugRepository(db *sql.DB) *DrugRepository {
+	return &DrugRepository{q: db}
+}
+
+func (r *DrugRepository) GetDrug(ctx context.Context, name domain.DrugName) (*domain.Drug, error) {
+	drugs, err := r.loadDrugs(ctx, "WHERE name = ?", string(name))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(drugs) == 0 {
+		return nil, fmt.Errorf("%w: name %s", domain.ErrDrugNotFound, name)
+	}
+
+	return drugs[0], nil
+}
+
+func (r *DrugRepository) AddDrug(ctx context.Context, drug *domain.Drug) error {
+	if drug.Name == "" {
+		return fmt.Errorf("drug: %w", domain.ErrEmptyDrugName)
+	}
+
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM drugs WHERE name = ?", string(drug.Name))
+	if err != nil {
+		return fmt.Errorf("checking drug existence: %w", err)
+	}
+
+	if exists > 0 {
+		return fmt.Errorf("%w: name %s", domain.ErrDrugAlreadyExists, drug.Name)
+	}
+
+	contraindications, err := encodeContraindications(drug.Contraindications)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.q.ExecContext(ctx,
+		"INSERT INTO drugs (name, contraindications) VALUES (?, ?)",
+		string(drug.Name),
+		contraindications,
+	)
+	if err != nil {
+		return fmt.Errorf("inserting drug: %w", err)
+	}
+
+	return r.insertDosages(ctx, drug)
+}
+
+func (r *DrugRepository) DeleteDrug(ctx context.Context, name domain.DrugName) error {
+	res, err := r.q.ExecContext(ctx, "DELETE FROM drugs WHERE name = ?", string(name))
+	if err != nil {
+		return fmt.Errorf("deleting drug: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: name %s", domain.ErrDrugNotFound, name))
+}
+
+// UpdateDrug replaces the contraindications and the dosages stored for the drug.
+func (r *DrugRepository) UpdateDrug(ctx context.Context, drug *domain.Drug) error {
+	contraindications, err := encodeContraindications(drug.Contraindications)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.q.ExecContext(ctx,
+		"UPDATE drugs SET contraindications = ? WHERE name = ?",
+		contraindications,
+		string(drug.Name),
+	)
+	if err != nil {
+		return fmt.Errorf("updating drug: %w", err)
+	}
+
+	if err := ensureAffected(res, fmt.Errorf("%w: name %s", domain.ErrDrugNotFound, drug.Name)); err != nil {
+		return err
+	}
+
+	if _, err := r.q.ExecContext(ctx, "DELETE FROM drug_dosages WHERE drug = ?", string(drug.Name)); err != nil {
+		return fmt.Errorf("deleting dosages: %w", err)
+	}
+
+	return r.insertDosages(ctx, drug)
+}
+
+func (r *DrugRepository) GetAllDrugs(ctx context.Context) ([]*domain.Drug, error) {
+	return r.loadDrugs(ctx, "")
+}
+
+func (r *DrugRepository) insertDosages(ctx context.Context, drug *domain.Drug) error {
+	for _, dosage := range drug.Dosages {
+		_, err := r.q.ExecContext(ctx,
+			"INSERT INTO drug_dosages (drug, species, min_mg_per_kg, max_mg_per_kg, max_daily_mg_per_kg) VALUES (?, ?, ?, ?, ?)",
+			string(drug.Name),
+			string(dosage.Species),
+			dosage.MinMgPerKg,
+			dosage.MaxMgPerKg,
+			dosage.MaxDailyMgPerKg,
+		)
+		if err != nil {
+			return fmt.Errorf("inserting dosage: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *DrugRepository) loadDrugs(ctx context.Context, where string, args ...any) ([]*domain.Drug, error) {
+	rows, err := r.q.QueryContext(ctx, "SELECT name, contraindications FROM drugs "+where+" ORDER BY name", args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying drugs: %w", err)
+	}
+
+	drugs := make([]*domain.Drug, 0)
+
+	for rows.Next() {
+		var name, encoded string
+
+		if err := rows.Scan(&name, &encoded); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scanning drug: %w", err)
+		}
+
+		drug := &domain.Drug{Name: domain.DrugName(name)}
+
+		if err := json.Unmarshal([]byte(encoded), &drug.Contraindications); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("decoding contraindications: %w", err)
+		}
+
+		drugs = append(drugs, drug)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying drugs: %w", err)
+	}
+
+	if err := r.loadDosages(ctx, drugs, where, args...); err != nil {
+		return nil, err
+	}
+
+	return drugs, nil
+}
+
+// loadDosages fills the dosages of the drugs loaded with where.
+func (r *DrugRepository) loadDosages(ctx context.Context, drugs []*domain.Drug, where string, args ...any) error {
+	byName := make(map[domain.DrugName]*domain.Drug, len(drugs))
+	for _, drug := range drugs {
+		byName[drug.Name] = drug
+	}
+
+	rows, err := r.q.QueryContext(ctx,
+		`SELECT drug, species, min_mg_per_kg, max_mg_per_kg, max_daily_mg_per_kg FROM drug_dosages
+		WHERE drug IN (SELECT name FROM drugs `+where+`)
+		ORDER BY seq`,
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("querying dosages: %w", err)
+	}
+
+	for rows.Next() {
+		var (
+			dosage        domain.SpeciesDosage
+			name, species string
+		)
+
+		if err := rows.Scan(&name, &species, &dosage.MinMgPerKg, &dosage.MaxMgPerKg, &dosage.MaxDailyMgPerKg); err != nil {
+			rows.Close()
+			return fmt.Errorf("scanning dosage: %w", err)
+		}
+
+		drug, ok := byName[domain.DrugName(name)]
+		if !ok {
+			continue
+		}
+
+		dosage.Species = domain.AnimalSpecies(species)
+		drug.Dosages = append(drug.Dosages, dosage)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return fmt.Errorf("querying dosages: %w", err)
+	}
+
+	return nil
+}
+
+func encodeContraindications(contraindications []string) (string, error) {
+	if contraindications == nil {
+		contraindications = []string{}
+	}
+
+	encoded, err := json.Marshal(contraindications)
+	if err != nil {
+		return "", fmt.Errorf("encoding contraindications: %w", err)
+	}
+
+	return string(encoded), nil
+}
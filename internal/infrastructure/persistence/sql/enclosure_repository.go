@@ -0,0 +1,130 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Static check that the interface is implemented.
+var _ domain.EnclosureRepository = (*EnclosureRepository)(nil)
+
+// hasSpace selects enclosures holding fewer animals than their capacity.
+const hasSpace = "e.capacity > (SELECT COUNT(*) FROM animals r WHERE r.enclosure_id = e.id)"
+
+// EnclosureRepository stores enclosure attributes; occupancy is derived from animals.enclosure_id,
+// so moving animals between enclosures is persisted through AnimalRepository.UpdateAnimal.
+type EnclosureRepository struct {
+	q querier
+}
+
+func NewEnclosureRepository(db *sql.DB) *EnclosureRepository {
+	return &EnclosureRepository{q: db}
+}
+
+func (r *EnclosureRepository) GetEnclosure(ctx context.Context, id domain.EnclosureID) (*domain.Enclosure, error) {
+	enclosures, err := newGraphLoader(r.q).loadEnclosures(ctx, "WHERE e.id = ?", id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(enclosures) == 0 {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrEnclosureNotFound, id)
+	}
+
+	return enclosures[0], nil
+}
+
+func (r *EnclosureRepository) AddEnclosure(ctx context.Context, enclosure *domain.Enclosure) error {
+	if enclosure.ID == domain.EnclosureID(uuid.Nil) {
+		return fmt.Errorf("enclosure: %w", domain.ErrNilID)
+	}
+
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM enclosures WHERE id = ?", enclosure.ID.String())
+	if err != nil {
+		return fmt.Errorf("checking enclosure existence: %w", err)
+	}
+
+	if exists > 0 {
+		return fmt.Errorf("%w: id %s", domain.ErrEnclosureAlreadyExists, enclosure.ID)
+	}
+
+	_, err = r.q.ExecContext(ctx,
+		"INSERT INTO enclosures (id, type, size, capacity) VALUES (?, ?, ?, ?)",
+		enclosure.ID.String(),
+		string(enclosure.Type),
+		int(enclosure.Size),
+		enclosure.Occupancy.Capacity,
+	)
+	if err != nil {
+		return fmt.Errorf("inserting enclosure: %w", err)
+	}
+
+	return nil
+}
+
+func (r *EnclosureRepository) DeleteEnclosure(ctx context.Context, id domain.EnclosureID) error {
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM enclosures WHERE id = ?", id.String())
+	if err != nil {
+		return fmt.Errorf("checking enclosure existence: %w", err)
+	}
+
+	if exists == 0 {
+		return fmt.Errorf("%w: id %s", domain.ErrEnclosureNotFound, id)
+	}
+
+	animals, err := count(ctx, r.q, "SELECT COUNT(*) FROM animals WHERE enclosure_id = ?", id.String())
+	if err != nil {
+		return fmt.Errorf("counting enclosure animals: %w", err)
+	}
+
+	if animals > 0 {
+		return fmt.Errorf("%w: id %s", domain.ErrEnclosureNotEmpty, id)
+	}
+
+	if _, err := r.q.ExecContext(ctx, "DELETE FROM enclosures WHERE id = ?", id.String()); err != nil {
+		return fmt.Errorf("deleting enclosure: %w", err)
+	}
+
+	return nil
+}
+
+func (r *EnclosureRepository) UpdateEnclosure(ctx context.Context, enclosure *domain.Enclosure) error {
+	res, err := r.q.ExecContext(ctx,
+		"UPDATE enclosures SET type = ?, size = ?, capacity = ? WHERE id = ?",
+		string(enclosure.Type),
+		int(enclosure.Size),
+		enclosure.Occupancy.Capacity,
+		enclosure.ID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("updating enclosure: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: id %s", domain.ErrEnclosureNotFound, enclosure.ID))
+}
+
+func (r *EnclosureRepository) GetAllEnclosures(ctx context.Context) ([]*domain.Enclosure, error) {
+	return newGraphLoader(r.q).loadEnclosures(ctx, "")
+}
+
+func (r *EnclosureRepository) CountEnclosures(ctx context.Context) (int, error) {
+	return count(ctx, r.q, "SELECT COUNT(*) FROM enclosures")
+}
+
+func (r *EnclosureRepository) CountFreeEnclosures(ctx context.Context) (int, error) {
+	return count(ctx, r.q, "SELECT COUNT(*) FROM enclosures e WHERE "+hasSpace)
+}
+
+// GetEnclosuresByType returns all enclosures of the given type.
+func (r *EnclosureRepository) GetEnclosuresByType(ctx context.Context, enclosureType domain.EnclosureType) ([]*domain.Enclosure, error) {
+	return newGraphLoader(r.q).loadEnclosures(ctx, "WHERE e.type = ?", string(enclosureType))
+}
+
+// GetEnclosuresWithSpace returns all enclosures that still have free space.
+func (r *EnclosureRepository) GetEnclosuresWithSpace(ctx context.Context) ([]*domain.Enclosure, error) {
+	return newGraphLoader(r.q).loadEnclosures(ctx, "WHERE "+hasSpace)
+}
@@ -0,0 +1,155 @@
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEnclosure(capacity int) *domain.Enclosure {
+	return &domain.Enclosure{
+		ID:   domain.EnclosureID(uuid.New()),
+		Type: domain.EnclosureTypeSavanna,
+		Size: 100,
+		Occupancy: domain.EnclosureOccupancy{
+			Capacity: capacity,
+			Animals:  make(map[*domain.Animal]struct{}),
+		},
+	}
+}
+
+func newTestAnimal(name domain.AnimalName, enclosure *domain.Enclosure) *domain.Animal {
+	animal := &domain.Animal{
+		ID:           domain.AnimalID(uuid.New()),
+		Name:         name,
+		Gender:       domain.Female,
+		Species:      "Lion",
+		BirthDate:    domain.BirthDate(time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)),
+		FavoriteFood: "Meat",
+		Status:       domain.AnimalStatusHealthy,
+	}
+
+	if enclosure != nil {
+		enclosure.Occupancy.Animals[animal] = struct{}{}
+		animal.Enclosure = enclosure
+	}
+
+	return animal
+}
+
+func TestEnclosureAndAnimalRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	uow, _ := newTestUnitOfWork(t)
+
+	enclosure := newTestEnclosure(2)
+	leo := newTestAnimal("Leo", enclosure)
+
+	err := uow.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		require.NoError(t, repos.Enclosures().AddEnclosure(ctx, enclosure))
+		return repos.Animals().AddAnimal(ctx, leo)
+	})
+	require.NoError(t, err)
+
+	err = uow.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		loaded, err := repos.Enclosures().GetEnclosure(ctx, enclosure.ID)
+		require.NoError(t, err)
+		assert.Equal(t, enclosure.Type, loaded.Type)
+		assert.Equal(t, enclosure.Size, loaded.Size)
+		assert.Equal(t, 2, loaded.Occupancy.Capacity)
+		require.Equal(t, 1, loaded.Occupancy.CountAnimals())
+
+		animal, err := repos.Animals().GetAnimal(ctx, leo.ID)
+		require.NoError(t, err)
+		assert.Equal(t, leo.Name, animal.Name)
+		assert.Equal(t, leo.Gender, animal.Gender)
+		assert.Equal(t, leo.Species, animal.Species)
+		assert.True(t, time.Time(leo.BirthDate).Equal(time.Time(animal.BirthDate)))
+		assert.Equal(t, leo.FavoriteFood, animal.FavoriteFood)
+		assert.Equal(t, leo.Status, animal.Status)
+		require.NotNil(t, animal.Enclosure)
+		assert.Equal(t, enclosure.ID, animal.Enclosure.ID)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = uow.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		leo.Status = domain.AnimalStatusSick
+		require.NoError(t, repos.Animals().UpdateAnimal(ctx, leo))
+
+		enclosure.Occupancy.Capacity = 3
+		return repos.Enclosures().UpdateEnclosure(ctx, enclosure)
+	})
+	require.NoError(t, err)
+
+	err = uow.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		sick, err := repos.Animals().CountSickAnimals(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, sick)
+
+		loaded, err := repos.Enclosures().GetEnclosure(ctx, enclosure.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, loaded.Occupancy.Capacity)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = uow.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Animals().DeleteAnimal(ctx, leo.ID)
+	})
+	require.NoError(t, err)
+
+	err = uow.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		_, err := repos.Animals().GetAnimal(ctx, leo.ID)
+		return err
+	})
+	assert.ErrorIs(t, err, domain.ErrAnimalNotFound)
+}
+
+func TestGetEnclosuresWithSpace(t *testing.T) {
+	ctx := context.Background()
+	uow, _ := newTestUnitOfWork(t)
+
+	full := newTestEnclosure(1)
+	spacious := newTestEnclosure(2)
+	empty := newTestEnclosure(1)
+	animals := []*domain.Animal{newTestAnimal("Leo", full), newTestAnimal("Nala", spacious)}
+
+	err := uow.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		for _, enclosure := range []*domain.Enclosure{full, spacious, empty} {
+			require.NoError(t, repos.Enclosures().AddEnclosure(ctx, enclosure))
+		}
+
+		for _, animal := range animals {
+			require.NoError(t, repos.Animals().AddAnimal(ctx, animal))
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = uow.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		withSpace, err := repos.Enclosures().GetEnclosuresWithSpace(ctx)
+		require.NoError(t, err)
+
+		ids := make([]domain.EnclosureID, 0, len(withSpace))
+		for _, enclosure := range withSpace {
+			ids = append(ids, enclosure.ID)
+		}
+
+		assert.ElementsMatch(t, []domain.EnclosureID{spacious.ID, empty.ID}, ids)
+
+		free, err := repos.Enclosures().CountFreeEnclosures(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, free)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,530 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Static check that the interface is implemented.
+var _ domain.FeedingScheduleRepository = (*FeedingScheduleRepository)(nil)
+
+const feedingScheduleColumns = "s.id, s.animal_id, s.food, s.feeding_time, s.done, s.portion_amount, s.portion_unit, s.recurrence, s.time_zone, s.assignee_id, s.fed_through"
+
+type FeedingScheduleRepository struct {
+	q querier
+}
+
+func NewFeedingScheduleRepository(db *sql.DB) *FeedingScheduleRepository {
+	return &FeedingScheduleRepository{q: db}
+}
+
+func (r *FeedingScheduleRepository) GetFeedingSchedule(ctx context.Context, id domain.FeedingScheduleID) (*domain.FeedingSchedule, error) {
+	schedules, err := r.loadSchedules(ctx, "WHERE s.id = ?", id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(schedules) == 0 {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrFeedingScheduleNotFound, id)
+	}
+
+	return schedules[0], nil
+}
+
+// GetFeedingScheduleWithHistory returns the schedule with the history of its feedings within [startTime, endTime].
+func (r *FeedingScheduleRepository) GetFeedingScheduleWithHistory(
+	ctx context.Context,
+	id domain.FeedingScheduleID,
+	startTime, endTime time.Time,
+) (*domain.FeedingSchedule, error) {
+	schedule, err := r.GetFeedingSchedule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadHistory(ctx, []*domain.FeedingSchedule{schedule}, startTime, endTime, "WHERE s.id = ?", id.String()); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (r *FeedingScheduleRepository) AddFeedingSchedule(ctx context.Context, schedule *domain.FeedingSchedule) error {
+	if schedule.ID == domain.FeedingScheduleID(uuid.Nil) {
+		return fmt.Errorf("feeding schedule: %w", domain.ErrNilID)
+	}
+
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM feeding_schedules WHERE id = ?", schedule.ID.String())
+	if err != nil {
+		return fmt.Errorf("checking feeding schedule existence: %w", err)
+	}
+
+	if exists > 0 {
+		return fmt.Errorf("%w: id %s", domain.ErrFeedingScheduleAlreadyExists, schedule.ID)
+	}
+
+	recurrence, timeZone := encodeRecurrence(schedule.Recurrence)
+	fedThrough, nextFeeding, end := encodeFeedingTimes(schedule)
+
+	_, err = r.q.ExecContext(ctx,
+		`INSERT INTO feeding_schedules
+		(id, animal_id, food, feeding_time, done, portion_amount, portion_unit, recurrence, time_zone, assignee_id,
+			fed_through, next_feeding_time, end_time)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		schedule.ID.String(),
+		schedule.Animal.ID.String(),
+		string(schedule.Food),
+		toUnix(time.Time(schedule.Time)),
+		bool(schedule.Status),
+		schedule.Portion.Amount,
+		string(schedule.Portion.Unit),
+		recurrence,
+		timeZone,
+		encodeAssignee(schedule.Assignee),
+		fedThrough,
+		nextFeeding,
+		end,
+	)
+	if err != nil {
+		return fmt.Errorf("inserting feeding schedule: %w", err)
+	}
+
+	return r.insertOccurrences(ctx, schedule)
+}
+
+func (r *FeedingScheduleRepository) DeleteFeedingSchedule(ctx context.Context, id domain.FeedingScheduleID) error {
+	res, err := r.q.ExecContext(ctx, "DELETE FROM feeding_schedules WHERE id = ?", id.String())
+	if err != nil {
+		return fmt.Errorf("deleting feeding schedule: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: id %s", domain.ErrFeedingScheduleNotFound, id))
+}
+
+func (r *FeedingScheduleRepository) UpdateFeedingSchedule(ctx context.Context, schedule *domain.FeedingSchedule) error {
+	recurrence, timeZone := encodeRecurrence(schedule.Recurrence)
+	fedThrough, nextFeeding, end := encodeFeedingTimes(schedule)
+
+	res, err := r.q.ExecContext(ctx,
+		`UPDATE feeding_schedules
+		SET animal_id = ?, food = ?, feeding_time = ?, done = ?, portion_amount = ?, portion_unit = ?,
+			recurrence = ?, time_zone = ?, assignee_id = ?, fed_through = ?, next_feeding_time = ?, end_time = ?
+		WHERE id = ?`,
+		schedule.Animal.ID.String(),
+		string(schedule.Food),
+		toUnix(time.Time(schedule.Time)),
+		bool(schedule.Status),
+		schedule.Portion.Amount,
+		string(schedule.Portion.Unit),
+		recurrence,
+		timeZone,
+		encodeAssignee(schedule.Assignee),
+		fedThrough,
+		nextFeeding,
+		end,
+		schedule.ID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("updating feeding schedule: %w", err)
+	}
+
+	if err := ensureAffected(res, fmt.Errorf("%w: id %s", domain.ErrFeedingScheduleNotFound, schedule.ID)); err != nil {
+		return err
+	}
+
+	return r.insertOccurrences(ctx, schedule)
+}
+
+// insertOccurrences appends the last completed and the skipped occurrences of the schedule to its feeding history.
+// The history is never rewritten: occurrences already in it are left as they are.
+func (r *FeedingScheduleRepository) insertOccurrences(ctx context.Context, schedule *domain.FeedingSchedule) error {
+	insert := func(t time.Time, status domain.FeedingOccurrenceStatus) error {
+		_, err := r.q.ExecContext(ctx,
+			"INSERT OR IGNORE INTO feeding_occurrences (schedule_id, occurrence_time, status) VALUES (?, ?, ?)",
+			schedule.ID.String(),
+			toUnix(t),
+			string(status),
+		)
+		if err != nil {
+			return fmt.Errorf("inserting feeding occurrence: %w", err)
+		}
+
+		return nil
+	}
+
+	if !schedule.FedThrough.IsZero() {
+		if err := insert(schedule.FedThrough, domain.FeedingOccurrenceStatusDone); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range schedule.Skipped {
+		if err := insert(t, domain.FeedingOccurrenceStatusSkipped); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *FeedingScheduleRepository) GetAllFeedingSchedules(ctx context.Context) ([]*domain.FeedingSchedule, error) {
+	return r.loadSchedules(ctx, "")
+}
+
+func (r *FeedingScheduleRepository) CountFeedingSchedules(ctx context.Context) (int, error) {
+	return count(ctx, r.q, "SELECT COUNT(*) FROM feeding_schedules")
+}
+
+// GetFeedingSchedulesForAnimal returns all feeding schedules of the given animal.
+func (r *FeedingScheduleRepository) GetFeedingSchedulesForAnimal(
+	ctx context.Context,
+	animalID domain.AnimalID,
+) ([]*domain.FeedingSchedule, error) {
+	return r.loadSchedules(ctx, "WHERE s.animal_id = ?", animalID.String())
+}
+
+// GetCompletedFeedingSchedules returns all completed feeding schedules.
+func (r *FeedingScheduleRepository) GetCompletedFeedingSchedules(ctx context.Context) ([]*domain.FeedingSchedule, error) {
+	return r.loadSchedules(ctx, "WHERE s.done = ?", true)
+}
+
+// GetPendingFeedingSchedules returns all pending feeding schedules.
+func (r *FeedingScheduleRepository) GetPendingFeedingSchedules(ctx context.Context) ([]*domain.FeedingSchedule, error) {
+	return r.loadSchedules(ctx, "WHERE s.done = ?", false)
+}
+
+// GetFeedingSchedulesForTimeRange returns all feeding schedules with a feeding within [startTime, endTime]
+// together with the history of their feedings within it.
+func (r *FeedingScheduleRepository) GetFeedingSchedulesForTimeRange(
+	ctx context.Context,
+	startTime, endTime time.Time,
+) ([]*domain.FeedingSchedule, error) {
+	where, args := "WHERE s.feeding_time <= ? AND (s.end_time IS NULL OR s.end_time >= ?)", []any{toUnix(endTime), toUnix(startTime)}
+
+	candidates, err := r.loadSchedules(ctx, where, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	// The span of a recurring schedule may still fall between its occurrences
+	var schedules []*domain.FeedingSchedule
+
+	for _, schedule := range candidates {
+		if len(schedule.Occurrences(startTime, endTime.Add(time.Nanosecond))) > 0 {
+			schedules = append(schedules, schedule)
+		}
+	}
+
+	if err := r.loadHistory(ctx, schedules, startTime, endTime, where, args...); err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// GetDueFeedingSchedules returns the feeding schedules with a pending feeding before now.
+func (r *FeedingScheduleRepository) GetDueFeedingSchedules(ctx context.Context, now time.Time) ([]*domain.FeedingSchedule, error) {
+	return r.loadSchedules(ctx, "WHERE s.next_feeding_time < ?", toUnix(now))
+}
+
+// CountCompletedFeedingsToday returns the number of feedings completed today, occurrences of recurring schedules included.
+func (r *FeedingScheduleRepository) CountCompletedFeedingsToday(ctx context.Context, now time.Time) (int, error) {
+	startOfDay, endOfDay := dayBounds(now)
+
+	return count(ctx, r.q,
+		`SELECT
+			(SELECT COUNT(*) FROM feeding_occurrences o
+			WHERE o.status = ? AND o.occurrence_time >= ? AND o.occurrence_time < ?)
+			+ (SELECT COUNT(*) FROM feeding_schedules s
+			WHERE s.recurrence IS NULL AND s.done = ? AND s.feeding_time >= ? AND s.feeding_time < ?)`,
+		string(domain.FeedingOccurrenceStatusDone), toUnix(startOfDay), toUnix(endOfDay),
+		true, toUnix(startOfDay), toUnix(endOfDay),
+	)
+}
+
+// CountPendingFeedingsToday returns the number of feedings still pending today, occurrences of recurring schedules included.
+func (r *FeedingScheduleRepository) CountPendingFeedingsToday(ctx context.Context, now time.Time) (int, error) {
+	startOfDay, endOfDay := dayBounds(now)
+
+	pending, err := count(ctx, r.q,
+		`SELECT COUNT(*) FROM feeding_schedules s
+		WHERE s.recurrence IS NULL AND s.done = ? AND s.feeding_time >= ? AND s.feeding_time < ?`,
+		false, toUnix(startOfDay), toUnix(endOfDay),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("counting pending feedings: %w", err)
+	}
+
+	// Only the recurring schedules whose next feeding is due by the end of the day are expanded, without their animals
+	where := "WHERE s.recurrence IS NOT NULL AND s.next_feeding_time < ?"
+
+	schedules, err := r.querySchedules(ctx, newGraphLoader(r.q), where, toUnix(endOfDay))
+	if err != nil {
+		return 0, err
+	}
+
+	if err := r.loadSkipped(ctx, schedules, where, toUnix(endOfDay)); err != nil {
+		return 0, err
+	}
+
+	return pending + domain.CountFeedingOccurrences(schedules, startOfDay, endOfDay)[domain.FeedingOccurrenceStatusPending], nil
+}
+
+// loadSchedules loads schedules matching where together with their animals.
+// The where clause must reference the feeding_schedules table as "s".
+func (r *FeedingScheduleRepository) loadSchedules(ctx context.Context, where string, args ...any) ([]*domain.FeedingSchedule, error) {
+	loader := newGraphLoader(r.q)
+
+	if _, err := loader.loadAnimals(ctx,
+		"WHERE a.id IN (SELECT s.animal_id FROM feeding_schedules s "+where+")",
+		args...,
+	); err != nil {
+		return nil, err
+	}
+
+	schedules, err := r.querySchedules(ctx, loader, where, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadSkipped(ctx, schedules, where, args...); err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// querySchedules scans the schedules matching where, taking their animals from the loader.
+func (r *FeedingScheduleRepository) querySchedules(
+	ctx context.Context,
+	loader *graphLoader,
+	where string,
+	args ...any,
+) ([]*domain.FeedingSchedule, error) {
+	rows, err := r.q.QueryContext(ctx, "SELECT "+feedingScheduleColumns+" FROM feeding_schedules s "+where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying feeding schedules: %w", err)
+	}
+
+	var schedules []*domain.FeedingSchedule
+
+	for rows.Next() {
+		schedule, err := scanFeedingSchedule(rows, loader)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		schedules = append(schedules, schedule)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying feeding schedules: %w", err)
+	}
+
+	return schedules, nil
+}
+
+// loadSkipped fills the occurrences skipped after the last completed one of the schedules loaded with where.
+func (r *FeedingScheduleRepository) loadSkipped(
+	ctx context.Context,
+	schedules []*domain.FeedingSchedule,
+	where string,
+	args ...any,
+) error {
+	return r.loadOccurrences(ctx, schedules,
+		`SELECT o.schedule_id, o.occurrence_time, o.status FROM feeding_occurrences o
+		JOIN feeding_schedules s ON s.id = o.schedule_id
+		`+whereAnd(where, "o.status = ? AND (s.fed_through IS NULL OR o.occurrence_time > s.fed_through)")+`
+		ORDER BY o.occurrence_time`,
+		append(args[:len(args):len(args)], string(domain.FeedingOccurrenceStatusSkipped)),
+		func(schedule *domain.FeedingSchedule, occurrence domain.FeedingOccurrence) {
+			schedule.Skipped = append(schedule.Skipped, occurrence.Time)
+		},
+	)
+}
+
+// loadHistory fills the completed and skipped occurrences before the last completed one
+// within [startTime, endTime] of the schedules loaded with where.
+func (r *FeedingScheduleRepository) loadHistory(
+	ctx context.Context,
+	schedules []*domain.FeedingSchedule,
+	startTime, endTime time.Time,
+	where string,
+	args ...any,
+) error {
+	return r.loadOccurrences(ctx, schedules,
+		`SELECT o.schedule_id, o.occurrence_time, o.status FROM feeding_occurrences o
+		JOIN feeding_schedules s ON s.id = o.schedule_id
+		`+whereAnd(where, "o.occurrence_time >= ? AND o.occurrence_time <= ? AND o.occurrence_time < s.fed_through")+`
+		ORDER BY o.occurrence_time`,
+		append(args[:len(args):len(args)], toUnix(startTime), toUnix(endTime)),
+		func(schedule *domain.FeedingSchedule, occurrence domain.FeedingOccurrence) {
+			schedule.History = append(schedule.History, occurrence)
+		},
+	)
+}
+
+// loadOccurrences passes the feeding occurrences the query returns to add together with their schedules.
+func (r *FeedingScheduleRepository) loadOccurrences(
+	ctx context.Context,
+	schedules []*domain.FeedingSchedule,
+	query string,
+	args []any,
+	add func(schedule *domain.FeedingSchedule, occurrence domain.FeedingOccurrence),
+) error {
+	if len(schedules) == 0 {
+		return nil
+	}
+
+	byID := make(map[domain.FeedingScheduleID]*domain.FeedingSchedule, len(schedules))
+	for _, schedule := range schedules {
+		byID[schedule.ID] = schedule
+	}
+
+	rows, err := r.q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("querying feeding occurrences: %w", err)
+	}
+
+	for rows.Next() {
+		var (
+			rawScheduleID  string
+			occurrenceTime int64
+			status         string
+		)
+
+		if err := rows.Scan(&rawScheduleID, &occurrenceTime, &status); err != nil {
+			rows.Close()
+			return fmt.Errorf("scanning feeding occurrence: %w", err)
+		}
+
+		scheduleID, err := parseUUID(rawScheduleID)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+
+		if schedule, ok := byID[domain.FeedingScheduleID(scheduleID)]; ok {
+			add(schedule, domain.FeedingOccurrence{Time: fromUnix(occurrenceTime), Status: domain.FeedingOccurrenceStatus(status)})
+		}
+	}
+
+	if err := closeRows(rows); err != nil {
+		return fmt.Errorf("querying feeding occurrences: %w", err)
+	}
+
+	return nil
+}
+
+func scanFeedingSchedule(rows *sql.Rows, loader *graphLoader) (*domain.FeedingSchedule, error) {
+	var (
+		rawID       string
+		rawAnimalID string
+		food        string
+		feedingTime int64
+		done        bool
+		portion     domain.FoodQuantity
+		portionUnit string
+		recurrence  sql.NullString
+		timeZone    sql.NullString
+		rawAssignee sql.NullString
+		fedThrough  sql.NullInt64
+	)
+
+	if err := rows.Scan(
+		&rawID, &rawAnimalID, &food, &feedingTime, &done, &portion.Amount, &portionUnit, &recurrence, &timeZone, &rawAssignee,
+		&fedThrough,
+	); err != nil {
+		return nil, fmt.Errorf("scanning feeding schedule: %w", err)
+	}
+
+	id, err := parseUUID(rawID)
+	if err != nil {
+		return nil, err
+	}
+
+	animalID, err := parseUUID(rawAnimalID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &domain.FeedingSchedule{
+		ID:     domain.FeedingScheduleID(id),
+		Animal: loader.animals[domain.AnimalID(animalID)],
+		Food:   domain.Food(food),
+		Time:   domain.FeedingScheduleTime(fromUnix(feedingTime)),
+		Status: domain.FeedingStatus(done),
+	}
+
+	if schedule.Assignee, err = decodeAssignee(rawAssignee); err != nil {
+		return nil, err
+	}
+
+	if fedThrough.Valid {
+		schedule.FedThrough = fromUnix(fedThrough.Int64)
+	}
+
+	if portion.Amount > 0 {
+		portion.Unit = domain.FoodUnit(portionUnit)
+		schedule.Portion = portion
+	}
+
+	if recurrence.Valid {
+		if schedule.Recurrence, err = domain.ParseRecurrence(recurrence.String, timeZone.String); err != nil {
+			return nil, fmt.Errorf("parsing recurrence of feeding schedule %s: %w", schedule.ID, err)
+		}
+	}
+
+	return schedule, nil
+}
+
+// encodeRecurrence returns the rule and the time zone of the recurrence, NULL for one-time schedules.
+func encodeRecurrence(recurrence *domain.Recurrence) (rule, timeZone sql.NullString) {
+	if recurrence == nil {
+		return rule, timeZone
+	}
+
+	return sql.NullString{String: recurrence.String(), Valid: true},
+		sql.NullString{String: recurrence.TimeZone(), Valid: true}
+}
+
+// encodeFeedingTimes returns the last completed feeding, the next pending one and the end of the schedule,
+// NULL if there is none. The last two let queries skip schedules without feedings in a time range.
+func encodeFeedingTimes(schedule *domain.FeedingSchedule) (fedThrough, nextFeeding, end sql.NullInt64) {
+	if !schedule.FedThrough.IsZero() {
+		fedThrough = sql.NullInt64{Int64: toUnix(schedule.FedThrough), Valid: true}
+	}
+
+	if next, ok := schedule.NextOccurrence(); ok {
+		nextFeeding = sql.NullInt64{Int64: toUnix(next), Valid: true}
+	}
+
+	if last, ok := schedule.End(); ok {
+		end = sql.NullInt64{Int64: toUnix(last), Valid: true}
+	}
+
+	return fedThrough, nextFeeding, end
+}
+
+// whereAnd adds the condition to the where clause, which may be empty.
+func whereAnd(where, condition string) string {
+	if where == "" {
+		return "WHERE " + condition
+	}
+
+	return "WHERE (" + strings.TrimPrefix(where, "WHERE ") + ") AND " + condition
+}
+
+func dayBounds(now time.Time) (startOfDay, endOfDay time.Time) {
+	startOfDay = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	endOfDay = startOfDay.Add(24 * time.Hour)
+
+	return startOfDay, endOfDay
+}
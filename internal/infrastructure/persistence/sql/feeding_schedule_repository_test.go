@@ -0,0 +1,205 @@
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2025, time.May, 14, 12, 0, 0, 0, time.UTC)
+
+func newTestSchedule(t *testing.T, animal *domain.Animal, at time.Time, rule string) *domain.FeedingSchedule {
+	t.Helper()
+
+	portion, err := domain.NewFoodQuantity(1.5, domain.FoodUnitKilogram)
+	require.NoError(t, err)
+
+	schedule := &domain.FeedingSchedule{
+		ID:      domain.FeedingScheduleID(uuid.New()),
+		Animal:  animal,
+		Food:    "Meat",
+		Time:    domain.FeedingScheduleTime(at),
+		Status:  domain.FeedingStatusNotDone,
+		Portion: portion,
+	}
+
+	if rule != "" {
+		schedule.Recurrence, err = domain.ParseRecurrence(rule, "Europe/Moscow")
+		require.NoError(t, err)
+	}
+
+	return schedule
+}
+
+func addTestSchedules(t *testing.T, uow *UnitOfWork, animal *domain.Animal, schedules ...*domain.FeedingSchedule) {
+	t.Helper()
+
+	err := uow.Do(context.Background(), func(ctx context.Context, repos domain.Repositories) error {
+		require.NoError(t, repos.Animals().AddAnimal(ctx, animal))
+
+		for _, schedule := range schedules {
+			require.NoError(t, repos.FeedingSchedules().AddFeedingSchedule(ctx, schedule))
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestFeedingScheduleRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	uow, _ := newTestUnitOfWork(t)
+
+	animal := newTestAnimal("Leo", nil)
+	daily := newTestSchedule(t, animal, testNow.Add(-72*time.Hour-3*time.Hour), "FREQ=DAILY;COUNT=5")
+	keeper := domain.KeeperID(uuid.New())
+	daily.Assignee = &keeper
+	addTestSchedules(t, uow, animal, daily)
+
+	first := time.Time(daily.Time)
+	update := func(change func() error) {
+		require.NoError(t, change())
+		require.NoError(t, uow.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+			return repos.FeedingSchedules().UpdateFeedingSchedule(ctx, daily)
+		}))
+	}
+
+	// The third occurrence is completed before the second one, which is missed then
+	update(func() error { return daily.SkipOccurrence(first) })
+	update(func() error { return daily.SkipOccurrence(first.Add(4 * 24 * time.Hour)) })
+	update(func() error { return daily.CompleteOccurrence(first.Add(2 * 24 * time.Hour)) })
+
+	err := uow.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		from, to := first, first.Add(5*24*time.Hour)
+
+		loaded, err := repos.FeedingSchedules().GetFeedingScheduleWithHistory(ctx, daily.ID, from, to)
+		require.NoError(t, err)
+
+		assert.Equal(t, animal.ID, loaded.Animal.ID)
+		assert.Equal(t, daily.Food, loaded.Food)
+		assert.Equal(t, daily.Portion, loaded.Portion)
+		assert.Equal(t, daily.Recurrence.String(), loaded.Recurrence.String())
+		assert.Equal(t, daily.Recurrence.TimeZone(), loaded.Recurrence.TimeZone())
+		require.NotNil(t, loaded.Assignee)
+		assert.Equal(t, keeper, *loaded.Assignee)
+
+		statuses := make([]domain.FeedingOccurrenceStatus, 0, 5)
+		for _, occurrence := range loaded.Occurrences(from, to) {
+			statuses = append(statuses, occurrence.Status)
+		}
+
+		assert.Equal(t, []domain.FeedingOccurrenceStatus{
+			domain.FeedingOccurrenceStatusSkipped,
+			domain.FeedingOccurrenceStatusMissed,
+			domain.FeedingOccurrenceStatusDone,
+			domain.FeedingOccurrenceStatusPending,
+			domain.FeedingOccurrenceStatusSkipped,
+		}, statuses)
+		assert.Equal(t, []time.Time{first.Add(4 * 24 * time.Hour)}, loaded.Skipped)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = uow.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		return repos.FeedingSchedules().DeleteFeedingSchedule(ctx, daily.ID)
+	})
+	require.NoError(t, err)
+
+	err = uow.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		_, err := repos.FeedingSchedules().GetFeedingSchedule(ctx, daily.ID)
+		return err
+	})
+	assert.ErrorIs(t, err, domain.ErrFeedingScheduleNotFound)
+}
+
+func TestCountFeedingsToday(t *testing.T) {
+	ctx := context.Background()
+	uow, _ := newTestUnitOfWork(t)
+
+	animal := newTestAnimal("Leo", nil)
+	// Daily occurrences at 09:00 and 11:00 UTC, the one at 09:00 today is done
+	daily := newTestSchedule(t, animal, testNow.Add(-51*time.Hour), "FREQ=DAILY")
+	lateDaily := newTestSchedule(t, animal, testNow.Add(-49*time.Hour), "FREQ=DAILY")
+	require.NoError(t, daily.CompleteOccurrence(testNow.Add(-3*time.Hour)))
+	// Only the one-time feeding of today counts
+	later := newTestSchedule(t, animal, testNow.Add(3*time.Hour), "")
+	yesterday := newTestSchedule(t, animal, testNow.Add(-24*time.Hour), "")
+	done := newTestSchedule(t, animal, testNow.Add(-time.Hour), "")
+	require.NoError(t, done.Done())
+	addTestSchedules(t, uow, animal, daily, lateDaily, later, yesterday, done)
+
+	err := uow.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		completed, err := repos.FeedingSchedules().CountCompletedFeedingsToday(ctx, testNow)
+		require.NoError(t, err)
+		assert.Equal(t, 2, completed)
+
+		pending, err := repos.FeedingSchedules().CountPendingFeedingsToday(ctx, testNow)
+		require.NoError(t, err)
+		assert.Equal(t, 2, pending)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestGetFeedingSchedulesForTimeRange(t *testing.T) {
+	ctx := context.Background()
+	uow, _ := newTestUnitOfWork(t)
+
+	animal := newTestAnimal("Leo", nil)
+	weekly := newTestSchedule(t, animal, testNow.Add(-7*24*time.Hour), "FREQ=WEEKLY")
+	finished := newTestSchedule(t, animal, testNow.Add(-30*24*time.Hour), "FREQ=DAILY;COUNT=3")
+	inRange := newTestSchedule(t, animal, testNow.Add(time.Hour), "")
+	outOfRange := newTestSchedule(t, animal, testNow.Add(48*time.Hour), "")
+	addTestSchedules(t, uow, animal, weekly, finished, inRange, outOfRange)
+
+	err := uow.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		schedules, err := repos.FeedingSchedules().GetFeedingSchedulesForTimeRange(ctx, testNow.Add(-time.Hour), testNow.Add(time.Hour))
+		require.NoError(t, err)
+
+		ids := make([]domain.FeedingScheduleID, 0, len(schedules))
+		for _, schedule := range schedules {
+			ids = append(ids, schedule.ID)
+		}
+
+		assert.ElementsMatch(t, []domain.FeedingScheduleID{weekly.ID, inRange.ID}, ids)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestGetDueFeedingSchedules(t *testing.T) {
+	ctx := context.Background()
+	uow, _ := newTestUnitOfWork(t)
+
+	animal := newTestAnimal("Leo", nil)
+	// The daily schedule is fed through today, the hourly one has been due since yesterday
+	daily := newTestSchedule(t, animal, testNow.Add(-51*time.Hour), "FREQ=DAILY")
+	require.NoError(t, daily.CompleteOccurrence(testNow.Add(-3*time.Hour)))
+	hourly := newTestSchedule(t, animal, testNow.Add(-24*time.Hour), "FREQ=HOURLY")
+	overdue := newTestSchedule(t, animal, testNow.Add(-time.Hour), "")
+	later := newTestSchedule(t, animal, testNow.Add(time.Hour), "")
+	addTestSchedules(t, uow, animal, daily, hourly, overdue, later)
+
+	err := uow.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		schedules, err := repos.FeedingSchedules().GetDueFeedingSchedules(ctx, testNow)
+		require.NoError(t, err)
+
+		ids := make([]domain.FeedingScheduleID, 0, len(schedules))
+		for _, schedule := range schedules {
+			ids = append(ids, schedule.ID)
+		}
+
+		assert.ElementsMatch(t, []domain.FeedingScheduleID{hourly.ID, overdue.ID}, ids)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,125 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Static check that the interface is implemented.
+var _ domain.FoodStockRepository = (*FoodStockRepository)(nil)
+
+const foodStockColumns = "food, quantity, unit, low_stock_threshold, short"
+
+type FoodStockRepository struct {
+	q querier
+}
+
+func NewFoodStockRepository(db *sql.DB) *FoodStockRepository {
+	return &FoodStockRepository{q: db}
+}
+
+func (r *FoodStockRepository) GetFoodStock(ctx context.Context, food domain.Food) (*domain.FoodStock, error) {
+	stocks, err := r.loadFoodStocks(ctx, "WHERE food = ?", string(food))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stocks) == 0 {
+		return nil, fmt.Errorf("%w: food %s", domain.ErrFoodStockNotFound, food)
+	}
+
+	return stocks[0], nil
+}
+
+func (r *FoodStockRepository) AddFoodStock(ctx context.Context, stock *domain.FoodStock) error {
+	if stock.Food == "" {
+		return fmt.Errorf("food stock: %w", domain.ErrEmptyFoodName)
+	}
+
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM food_stocks WHERE food = ?", string(stock.Food))
+	if err != nil {
+		return fmt.Errorf("checking food stock existence: %w", err)
+	}
+
+	if exists > 0 {
+		return fmt.Errorf("%w: food %s", domain.ErrFoodStockAlreadyExists, stock.Food)
+	}
+
+	_, err = r.q.ExecContext(ctx,
+		"INSERT INTO food_stocks ("+foodStockColumns+") VALUES (?, ?, ?, ?, ?)",
+		string(stock.Food),
+		stock.Quantity.Amount,
+		string(stock.Quantity.Unit),
+		stock.LowStockThreshold,
+		stock.Short,
+	)
+	if err != nil {
+		return fmt.Errorf("inserting food stock: %w", err)
+	}
+
+	return nil
+}
+
+func (r *FoodStockRepository) DeleteFoodStock(ctx context.Context, food domain.Food) error {
+	res, err := r.q.ExecContext(ctx, "DELETE FROM food_stocks WHERE food = ?", string(food))
+	if err != nil {
+		return fmt.Errorf("deleting food stock: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: food %s", domain.ErrFoodStockNotFound, food))
+}
+
+func (r *FoodStockRepository) UpdateFoodStock(ctx context.Context, stock *domain.FoodStock) error {
+	res, err := r.q.ExecContext(ctx,
+		"UPDATE food_stocks SET quantity = ?, unit = ?, low_stock_threshold = ?, short = ? WHERE food = ?",
+		stock.Quantity.Amount,
+		string(stock.Quantity.Unit),
+		stock.LowStockThreshold,
+		stock.Short,
+		string(stock.Food),
+	)
+	if err != nil {
+		return fmt.Errorf("updating food stock: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: food %s", domain.ErrFoodStockNotFound, stock.Food))
+}
+
+func (r *FoodStockRepository) GetAllFoodStocks(ctx context.Context) ([]*domain.FoodStock, error) {
+	return r.loadFoodStocks(ctx, "")
+}
+
+func (r *FoodStockRepository) loadFoodStocks(ctx context.Context, where string, args ...any) ([]*domain.FoodStock, error) {
+	rows, err := r.q.QueryContext(ctx, "SELECT "+foodStockColumns+" FROM food_stocks "+where+" ORDER BY food", args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying food stocks: %w", err)
+	}
+
+	stocks := make([]*domain.FoodStock, 0)
+
+	for rows.Next() {
+		var (
+			stock domain.FoodStock
+			food  string
+			unit  string
+		)
+
+		if err := rows.Scan(&food, &stock.Quantity.Amount, &unit, &stock.LowStockThreshold, &stock.Short); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scanning food stock: %w", err)
+		}
+
+		stock.Food = domain.Food(food)
+		stock.Quantity.Unit = domain.FoodUnit(unit)
+		stocks = append(stocks, &stock)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying food stocks: %w", err)
+	}
+
+	return stocks, nil
+}
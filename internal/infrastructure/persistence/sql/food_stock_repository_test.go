@@ -0,0 +1,50 @@
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFoodStockRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	uow, _ := newTestUnitOfWork(t)
+
+	stock, err := domain.NewFoodStock("Meat", domain.FoodUnitKilogram, 5)
+	require.NoError(t, err)
+
+	delivered, err := domain.NewFoodQuantity(12.5, domain.FoodUnitKilogram)
+	require.NoError(t, err)
+	require.NoError(t, stock.Deliver(delivered))
+
+	err = uow.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		return repos.FoodStocks().AddFoodStock(ctx, stock)
+	})
+	require.NoError(t, err)
+
+	portion, err := domain.NewFoodQuantity(8000, domain.FoodUnitGram)
+	require.NoError(t, err)
+	require.NoError(t, stock.Consume(portion))
+	stock.ReportShortage()
+
+	err = uow.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		return repos.FoodStocks().UpdateFoodStock(ctx, stock)
+	})
+	require.NoError(t, err)
+
+	err = uow.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		loaded, err := repos.FoodStocks().GetFoodStock(ctx, "Meat")
+		require.NoError(t, err)
+		assert.Equal(t, stock, loaded)
+
+		stocks, err := repos.FoodStocks().GetAllFoodStocks(ctx)
+		require.NoError(t, err)
+		assert.Len(t, stocks, 1)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
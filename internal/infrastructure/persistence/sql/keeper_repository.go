@@ -0,0 +1,244 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Static check that the interface is implemented.
+var _ domain.KeeperRepository = (*KeeperRepository)(nil)
+
+type KeeperRepository struct {
+	q querier
+}
+
+func NewKeeperRepository(db *sql.DB) *KeeperRepository {
+	return &KeeperRepository{q: db}
+}
+
+func (r *KeeperRepository) GetKeeper(ctx context.Context, id domain.KeeperID) (*domain.Keeper, error) {
+	keepers, err := r.loadKeepers(ctx, "WHERE id = ?", id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keepers) == 0 {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrKeeperNotFound, id)
+	}
+
+	return keepers[0], nil
+}
+
+func (r *KeeperRepository) AddKeeper(ctx context.Context, keeper *domain.Keeper) error {
+	if keeper.ID == domain.KeeperID(uuid.Nil) {
+		return fmt.Errorf("keeper: %w", domain.ErrNilID)
+	}
+
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM keepers WHERE id = ?", keeper.ID.String())
+	if err != nil {
+		return fmt.Errorf("checking keeper existence: %w", err)
+	}
+
+	if exists > 0 {
+		return fmt.Errorf("%w: id %s", domain.ErrKeeperAlreadyExists, keeper.ID)
+	}
+
+	qualifications, err := encodeQualifications(keeper.Qualifications)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.q.ExecContext(ctx,
+		"INSERT INTO keepers (id, name, max_enclosures, qualifications) VALUES (?, ?, ?, ?)",
+		keeper.ID.String(),
+		string(keeper.Name),
+		keeper.MaxEnclosures,
+		qualifications,
+	)
+	if err != nil {
+		return fmt.Errorf("inserting keeper: %w", err)
+	}
+
+	return r.insertAssignments(ctx, keeper)
+}
+
+// UpdateKeeper replaces the details and the assignments stored for the keeper.
+func (r *KeeperRepository) UpdateKeeper(ctx context.Context, keeper *domain.Keeper) error {
+	qualifications, err := encodeQualifications(keeper.Qualifications)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.q.ExecContext(ctx,
+		"UPDATE keepers SET name = ?, max_enclosures = ?, qualifications = ? WHERE id = ?",
+		string(keeper.Name),
+		keeper.MaxEnclosures,
+		qualifications,
+		keeper.ID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("updating keeper: %w", err)
+	}
+
+	if err := ensureAffected(res, fmt.Errorf("%w: id %s", domain.ErrKeeperNotFound, keeper.ID)); err != nil {
+		return err
+	}
+
+	if _, err := r.q.ExecContext(ctx, "DELETE FROM keeper_assignments WHERE keeper_id = ?", keeper.ID.String()); err != nil {
+		return fmt.Errorf("deleting assignments: %w", err)
+	}
+
+	return r.insertAssignments(ctx, keeper)
+}
+
+func (r *KeeperRepository) DeleteKeeper(ctx context.Context, id domain.KeeperID) error {
+	res, err := r.q.ExecContext(ctx, "DELETE FROM keepers WHERE id = ?", id.String())
+	if err != nil {
+		return fmt.Errorf("deleting keeper: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: id %s", domain.ErrKeeperNotFound, id))
+}
+
+func (r *KeeperRepository) GetAllKeepers(ctx context.Context) ([]*domain.Keeper, error) {
+	return r.loadKeepers(ctx, "")
+}
+
+func (r *KeeperRepository) insertAssignments(ctx context.Context, keeper *domain.Keeper) error {
+	for _, assignment := range keeper.Assignments {
+		_, err := r.q.ExecContext(ctx,
+			"INSERT INTO keeper_assignments (keeper_id, enclosure_id, shift) VALUES (?, ?, ?)",
+			keeper.ID.String(),
+			assignment.EnclosureID.String(),
+			string(assignment.Shift),
+		)
+		if err != nil {
+			return fmt.Errorf("inserting assignment: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *KeeperRepository) loadKeepers(ctx context.Context, where string, args ...any) ([]*domain.Keeper, error) {
+	rows, err := r.q.QueryContext(ctx,
+		"SELECT id, name, max_enclosures, qualifications FROM keepers "+where+" ORDER BY name, id",
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying keepers: %w", err)
+	}
+
+	keepers := make([]*domain.Keeper, 0)
+
+	for rows.Next() {
+		var (
+			keeper            domain.Keeper
+			id, name, encoded string
+		)
+
+		if err := rows.Scan(&id, &name, &keeper.MaxEnclosures, &encoded); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scanning keeper: %w", err)
+		}
+
+		keeperID, err := parseUUID(id)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		keeper.ID = domain.KeeperID(keeperID)
+		keeper.Name = domain.KeeperName(name)
+
+		if err := json.Unmarshal([]byte(encoded), &keeper.Qualifications); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("decoding qualifications: %w", err)
+		}
+
+		keepers = append(keepers, &keeper)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying keepers: %w", err)
+	}
+
+	if err := r.loadAssignments(ctx, keepers, where, args...); err != nil {
+		return nil, err
+	}
+
+	return keepers, nil
+}
+
+// loadAssignments fills the assignments of the keepers loaded with where.
+func (r *KeeperRepository) loadAssignments(ctx context.Context, keepers []*domain.Keeper, where string, args ...any) error {
+	byID := make(map[domain.KeeperID]*domain.Keeper, len(keepers))
+	for _, keeper := range keepers {
+		byID[keeper.ID] = keeper
+	}
+
+	rows, err := r.q.QueryContext(ctx,
+		`SELECT keeper_id, enclosure_id, shift FROM keeper_assignments
+		WHERE keeper_id IN (SELECT id FROM keepers `+where+`)
+		ORDER BY seq`,
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("querying assignments: %w", err)
+	}
+
+	for rows.Next() {
+		var keeperID, enclosureID, shift string
+
+		if err := rows.Scan(&keeperID, &enclosureID, &shift); err != nil {
+			rows.Close()
+			return fmt.Errorf("scanning assignment: %w", err)
+		}
+
+		parsedKeeperID, err := parseUUID(keeperID)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+
+		parsedEnclosureID, err := parseUUID(enclosureID)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+
+		keeper, ok := byID[domain.KeeperID(parsedKeeperID)]
+		if !ok {
+			continue
+		}
+
+		keeper.Assignments = append(keeper.Assignments, domain.KeeperAssignment{
+			EnclosureID: domain.EnclosureID(parsedEnclosureID),
+			Shift:       domain.ShiftName(shift),
+		})
+	}
+
+	if err := closeRows(rows); err != nil {
+		return fmt.Errorf("querying assignments: %w", err)
+	}
+
+	return nil
+}
+
+func encodeQualifications(qualifications []domain.AnimalSpecies) (string, error) {
+	if qualifications == nil {
+		qualifications = []domain.AnimalSpecies{}
+	}
+
+	encoded, err := json.Marshal(qualifications)
+	if err != nil {
+		return "", fmt.Errorf("encoding qualifications: %w", err)
+	}
+
+	return string(encoded), nil
+}
@@ -0,0 +1,92 @@
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeperShiftAndCareTaskRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	uow, _ := newTestUnitOfWork(t)
+
+	location, err := domain.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	shift, err := domain.NewShift("Night", 22*time.Hour, 6*time.Hour, []time.Weekday{time.Monday, time.Friday}, location)
+	require.NoError(t, err)
+
+	keeper, err := domain.NewKeeper("Anna", []domain.AnimalSpecies{"Lion", "Tiger"}, 2)
+	require.NoError(t, err)
+
+	enclosure := newTestEnclosure(2)
+	require.NoError(t, keeper.Assign(enclosure, shift.Name, nil))
+
+	recurrence, err := domain.ParseRecurrence("FREQ=WEEKLY;BYDAY=MO,TH", "Europe/Moscow")
+	require.NoError(t, err)
+
+	task, err := domain.NewCareTask(domain.TaskKindCleaning, enclosure.ID, testNow, recurrence)
+	require.NoError(t, err)
+	task.Assignee = &keeper.ID
+
+	err = uow.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		require.NoError(t, repos.Enclosures().AddEnclosure(ctx, enclosure))
+		require.NoError(t, repos.Shifts().AddShift(ctx, shift))
+		require.NoError(t, repos.Keepers().AddKeeper(ctx, keeper))
+		return repos.CareTasks().AddCareTask(ctx, task)
+	})
+	require.NoError(t, err)
+
+	from, to := testNow, testNow.Add(14*24*time.Hour)
+	occurrences := task.Occurrences(from, to)
+	require.Len(t, occurrences, 4)
+	require.NoError(t, task.CompleteOccurrence(occurrences[0].Time))
+
+	err = uow.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		return repos.CareTasks().UpdateCareTask(ctx, task)
+	})
+	require.NoError(t, err)
+
+	err = uow.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		loadedShift, err := repos.Shifts().GetShift(ctx, shift.Name)
+		require.NoError(t, err)
+		assert.Equal(t, shift.Start, loadedShift.Start)
+		assert.Equal(t, shift.End, loadedShift.End)
+		assert.Equal(t, shift.Days, loadedShift.Days)
+		assert.Equal(t, shift.Location.String(), loadedShift.Location.String())
+
+		loadedKeeper, err := repos.Keepers().GetKeeper(ctx, keeper.ID)
+		require.NoError(t, err)
+		assert.Equal(t, keeper.Name, loadedKeeper.Name)
+		assert.Equal(t, keeper.Qualifications, loadedKeeper.Qualifications)
+		assert.Equal(t, keeper.MaxEnclosures, loadedKeeper.MaxEnclosures)
+		assert.Equal(t, keeper.Assignments, loadedKeeper.Assignments)
+
+		loadedTask, err := repos.CareTasks().GetCareTask(ctx, task.ID)
+		require.NoError(t, err)
+		assert.Equal(t, task.Kind, loadedTask.Kind)
+		assert.Equal(t, task.EnclosureID, loadedTask.EnclosureID)
+		require.NotNil(t, loadedTask.Assignee)
+		assert.Equal(t, keeper.ID, *loadedTask.Assignee)
+
+		assert.Equal(t, task.Occurrences(from, to), loadedTask.Occurrences(from, to))
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = uow.Do(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Keepers().DeleteKeeper(ctx, keeper.ID)
+	})
+	require.NoError(t, err)
+
+	err = uow.View(ctx, func(ctx context.Context, repos domain.Repositories) error {
+		_, err := repos.Keepers().GetKeeper(ctx, keeper.ID)
+		return err
+	})
+	assert.ErrorIs(t, err, domain.ErrKeeperNotFound)
+}
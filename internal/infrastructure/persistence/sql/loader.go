@@ -0,0 +1,216 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+const (
+	enclosureColumns = "e.id, e.type, e.size, e.capacity"
+	animalColumns    = "a.id, a.name, a.gender, a.species, a.birth_date, a.favorite_food, a.status, a.enclosure_id"
+)
+
+// graphLoader rebuilds the pointer graph between animals and enclosures.
+// Every aggregate is materialised at most once per loader, so an animal returned
+// by a query is the same object that is stored in its enclosure occupancy.
+type graphLoader struct {
+	q          querier
+	animals    map[domain.AnimalID]*domain.Animal
+	enclosures map[domain.EnclosureID]*domain.Enclosure
+}
+
+func newGraphLoader(q querier) *graphLoader {
+	return &graphLoader{
+		q:          q,
+		animals:    make(map[domain.AnimalID]*domain.Animal),
+		enclosures: make(map[domain.EnclosureID]*domain.Enclosure),
+	}
+}
+
+// loadEnclosures loads enclosures matching where together with all animals living in them.
+// The where clause must reference the enclosures table as "e".
+func (l *graphLoader) loadEnclosures(ctx context.Context, where string, args ...any) ([]*domain.Enclosure, error) {
+	rows, err := l.q.QueryContext(ctx, "SELECT "+enclosureColumns+" FROM enclosures e "+where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying enclosures: %w", err)
+	}
+
+	var enclosures []*domain.Enclosure
+
+	for rows.Next() {
+		enclosure, err := l.scanEnclosure(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		enclosures = append(enclosures, enclosure)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying enclosures: %w", err)
+	}
+
+	rows, err = l.q.QueryContext(ctx,
+		"SELECT "+animalColumns+" FROM animals a WHERE a.enclosure_id IN (SELECT e.id FROM enclosures e "+where+")",
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying enclosure animals: %w", err)
+	}
+
+	for rows.Next() {
+		if _, err := l.scanAnimal(rows); err != nil {
+			rows.Close()
+			return nil, err
+		}
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying enclosure animals: %w", err)
+	}
+
+	return enclosures, nil
+}
+
+// loadAnimals loads animals matching where together with their enclosures and neighbours.
+// The where clause must reference the animals table as "a".
+func (l *graphLoader) loadAnimals(ctx context.Context, where string, args ...any) ([]*domain.Animal, error) {
+	if _, err := l.loadEnclosures(ctx,
+		"WHERE e.id IN (SELECT a.enclosure_id FROM animals a "+where+")",
+		args...,
+	); err != nil {
+		return nil, err
+	}
+
+	rows, err := l.q.QueryContext(ctx, "SELECT "+animalColumns+" FROM animals a "+where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying animals: %w", err)
+	}
+
+	var animals []*domain.Animal
+
+	for rows.Next() {
+		animal, err := l.scanAnimal(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		animals = append(animals, animal)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying animals: %w", err)
+	}
+
+	return animals, nil
+}
+
+func (l *graphLoader) scanEnclosure(rows *sql.Rows) (*domain.Enclosure, error) {
+	var (
+		rawID    string
+		encType  string
+		size     int
+		capacity int
+	)
+
+	if err := rows.Scan(&rawID, &encType, &size, &capacity); err != nil {
+		return nil, fmt.Errorf("scanning enclosure: %w", err)
+	}
+
+	id, err := parseUUID(rawID)
+	if err != nil {
+		return nil, err
+	}
+
+	if enclosure, ok := l.enclosures[domain.EnclosureID(id)]; ok {
+		return enclosure, nil
+	}
+
+	enclosure := &domain.Enclosure{
+		ID:   domain.EnclosureID(id),
+		Type: domain.EnclosureType(encType),
+		Size: domain.EnclosureSize(size),
+		Occupancy: domain.EnclosureOccupancy{
+			Capacity: capacity,
+			Animals:  make(map[*domain.Animal]struct{}),
+		},
+	}
+
+	l.enclosures[enclosure.ID] = enclosure
+
+	return enclosure, nil
+}
+
+func (l *graphLoader) scanAnimal(rows *sql.Rows) (*domain.Animal, error) {
+	var (
+		rawID          string
+		name           string
+		gender         string
+		species        string
+		birthDate      int64
+		favoriteFood   string
+		status         int
+		rawEnclosureID sql.NullString
+	)
+
+	if err := rows.Scan(&rawID, &name, &gender, &species, &birthDate, &favoriteFood, &status, &rawEnclosureID); err != nil {
+		return nil, fmt.Errorf("scanning animal: %w", err)
+	}
+
+	id, err := parseUUID(rawID)
+	if err != nil {
+		return nil, err
+	}
+
+	if animal, ok := l.animals[domain.AnimalID(id)]; ok {
+		return animal, nil
+	}
+
+	animal := &domain.Animal{
+		ID:           domain.AnimalID(id),
+		Name:         domain.AnimalName(name),
+		Gender:       domain.Gender(gender),
+		Species:      domain.AnimalSpecies(species),
+		BirthDate:    domain.BirthDate(fromUnix(birthDate)),
+		FavoriteFood: domain.Food(favoriteFood),
+		Status:       domain.AnimalStatus(status),
+	}
+
+	if rawEnclosureID.Valid {
+		enclosureID, err := parseUUID(rawEnclosureID.String)
+		if err != nil {
+			return nil, err
+		}
+
+		if enclosure, ok := l.enclosures[domain.EnclosureID(enclosureID)]; ok {
+			animal.Enclosure = enclosure
+			enclosure.Occupancy.Animals[animal] = struct{}{}
+		}
+	}
+
+	l.animals[animal.ID] = animal
+
+	return animal, nil
+}
+
+func closeRows(rows *sql.Rows) error {
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return err
+	}
+
+	return rows.Close()
+}
+
+func enclosureIDOf(animal *domain.Animal) sql.NullString {
+	if animal.Enclosure == nil {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: animal.Enclosure.ID.String(), Valid: true}
+}
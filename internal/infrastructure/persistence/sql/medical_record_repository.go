@@ -0,0 +1,420 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Static check that the interface is implemented.
+var _ domain.MedicalRecordRepository = (*MedicalRecordRepository)(nil)
+
+type MedicalRecordRepository struct {
+	q querier
+}
+
+func NewMedicalRecordRepository(db *sql.DB) *MedicalRecordRepository {
+	return &MedicalRecordRepository{q: db}
+}
+
+// medicationRow is the JSON representation of a medication in the medications column.
+type medicationRow struct {
+	Name   string `json:"name"`
+	Dosage string `json:"dosage"`
+}
+
+func (r *MedicalRecordRepository) GetMedicalRecord(ctx context.Context, animalID domain.AnimalID) (*domain.MedicalRecord, error) {
+	var openedAt int64
+
+	err := r.q.QueryRowContext(ctx,
+		"SELECT opened_at FROM medical_records WHERE animal_id = ?", animalID.String(),
+	).Scan(&openedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: animal id %s", domain.ErrMedicalRecordNotFound, animalID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("querying medical record: %w", err)
+	}
+
+	record := domain.NewMedicalRecord(animalID, fromUnix(openedAt))
+
+	if record.Illnesses, err = r.loadIllnesses(ctx, animalID); err != nil {
+		return nil, err
+	}
+
+	if record.Diagnoses, err = r.loadDiagnoses(ctx, animalID); err != nil {
+		return nil, err
+	}
+
+	if record.Treatments, err = r.loadTreatments(ctx, animalID); err != nil {
+		return nil, err
+	}
+
+	if record.Administrations, err = r.loadAdministrations(ctx, animalID); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (r *MedicalRecordRepository) AddMedicalRecord(ctx context.Context, record *domain.MedicalRecord) error {
+	if record.AnimalID == domain.AnimalID(uuid.Nil) {
+		return fmt.Errorf("medical record: %w", domain.ErrNilID)
+	}
+
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM medical_records WHERE animal_id = ?", record.AnimalID.String())
+	if err != nil {
+		return fmt.Errorf("checking medical record existence: %w", err)
+	}
+
+	if exists > 0 {
+		return fmt.Errorf("%w: animal id %s", domain.ErrMedicalRecordAlreadyExists, record.AnimalID)
+	}
+
+	_, err = r.q.ExecContext(ctx,
+		"INSERT INTO medical_records (animal_id, opened_at) VALUES (?, ?)",
+		record.AnimalID.String(),
+		toUnix(record.OpenedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("inserting medical record: %w", err)
+	}
+
+	return r.insertEntries(ctx, record)
+}
+
+// UpdateMedicalRecord replaces the illnesses, diagnoses, treatments and administrations stored for the record.
+func (r *MedicalRecordRepository) UpdateMedicalRecord(ctx context.Context, record *domain.MedicalRecord) error {
+	res, err := r.q.ExecContext(ctx,
+		"UPDATE medical_records SET opened_at = ? WHERE animal_id = ?",
+		toUnix(record.OpenedAt),
+		record.AnimalID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("updating medical record: %w", err)
+	}
+
+	if err := ensureAffected(res, fmt.Errorf("%w: animal id %s", domain.ErrMedicalRecordNotFound, record.AnimalID)); err != nil {
+		return err
+	}
+
+	// Administrations reference treatments and treatments reference diagnoses, so they go first
+	if _, err := r.q.ExecContext(ctx, "DELETE FROM medical_administrations WHERE animal_id = ?", record.AnimalID.String()); err != nil {
+		return fmt.Errorf("deleting administrations: %w", err)
+	}
+
+	if _, err := r.q.ExecContext(ctx, "DELETE FROM medical_treatments WHERE animal_id = ?", record.AnimalID.String()); err != nil {
+		return fmt.Errorf("deleting treatments: %w", err)
+	}
+
+	if _, err := r.q.ExecContext(ctx, "DELETE FROM medical_diagnoses WHERE animal_id = ?", record.AnimalID.String()); err != nil {
+		return fmt.Errorf("deleting diagnoses: %w", err)
+	}
+
+	if _, err := r.q.ExecContext(ctx, "DELETE FROM medical_illnesses WHERE animal_id = ?", record.AnimalID.String()); err != nil {
+		return fmt.Errorf("deleting illnesses: %w", err)
+	}
+
+	return r.insertEntries(ctx, record)
+}
+
+func (r *MedicalRecordRepository) insertEntries(ctx context.Context, record *domain.MedicalRecord) error {
+	for _, illness := range record.Illnesses {
+		_, err := r.q.ExecContext(ctx,
+			"INSERT INTO medical_illnesses (id, animal_id, diagnosis, severity, reported_by, reported_at) VALUES (?, ?, ?, ?, ?, ?)",
+			illness.ID.String(),
+			record.AnimalID.String(),
+			string(illness.Diagnosis),
+			string(illness.Severity),
+			string(illness.ReportedBy),
+			toUnix(illness.ReportedAt),
+		)
+		if err != nil {
+			return fmt.Errorf("inserting illness: %w", err)
+		}
+	}
+
+	for _, diagnosis := range record.Diagnoses {
+		_, err := r.q.ExecContext(ctx,
+			"INSERT INTO medical_diagnoses (id, animal_id, description, vet, diagnosed_at) VALUES (?, ?, ?, ?, ?)",
+			diagnosis.ID.String(),
+			record.AnimalID.String(),
+			string(diagnosis.Description),
+			string(diagnosis.Vet),
+			toUnix(diagnosis.DiagnosedAt),
+		)
+		if err != nil {
+			return fmt.Errorf("inserting diagnosis: %w", err)
+		}
+	}
+
+	for _, treatment := range record.Treatments {
+		medications := make([]medicationRow, len(treatment.Medications))
+		for i, medication := range treatment.Medications {
+			medications[i] = medicationRow(medication)
+		}
+
+		encoded, err := json.Marshal(medications)
+		if err != nil {
+			return fmt.Errorf("encoding medications: %w", err)
+		}
+
+		var diagnosisID sql.NullString
+		if treatment.DiagnosisID != nil {
+			diagnosisID = sql.NullString{String: treatment.DiagnosisID.String(), Valid: true}
+		}
+
+		_, err = r.q.ExecContext(ctx,
+			"INSERT INTO medical_treatments (id, animal_id, diagnosis_id, description, vet, medications, treated_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
+			treatment.ID.String(),
+			record.AnimalID.String(),
+			diagnosisID,
+			string(treatment.Description),
+			string(treatment.Vet),
+			string(encoded),
+			toUnix(treatment.TreatedAt),
+		)
+		if err != nil {
+			return fmt.Errorf("inserting treatment: %w", err)
+		}
+	}
+
+	for _, administration := range record.Administrations {
+		_, err := r.q.ExecContext(ctx,
+			`INSERT INTO medical_administrations (id, animal_id, treatment_id, drug, dose_mg, weight, administered_by, administered_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			administration.ID.String(),
+			record.AnimalID.String(),
+			administration.TreatmentID.String(),
+			string(administration.Drug),
+			administration.DoseMg,
+			administration.Weight,
+			string(administration.AdministeredBy),
+			toUnix(administration.AdministeredAt),
+		)
+		if err != nil {
+			return fmt.Errorf("inserting administration: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *MedicalRecordRepository) loadIllnesses(ctx context.Context, animalID domain.AnimalID) ([]domain.Illness, error) {
+	rows, err := r.q.QueryContext(ctx,
+		"SELECT id, diagnosis, severity, reported_by, reported_at FROM medical_illnesses WHERE animal_id = ? ORDER BY seq",
+		animalID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying illnesses: %w", err)
+	}
+
+	var illnesses []domain.Illness
+
+	for rows.Next() {
+		var (
+			rawID, diagnosis, severity, reportedBy string
+			reportedAt                             int64
+		)
+
+		if err := rows.Scan(&rawID, &diagnosis, &severity, &reportedBy, &reportedAt); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scanning illness: %w", err)
+		}
+
+		id, err := parseUUID(rawID)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		illnesses = append(illnesses, domain.Illness{
+			ID:         domain.IllnessID(id),
+			Diagnosis:  domain.DiagnosisDescription(diagnosis),
+			Severity:   domain.IllnessSeverity(severity),
+			ReportedBy: domain.ReporterName(reportedBy),
+			ReportedAt: fromUnix(reportedAt),
+		})
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying illnesses: %w", err)
+	}
+
+	return illnesses, nil
+}
+
+func (r *MedicalRecordRepository) loadDiagnoses(ctx context.Context, animalID domain.AnimalID) ([]domain.Diagnosis, error) {
+	rows, err := r.q.QueryContext(ctx,
+		"SELECT id, description, vet, diagnosed_at FROM medical_diagnoses WHERE animal_id = ? ORDER BY seq",
+		animalID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying diagnoses: %w", err)
+	}
+
+	var diagnoses []domain.Diagnosis
+
+	for rows.Next() {
+		var (
+			rawID, description, vet string
+			diagnosedAt             int64
+		)
+
+		if err := rows.Scan(&rawID, &description, &vet, &diagnosedAt); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scanning diagnosis: %w", err)
+		}
+
+		id, err := parseUUID(rawID)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		diagnoses = append(diagnoses, domain.Diagnosis{
+			ID:          domain.DiagnosisID(id),
+			Description: domain.DiagnosisDescription(description),
+			Vet:         domain.VetName(vet),
+			DiagnosedAt: fromUnix(diagnosedAt),
+		})
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying diagnoses: %w", err)
+	}
+
+	return diagnoses, nil
+}
+
+func (r *MedicalRecordRepository) loadTreatments(ctx context.Context, animalID domain.AnimalID) ([]domain.Treatment, error) {
+	rows, err := r.q.QueryContext(ctx,
+		"SELECT id, diagnosis_id, description, vet, medications, treated_at FROM medical_treatments WHERE animal_id = ? ORDER BY seq",
+		animalID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying treatments: %w", err)
+	}
+
+	var treatments []domain.Treatment
+
+	for rows.Next() {
+		treatment, err := scanTreatment(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		treatments = append(treatments, treatment)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying treatments: %w", err)
+	}
+
+	return treatments, nil
+}
+
+func (r *MedicalRecordRepository) loadAdministrations(ctx context.Context, animalID domain.AnimalID) ([]domain.DrugAdministration, error) {
+	rows, err := r.q.QueryContext(ctx,
+		`SELECT id, treatment_id, drug, dose_mg, weight, administered_by, administered_at FROM medical_administrations
+		WHERE animal_id = ? ORDER BY seq`,
+		animalID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying administrations: %w", err)
+	}
+
+	var administrations []domain.DrugAdministration
+
+	for rows.Next() {
+		var (
+			administration                              domain.DrugAdministration
+			rawID, rawTreatmentID, drug, administeredBy string
+			administeredAt                              int64
+		)
+
+		if err := rows.Scan(
+			&rawID, &rawTreatmentID, &drug, &administration.DoseMg,
+			&administration.Weight, &administeredBy, &administeredAt,
+		); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scanning administration: %w", err)
+		}
+
+		id, err := parseUUID(rawID)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		treatmentID, err := parseUUID(rawTreatmentID)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		administration.ID = domain.DrugAdministrationID(id)
+		administration.TreatmentID = domain.TreatmentID(treatmentID)
+		administration.Drug = domain.DrugName(drug)
+		administration.AdministeredBy = domain.ReporterName(administeredBy)
+		administration.AdministeredAt = fromUnix(administeredAt)
+		administrations = append(administrations, administration)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying administrations: %w", err)
+	}
+
+	return administrations, nil
+}
+
+func scanTreatment(rows *sql.Rows) (domain.Treatment, error) {
+	var (
+		rawID, description, vet, encoded string
+		rawDiagnosisID                   sql.NullString
+		treatedAt                        int64
+		medications                      []medicationRow
+	)
+
+	if err := rows.Scan(&rawID, &rawDiagnosisID, &description, &vet, &encoded, &treatedAt); err != nil {
+		return domain.Treatment{}, fmt.Errorf("scanning treatment: %w", err)
+	}
+
+	id, err := parseUUID(rawID)
+	if err != nil {
+		return domain.Treatment{}, err
+	}
+
+	if err := json.Unmarshal([]byte(encoded), &medications); err != nil {
+		return domain.Treatment{}, fmt.Errorf("decoding medications: %w", err)
+	}
+
+	treatment := domain.Treatment{
+		ID:          domain.TreatmentID(id),
+		Description: domain.TreatmentDescription(description),
+		Vet:         domain.VetName(vet),
+		TreatedAt:   fromUnix(treatedAt),
+	}
+
+	for _, medication := range medications {
+		treatment.Medications = append(treatment.Medications, domain.Medication(medication))
+	}
+
+	if rawDiagnosisID.Valid {
+		diagnosisID, err := parseUUID(rawDiagnosisID.String)
+		if err != nil {
+			return domain.Treatment{}, err
+		}
+
+		treatment.DiagnosisID = (*domain.DiagnosisID)(&diagnosisID)
+	}
+
+	return treatment, nil
+}
@@ -0,0 +1,30 @@
+CREATE TABLE enclosures (
+    id       TEXT PRIMARY KEY,
+    type     TEXT    NOT NULL,
+    size     INTEGER NOT NULL,
+    capacity INTEGER NOT NULL
+);
+
+CREATE TABLE animals (
+    id            TEXT PRIMARY KEY,
+    name          TEXT    NOT NULL,
+    gender        TEXT    NOT NULL,
+    species       TEXT    NOT NULL,
+    birth_date    INTEGER NOT NULL,
+    favorite_food TEXT    NOT NULL,
+    status        INTEGER NOT NULL,
+    enclosure_id  TEXT REFERENCES enclosures (id)
+);
+
+CREATE INDEX animals_enclosure_id_idx ON animals (enclosure_id);
+
+CREATE TABLE feeding_schedules (
+    id           TEXT PRIMARY KEY,
+    animal_id    TEXT    NOT NULL REFERENCES animals (id) ON DELETE CASCADE,
+    food         TEXT    NOT NULL,
+    feeding_time INTEGER NOT NULL,
+    done         INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX feeding_schedules_animal_id_idx ON feeding_schedules (animal_id);
+CREATE INDEX feeding_schedules_feeding_time_idx ON feeding_schedules (feeding_time);
@@ -0,0 +1,17 @@
+CREATE TABLE outbox_messages (
+    seq          INTEGER PRIMARY KEY AUTOINCREMENT,
+    id           TEXT    NOT NULL UNIQUE,
+    event_type   TEXT    NOT NULL,
+    payload      BLOB    NOT NULL,
+    occurred_at  INTEGER NOT NULL,
+    processed_at INTEGER
+);
+
+CREATE INDEX outbox_messages_pending_idx ON outbox_messages (processed_at, seq);
+
+CREATE TABLE outbox_deliveries (
+    message_id   TEXT    NOT NULL REFERENCES outbox_messages (id) ON DELETE CASCADE,
+    handler      TEXT    NOT NULL,
+    delivered_at INTEGER NOT NULL,
+    PRIMARY KEY (message_id, handler)
+);
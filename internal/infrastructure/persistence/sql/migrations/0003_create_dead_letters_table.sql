@@ -0,0 +1,10 @@
+CREATE TABLE dead_letters (
+    seq        INTEGER PRIMARY KEY AUTOINCREMENT,
+    id         TEXT    NOT NULL UNIQUE,
+    event_type TEXT    NOT NULL,
+    handler    TEXT    NOT NULL,
+    payload    BLOB    NOT NULL,
+    error      TEXT    NOT NULL,
+    attempts   INTEGER NOT NULL,
+    failed_at  INTEGER NOT NULL
+);
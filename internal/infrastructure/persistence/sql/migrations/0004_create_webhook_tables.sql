@@ -0,0 +1,23 @@
+CREATE TABLE webhooks (
+    id         TEXT PRIMARY KEY,
+    url        TEXT    NOT NULL,
+    events     TEXT    NOT NULL,
+    secret     TEXT    NOT NULL,
+    created_at INTEGER NOT NULL
+);
+
+CREATE TABLE webhook_delivery_attempts (
+    seq          INTEGER PRIMARY KEY AUTOINCREMENT,
+    id           TEXT    NOT NULL UNIQUE,
+    webhook_id   TEXT    NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    delivery_id  TEXT    NOT NULL,
+    event_type   TEXT    NOT NULL,
+    attempt      INTEGER NOT NULL,
+    status_code  INTEGER NOT NULL,
+    error        TEXT    NOT NULL,
+    succeeded    INTEGER NOT NULL,
+    attempted_at INTEGER NOT NULL,
+    duration     INTEGER NOT NULL
+);
+
+CREATE INDEX webhook_delivery_attempts_webhook_id_idx ON webhook_delivery_attempts (webhook_id, seq);
@@ -0,0 +1,28 @@
+CREATE TABLE medical_records (
+    animal_id TEXT PRIMARY KEY,
+    opened_at INTEGER NOT NULL
+);
+
+CREATE TABLE medical_diagnoses (
+    seq          INTEGER PRIMARY KEY AUTOINCREMENT,
+    id           TEXT    NOT NULL UNIQUE,
+    animal_id    TEXT    NOT NULL REFERENCES medical_records (animal_id) ON DELETE CASCADE,
+    description  TEXT    NOT NULL,
+    vet          TEXT    NOT NULL,
+    diagnosed_at INTEGER NOT NULL
+);
+
+CREATE INDEX medical_diagnoses_animal_id_idx ON medical_diagnoses (animal_id, seq);
+
+CREATE TABLE medical_treatments (
+    seq          INTEGER PRIMARY KEY AUTOINCREMENT,
+    id           TEXT    NOT NULL UNIQUE,
+    animal_id    TEXT    NOT NULL REFERENCES medical_records (animal_id) ON DELETE CASCADE,
+    diagnosis_id TEXT REFERENCES medical_diagnoses (id),
+    description  TEXT    NOT NULL,
+    vet          TEXT    NOT NULL,
+    medications  TEXT    NOT NULL,
+    treated_at   INTEGER NOT NULL
+);
+
+CREATE INDEX medical_treatments_animal_id_idx ON medical_treatments (animal_id, seq);
@@ -0,0 +1,11 @@
+CREATE TABLE medical_illnesses (
+    seq         INTEGER PRIMARY KEY AUTOINCREMENT,
+    id          TEXT    NOT NULL UNIQUE,
+    animal_id   TEXT    NOT NULL REFERENCES medical_records (animal_id) ON DELETE CASCADE,
+    diagnosis   TEXT    NOT NULL,
+    severity    TEXT    NOT NULL,
+    reported_by TEXT    NOT NULL,
+    reported_at INTEGER NOT NULL
+);
+
+CREATE INDEX medical_illnesses_animal_id_idx ON medical_illnesses (animal_id, seq);
@@ -0,0 +1,16 @@
+CREATE TABLE quarantines (
+    id                TEXT PRIMARY KEY,
+    animal_id         TEXT    NOT NULL,
+    enclosure_id      TEXT    NOT NULL,
+    from_enclosure_id TEXT,
+    reason            TEXT    NOT NULL,
+    ordered_by        TEXT    NOT NULL,
+    started_at        INTEGER NOT NULL,
+    ended_at          INTEGER,
+    cleared_by        TEXT    NOT NULL DEFAULT ''
+);
+
+CREATE INDEX quarantines_animal_id_idx ON quarantines (animal_id);
+
+-- An animal is in at most one quarantine at a time
+CREATE UNIQUE INDEX quarantines_active_animal_idx ON quarantines (animal_id) WHERE ended_at IS NULL;
@@ -0,0 +1,7 @@
+CREATE TABLE species (
+    name              TEXT PRIMARY KEY,
+    predator          INTEGER NOT NULL,
+    solitary          INTEGER NOT NULL,
+    max_per_enclosure INTEGER NOT NULL CHECK (max_per_enclosure >= 0),
+    mixed_genders     INTEGER NOT NULL
+);
@@ -0,0 +1,4 @@
+ALTER TABLE species ADD COLUMN aquatic INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE species ADD COLUMN aviary INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE species ADD COLUMN terrarium INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE species ADD COLUMN climates TEXT NOT NULL DEFAULT '[]';
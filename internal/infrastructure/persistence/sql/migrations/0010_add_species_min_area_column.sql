@@ -0,0 +1 @@
+ALTER TABLE species ADD COLUMN min_area INTEGER NOT NULL DEFAULT 0 CHECK (min_area >= 0);
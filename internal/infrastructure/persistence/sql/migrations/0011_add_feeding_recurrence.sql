@@ -0,0 +1,9 @@
+ALTER TABLE feeding_schedules ADD COLUMN recurrence TEXT;
+ALTER TABLE feeding_schedules ADD COLUMN time_zone TEXT;
+
+CREATE TABLE feeding_occurrences (
+    schedule_id     TEXT    NOT NULL REFERENCES feeding_schedules (id) ON DELETE CASCADE,
+    occurrence_time INTEGER NOT NULL,
+    status          TEXT    NOT NULL,
+    PRIMARY KEY (schedule_id, occurrence_time)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE food_stocks (
+    food                TEXT PRIMARY KEY,
+    quantity            REAL    NOT NULL CHECK (quantity >= 0),
+    unit                TEXT    NOT NULL,
+    low_stock_threshold REAL    NOT NULL CHECK (low_stock_threshold >= 0),
+    short               INTEGER NOT NULL DEFAULT 0
+);
+
+ALTER TABLE feeding_schedules ADD COLUMN portion_amount REAL NOT NULL DEFAULT 0;
+ALTER TABLE feeding_schedules ADD COLUMN portion_unit TEXT NOT NULL DEFAULT '';
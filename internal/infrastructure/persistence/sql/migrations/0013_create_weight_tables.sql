@@ -0,0 +1,20 @@
+CREATE TABLE weight_records (
+    animal_id TEXT PRIMARY KEY,
+    opened_at INTEGER NOT NULL
+);
+
+CREATE TABLE weight_measurements (
+    seq                  INTEGER PRIMARY KEY AUTOINCREMENT,
+    animal_id            TEXT    NOT NULL REFERENCES weight_records (animal_id) ON DELETE CASCADE,
+    weight               REAL    NOT NULL CHECK (weight > 0),
+    body_condition_score INTEGER NOT NULL DEFAULT 0,
+    measured_by          TEXT    NOT NULL,
+    measured_at          INTEGER NOT NULL
+);
+
+CREATE INDEX weight_measurements_animal_id_idx ON weight_measurements (animal_id, measured_at);
+
+ALTER TABLE species ADD COLUMN min_weight REAL NOT NULL DEFAULT 0;
+ALTER TABLE species ADD COLUMN max_weight REAL NOT NULL DEFAULT 0;
+ALTER TABLE species ADD COLUMN max_weight_change REAL NOT NULL DEFAULT 0;
+ALTER TABLE species ADD COLUMN weight_window INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,13 @@
+CREATE TABLE vet_appointments (
+    id         TEXT PRIMARY KEY,
+    animal_id  TEXT    NOT NULL,
+    vet        TEXT    NOT NULL,
+    procedure  TEXT    NOT NULL,
+    anesthesia INTEGER NOT NULL DEFAULT 0,
+    start_time INTEGER NOT NULL,
+    end_time   INTEGER NOT NULL CHECK (end_time > start_time),
+    status     TEXT    NOT NULL,
+    outcome    TEXT    NOT NULL DEFAULT ''
+);
+
+CREATE INDEX vet_appointments_start_time_idx ON vet_appointments (start_time);
@@ -0,0 +1,28 @@
+CREATE TABLE drugs (
+    name              TEXT PRIMARY KEY,
+    contraindications TEXT NOT NULL DEFAULT '[]'
+);
+
+CREATE TABLE drug_dosages (
+    seq                 INTEGER PRIMARY KEY AUTOINCREMENT,
+    drug                TEXT    NOT NULL REFERENCES drugs (name) ON DELETE CASCADE,
+    species             TEXT    NOT NULL,
+    min_mg_per_kg       REAL    NOT NULL CHECK (min_mg_per_kg > 0),
+    max_mg_per_kg       REAL    NOT NULL CHECK (max_mg_per_kg >= min_mg_per_kg),
+    max_daily_mg_per_kg REAL    NOT NULL DEFAULT 0,
+    UNIQUE (drug, species)
+);
+
+CREATE TABLE medical_administrations (
+    seq             INTEGER PRIMARY KEY AUTOINCREMENT,
+    id              TEXT    NOT NULL UNIQUE,
+    animal_id       TEXT    NOT NULL REFERENCES medical_records (animal_id) ON DELETE CASCADE,
+    treatment_id    TEXT    NOT NULL REFERENCES medical_treatments (id),
+    drug            TEXT    NOT NULL,
+    dose_mg         REAL    NOT NULL CHECK (dose_mg > 0),
+    weight          REAL    NOT NULL,
+    administered_by TEXT    NOT NULL,
+    administered_at INTEGER NOT NULL
+);
+
+CREATE INDEX medical_administrations_animal_id_idx ON medical_administrations (animal_id, seq);
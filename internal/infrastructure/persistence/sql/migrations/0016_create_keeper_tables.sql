@@ -0,0 +1,24 @@
+CREATE TABLE shifts (
+    name         TEXT PRIMARY KEY,
+    start_offset INTEGER NOT NULL,
+    end_offset   INTEGER NOT NULL,
+    days         TEXT    NOT NULL DEFAULT '',
+    time_zone    TEXT    NOT NULL
+);
+
+CREATE TABLE keepers (
+    id             TEXT PRIMARY KEY,
+    name           TEXT    NOT NULL,
+    max_enclosures INTEGER NOT NULL CHECK (max_enclosures > 0),
+    qualifications TEXT    NOT NULL DEFAULT '[]'
+);
+
+CREATE TABLE keeper_assignments (
+    seq          INTEGER PRIMARY KEY AUTOINCREMENT,
+    keeper_id    TEXT NOT NULL REFERENCES keepers (id) ON DELETE CASCADE,
+    enclosure_id TEXT NOT NULL,
+    shift        TEXT NOT NULL REFERENCES shifts (name),
+    UNIQUE (keeper_id, enclosure_id, shift)
+);
+
+CREATE INDEX keeper_assignments_enclosure_id_idx ON keeper_assignments (enclosure_id);
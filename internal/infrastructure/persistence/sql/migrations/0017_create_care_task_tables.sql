@@ -0,0 +1,17 @@
+ALTER TABLE feeding_schedules ADD COLUMN assignee_id TEXT;
+
+CREATE TABLE care_tasks (
+    id           TEXT PRIMARY KEY,
+    kind         TEXT    NOT NULL,
+    enclosure_id TEXT    NOT NULL,
+    task_time    INTEGER NOT NULL,
+    recurrence   TEXT,
+    time_zone    TEXT,
+    assignee_id  TEXT
+);
+
+CREATE TABLE care_task_completions (
+    task_id         TEXT    NOT NULL REFERENCES care_tasks (id) ON DELETE CASCADE,
+    occurrence_time INTEGER NOT NULL,
+    PRIMARY KEY (task_id, occurrence_time)
+);
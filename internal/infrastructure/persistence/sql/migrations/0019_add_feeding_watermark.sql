@@ -0,0 +1,16 @@
+ALTER TABLE feeding_schedules ADD COLUMN fed_through INTEGER;
+ALTER TABLE feeding_schedules ADD COLUMN next_feeding_time INTEGER;
+ALTER TABLE feeding_schedules ADD COLUMN end_time INTEGER;
+
+UPDATE feeding_schedules SET fed_through = (
+    SELECT MAX(o.occurrence_time) FROM feeding_occurrences o
+    WHERE o.schedule_id = feeding_schedules.id AND o.status = 'Done'
+);
+
+-- Occurrences are not generated here: the start of a schedule is an early enough next feeding
+-- and an unknown end is treated as none until the schedule is saved again
+UPDATE feeding_schedules SET next_feeding_time = feeding_time WHERE done = 0;
+UPDATE feeding_schedules SET end_time = feeding_time WHERE recurrence IS NULL;
+
+CREATE INDEX feeding_schedules_next_feeding_time_idx ON feeding_schedules (next_feeding_time);
+CREATE INDEX feeding_occurrences_occurrence_time_idx ON feeding_occurrences (occurrence_time);
@@ -0,0 +1,161 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/pkg/events"
+)
+
+// Static check that the interfaces are implemented.
+var (
+	_ events.Outbox      = (*OutboxRepository)(nil)
+	_ events.OutboxStore = (*OutboxRepository)(nil)
+)
+
+type OutboxRepository struct {
+	q querier
+}
+
+func NewOutboxRepository(db *sql.DB) *OutboxRepository {
+	return &OutboxRepository{q: db}
+}
+
+func (r *OutboxRepository) Record(ctx context.Context, event events.Event, occurredAt time.Time) error {
+	payload, err := events.Encode(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.q.ExecContext(ctx,
+		"INSERT INTO outbox_messages (id, event_type, payload, occurred_at) VALUES (?, ?, ?, ?)",
+		uuid.NewString(),
+		event.Name(),
+		payload,
+		toUnix(occurredAt),
+	)
+	if err != nil {
+		return fmt.Errorf("inserting outbox message: %w", err)
+	}
+
+	return nil
+}
+
+// PendingMessages returns unprocessed messages in the order they were recorded.
+func (r *OutboxRepository) PendingMessages(ctx context.Context, limit int) ([]*events.OutboxMessage, error) {
+	rows, err := r.q.QueryContext(ctx,
+		"SELECT id, event_type, payload, occurred_at, attempts FROM outbox_messages WHERE processed_at IS NULL ORDER BY seq LIMIT ?",
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying outbox messages: %w", err)
+	}
+
+	var (
+		messages []*events.OutboxMessage
+		byID     = make(map[string]*events.OutboxMessage)
+	)
+
+	for rows.Next() {
+		var (
+			rawID      string
+			message    events.OutboxMessage
+			occurredAt int64
+		)
+
+		if err := rows.Scan(&rawID, &message.EventType, &message.Payload, &occurredAt, &message.Attempts); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scanning outbox message: %w", err)
+		}
+
+		if message.ID, err = parseUUID(rawID); err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		message.OccurredAt = fromUnix(occurredAt)
+		message.Delivered = make(map[string]struct{})
+
+		messages = append(messages, &message)
+		byID[rawID] = &message
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying outbox messages: %w", err)
+	}
+
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	rows, err = r.q.QueryContext(ctx,
+		`SELECT d.message_id, d.handler FROM outbox_deliveries d
+		JOIN outbox_messages m ON m.id = d.message_id
+		WHERE m.processed_at IS NULL`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying outbox deliveries: %w", err)
+	}
+
+	for rows.Next() {
+		var messageID, handler string
+
+		if err := rows.Scan(&messageID, &handler); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scanning outbox delivery: %w", err)
+		}
+
+		if message, ok := byID[messageID]; ok {
+			message.Delivered[handler] = struct{}{}
+		}
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying outbox deliveries: %w", err)
+	}
+
+	return messages, nil
+}
+
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, messageID uuid.UUID, handler string) error {
+	_, err := r.q.ExecContext(ctx,
+		"INSERT OR IGNORE INTO outbox_deliveries (message_id, handler, delivered_at) VALUES (?, ?, ?)",
+		messageID.String(),
+		handler,
+		toUnix(time.Now()),
+	)
+	if err != nil {
+		return fmt.Errorf("marking outbox message delivered: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OutboxRepository) RecordAttempt(ctx context.Context, messageID uuid.UUID) error {
+	res, err := r.q.ExecContext(ctx,
+		"UPDATE outbox_messages SET attempts = attempts + 1 WHERE id = ?",
+		messageID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("recording outbox delivery attempt: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("outbox message with id %s not found", messageID))
+}
+
+// MarkProcessed keeps the message for auditing but excludes it from PendingMessages.
+func (r *OutboxRepository) MarkProcessed(ctx context.Context, messageID uuid.UUID) error {
+	res, err := r.q.ExecContext(ctx,
+		"UPDATE outbox_messages SET processed_at = ? WHERE id = ?",
+		toUnix(time.Now()),
+		messageID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("marking outbox message processed: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("outbox message with id %s not found", messageID))
+}
@@ -0,0 +1,211 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Static check that the interface is implemented.
+var _ domain.QuarantineRepository = (*QuarantineRepository)(nil)
+
+const quarantineColumns = "id, animal_id, enclosure_id, from_enclosure_id, reason, ordered_by, started_at, ended_at, cleared_by"
+
+type QuarantineRepository struct {
+	q querier
+}
+
+func NewQuarantineRepository(db *sql.DB) *QuarantineRepository {
+	return &QuarantineRepository{q: db}
+}
+
+func (r *QuarantineRepository) GetQuarantine(ctx context.Context, id domain.QuarantineID) (*domain.Quarantine, error) {
+	quarantines, err := r.loadQuarantines(ctx, "WHERE id = ?", id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(quarantines) == 0 {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrQuarantineNotFound, id)
+	}
+
+	return quarantines[0], nil
+}
+
+func (r *QuarantineRepository) AddQuarantine(ctx context.Context, quarantine *domain.Quarantine) error {
+	if quarantine.ID == domain.QuarantineID(uuid.Nil) {
+		return fmt.Errorf("quarantine: %w", domain.ErrNilID)
+	}
+
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM quarantines WHERE id = ?", quarantine.ID.String())
+	if err != nil {
+		return fmt.Errorf("checking quarantine existence: %w", err)
+	}
+
+	if exists > 0 {
+		return fmt.Errorf("%w: id %s", domain.ErrQuarantineAlreadyExists, quarantine.ID)
+	}
+
+	_, err = r.q.ExecContext(ctx,
+		"INSERT INTO quarantines ("+quarantineColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		quarantine.ID.String(),
+		quarantine.AnimalID.String(),
+		quarantine.EnclosureID.String(),
+		nullableEnclosureID(quarantine.FromEnclosureID),
+		string(quarantine.Reason),
+		string(quarantine.OrderedBy),
+		toUnix(quarantine.StartedAt),
+		endedAtOf(quarantine),
+		string(quarantine.ClearedBy),
+	)
+	if err != nil {
+		return fmt.Errorf("inserting quarantine: %w", err)
+	}
+
+	return nil
+}
+
+func (r *QuarantineRepository) UpdateQuarantine(ctx context.Context, quarantine *domain.Quarantine) error {
+	res, err := r.q.ExecContext(ctx,
+		`UPDATE quarantines
+		SET animal_id = ?, enclosure_id = ?, from_enclosure_id = ?, reason = ?, ordered_by = ?, started_at = ?, ended_at = ?, cleared_by = ?
+		WHERE id = ?`,
+		quarantine.AnimalID.String(),
+		quarantine.EnclosureID.String(),
+		nullableEnclosureID(quarantine.FromEnclosureID),
+		string(quarantine.Reason),
+		string(quarantine.OrderedBy),
+		toUnix(quarantine.StartedAt),
+		endedAtOf(quarantine),
+		string(quarantine.ClearedBy),
+		quarantine.ID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("updating quarantine: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: id %s", domain.ErrQuarantineNotFound, quarantine.ID))
+}
+
+func (r *QuarantineRepository) GetAllQuarantines(ctx context.Context) ([]*domain.Quarantine, error) {
+	return r.loadQuarantines(ctx, "")
+}
+
+func (r *QuarantineRepository) GetActiveQuarantine(ctx context.Context, animalID domain.AnimalID) (*domain.Quarantine, error) {
+	quarantines, err := r.loadQuarantines(ctx, "WHERE animal_id = ? AND ended_at IS NULL", animalID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(quarantines) == 0 {
+		return nil, fmt.Errorf("%w: no active quarantine for animal %s", domain.ErrQuarantineNotFound, animalID)
+	}
+
+	return quarantines[0], nil
+}
+
+func (r *QuarantineRepository) GetActiveQuarantines(ctx context.Context) ([]*domain.Quarantine, error) {
+	return r.loadQuarantines(ctx, "WHERE ended_at IS NULL")
+}
+
+func (r *QuarantineRepository) loadQuarantines(ctx context.Context, where string, args ...any) ([]*domain.Quarantine, error) {
+	rows, err := r.q.QueryContext(ctx, "SELECT "+quarantineColumns+" FROM quarantines "+where+" ORDER BY started_at", args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying quarantines: %w", err)
+	}
+
+	quarantines := make([]*domain.Quarantine, 0)
+
+	for rows.Next() {
+		quarantine, err := scanQuarantine(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		quarantines = append(quarantines, quarantine)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying quarantines: %w", err)
+	}
+
+	return quarantines, nil
+}
+
+func scanQuarantine(rows *sql.Rows) (*domain.Quarantine, error) {
+	var (
+		rawID, rawAnimalID, rawEnclosureID string
+		rawFromEnclosureID                 sql.NullString
+		reason, orderedBy, clearedBy       string
+		startedAt                          int64
+		endedAt                            sql.NullInt64
+	)
+
+	if err := rows.Scan(
+		&rawID, &rawAnimalID, &rawEnclosureID, &rawFromEnclosureID,
+		&reason, &orderedBy, &startedAt, &endedAt, &clearedBy,
+	); err != nil {
+		return nil, fmt.Errorf("scanning quarantine: %w", err)
+	}
+
+	id, err := parseUUID(rawID)
+	if err != nil {
+		return nil, err
+	}
+
+	animalID, err := parseUUID(rawAnimalID)
+	if err != nil {
+		return nil, err
+	}
+
+	enclosureID, err := parseUUID(rawEnclosureID)
+	if err != nil {
+		return nil, err
+	}
+
+	quarantine := &domain.Quarantine{
+		ID:          domain.QuarantineID(id),
+		AnimalID:    domain.AnimalID(animalID),
+		EnclosureID: domain.EnclosureID(enclosureID),
+		Reason:      domain.QuarantineReason(reason),
+		OrderedBy:   domain.VetName(orderedBy),
+		StartedAt:   fromUnix(startedAt),
+		ClearedBy:   domain.VetName(clearedBy),
+	}
+
+	if rawFromEnclosureID.Valid {
+		fromEnclosureID, err := parseUUID(rawFromEnclosureID.String)
+		if err != nil {
+			return nil, err
+		}
+
+		quarantine.FromEnclosureID = domain.EnclosureID(fromEnclosureID)
+	}
+
+	if endedAt.Valid {
+		ended := fromUnix(endedAt.Int64)
+		quarantine.EndedAt = &ended
+	}
+
+	return quarantine, nil
+}
+
+func nullableEnclosureID(id domain.EnclosureID) sql.NullString {
+	if id == domain.EnclosureID(uuid.Nil) {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: id.String(), Valid: true}
+}
+
+func endedAtOf(quarantine *domain.Quarantine) sql.NullInt64 {
+	if quarantine.EndedAt == nil {
+		return sql.NullInt64{}
+	}
+
+	return sql.NullInt64{Int64: toUnix(*quarantine.EndedAt), Valid: true}
+}
@@ -0,0 +1,171 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Static check that the interface is implemented.
+var _ domain.ShiftRepository = (*ShiftRepository)(nil)
+
+type ShiftRepository struct {
+	q querier
+}
+
+func NewShiftRepository(db *sql.DB) *ShiftRepository {
+	return &ShiftRepository{q: db}
+}
+
+func (r *ShiftRepository) GetShift(ctx context.Context, name domain.ShiftName) (*domain.Shift, error) {
+	shifts, err := r.loadShifts(ctx, "WHERE name = ?", string(name))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(shifts) == 0 {
+		return nil, fmt.Errorf("%w: name %s", domain.ErrShiftNotFound, name)
+	}
+
+	return shifts[0], nil
+}
+
+func (r *ShiftRepository) AddShift(ctx context.Context, shift *domain.Shift) error {
+	if shift.Name == "" {
+		return fmt.Errorf("shift: %w", domain.ErrEmptyShiftName)
+	}
+
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM shifts WHERE name = ?", string(shift.Name))
+	if err != nil {
+		return fmt.Errorf("checking shift existence: %w", err)
+	}
+
+	if exists > 0 {
+		return fmt.Errorf("%w: name %s", domain.ErrShiftAlreadyExists, shift.Name)
+	}
+
+	_, err = r.q.ExecContext(ctx,
+		"INSERT INTO shifts (name, start_offset, end_offset, days, time_zone) VALUES (?, ?, ?, ?, ?)",
+		string(shift.Name),
+		int64(shift.Start/time.Second),
+		int64(shift.End/time.Second),
+		encodeWeekdays(shift.Days),
+		shift.TimeZone(),
+	)
+	if err != nil {
+		return fmt.Errorf("inserting shift: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ShiftRepository) UpdateShift(ctx context.Context, shift *domain.Shift) error {
+	res, err := r.q.ExecContext(ctx,
+		"UPDATE shifts SET start_offset = ?, end_offset = ?, days = ?, time_zone = ? WHERE name = ?",
+		int64(shift.Start/time.Second),
+		int64(shift.End/time.Second),
+		encodeWeekdays(shift.Days),
+		shift.TimeZone(),
+		string(shift.Name),
+	)
+	if err != nil {
+		return fmt.Errorf("updating shift: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: name %s", domain.ErrShiftNotFound, shift.Name))
+}
+
+func (r *ShiftRepository) DeleteShift(ctx context.Context, name domain.ShiftName) error {
+	res, err := r.q.ExecContext(ctx, "DELETE FROM shifts WHERE name = ?", string(name))
+	if err != nil {
+		return fmt.Errorf("deleting shift: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: name %s", domain.ErrShiftNotFound, name))
+}
+
+func (r *ShiftRepository) GetAllShifts(ctx context.Context) ([]*domain.Shift, error) {
+	return r.loadShifts(ctx, "")
+}
+
+func (r *ShiftRepository) loadShifts(ctx context.Context, where string, args ...any) ([]*domain.Shift, error) {
+	rows, err := r.q.QueryContext(ctx,
+		"SELECT name, start_offset, end_offset, days, time_zone FROM shifts "+where+" ORDER BY name",
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying shifts: %w", err)
+	}
+
+	shifts := make([]*domain.Shift, 0)
+
+	for rows.Next() {
+		var (
+			name, days, timeZone string
+			start, end           int64
+		)
+
+		if err := rows.Scan(&name, &start, &end, &days, &timeZone); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scanning shift: %w", err)
+		}
+
+		shift := &domain.Shift{
+			Name:  domain.ShiftName(name),
+			Start: time.Duration(start) * time.Second,
+			End:   time.Duration(end) * time.Second,
+		}
+
+		if shift.Location, err = domain.LoadLocation(timeZone); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("loading time zone of shift %s: %w", name, err)
+		}
+
+		if shift.Days, err = decodeWeekdays(days); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("decoding days of shift %s: %w", name, err)
+		}
+
+		shifts = append(shifts, shift)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying shifts: %w", err)
+	}
+
+	return shifts, nil
+}
+
+// encodeWeekdays stores the weekdays as comma-separated RFC 5545 codes, such as "MO,TH".
+func encodeWeekdays(days []time.Weekday) string {
+	codes := make([]string, len(days))
+	for i, day := range days {
+		codes[i] = domain.WeekdayCode(day)
+	}
+
+	return strings.Join(codes, ",")
+}
+
+func decodeWeekdays(encoded string) ([]time.Weekday, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	codes := strings.Split(encoded, ",")
+	days := make([]time.Weekday, len(codes))
+
+	for i, code := range codes {
+		day, ok := domain.ParseWeekday(code)
+		if !ok {
+			return nil, fmt.Errorf("unknown weekday %q", code)
+		}
+
+		days[i] = day
+	}
+
+	return days, nil
+}
@@ -0,0 +1,196 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Static check that the interface is implemented.
+var _ domain.SpeciesRepository = (*SpeciesRepository)(nil)
+
+const speciesColumns = "name, predator, solitary, max_per_enclosure, mixed_genders, aquatic, aviary, terrarium, climates, min_area, " +
+	"min_weight, max_weight, max_weight_change, weight_window"
+
+type SpeciesRepository struct {
+	q querier
+}
+
+func NewSpeciesRepository(db *sql.DB) *SpeciesRepository {
+	return &SpeciesRepository{q: db}
+}
+
+func (r *SpeciesRepository) GetSpecies(ctx context.Context, name domain.AnimalSpecies) (*domain.Species, error) {
+	species, err := r.loadSpecies(ctx, "WHERE name = ?", string(name))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(species) == 0 {
+		return nil, fmt.Errorf("%w: name %s", domain.ErrSpeciesNotFound, name)
+	}
+
+	return species[0], nil
+}
+
+func (r *SpeciesRepository) AddSpecies(ctx context.Context, species *domain.Species) error {
+	if species.Name == "" {
+		return fmt.Errorf("species: %w", domain.ErrEmptySpeciesName)
+	}
+
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM species WHERE name = ?", string(species.Name))
+	if err != nil {
+		return fmt.Errorf("checking species existence: %w", err)
+	}
+
+	if exists > 0 {
+		return fmt.Errorf("%w: name %s", domain.ErrSpeciesAlreadyExists, species.Name)
+	}
+
+	climates, err := encodeClimates(species.Habitat.Climates)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.q.ExecContext(ctx,
+		"INSERT INTO species ("+speciesColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		string(species.Name),
+		species.Predator,
+		species.Solitary,
+		species.MaxPerEnclosure,
+		species.MixedGenders,
+		species.Habitat.Aquatic,
+		species.Habitat.Aviary,
+		species.Habitat.Terrarium,
+		climates,
+		int(species.MinArea),
+		species.WeightNorms.MinWeight,
+		species.WeightNorms.MaxWeight,
+		species.WeightNorms.MaxChangePercent,
+		int64(species.WeightNorms.Window),
+	)
+	if err != nil {
+		return fmt.Errorf("inserting species: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SpeciesRepository) DeleteSpecies(ctx context.Context, name domain.AnimalSpecies) error {
+	res, err := r.q.ExecContext(ctx, "DELETE FROM species WHERE name = ?", string(name))
+	if err != nil {
+		return fmt.Errorf("deleting species: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: name %s", domain.ErrSpeciesNotFound, name))
+}
+
+func (r *SpeciesRepository) UpdateSpecies(ctx context.Context, species *domain.Species) error {
+	climates, err := encodeClimates(species.Habitat.Climates)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.q.ExecContext(ctx,
+		`UPDATE species
+		SET predator = ?, solitary = ?, max_per_enclosure = ?, mixed_genders = ?,
+			aquatic = ?, aviary = ?, terrarium = ?, climates = ?, min_area = ?,
+			min_weight = ?, max_weight = ?, max_weight_change = ?, weight_window = ?
+		WHERE name = ?`,
+		species.Predator,
+		species.Solitary,
+		species.MaxPerEnclosure,
+		species.MixedGenders,
+		species.Habitat.Aquatic,
+		species.Habitat.Aviary,
+		species.Habitat.Terrarium,
+		climates,
+		int(species.MinArea),
+		species.WeightNorms.MinWeight,
+		species.WeightNorms.MaxWeight,
+		species.WeightNorms.MaxChangePercent,
+		int64(species.WeightNorms.Window),
+		string(species.Name),
+	)
+	if err != nil {
+		return fmt.Errorf("updating species: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: name %s", domain.ErrSpeciesNotFound, species.Name))
+}
+
+func (r *SpeciesRepository) GetAllSpecies(ctx context.Context) ([]*domain.Species, error) {
+	return r.loadSpecies(ctx, "")
+}
+
+func (r *SpeciesRepository) loadSpecies(ctx context.Context, where string, args ...any) ([]*domain.Species, error) {
+	rows, err := r.q.QueryContext(ctx, "SELECT "+speciesColumns+" FROM species "+where+" ORDER BY name", args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying species: %w", err)
+	}
+
+	species := make([]*domain.Species, 0)
+
+	for rows.Next() {
+		var (
+			s        domain.Species
+			name     string
+			climates string
+			minArea  int
+			window   int64
+		)
+
+		if err := rows.Scan(
+			&name,
+			&s.Predator,
+			&s.Solitary,
+			&s.MaxPerEnclosure,
+			&s.MixedGenders,
+			&s.Habitat.Aquatic,
+			&s.Habitat.Aviary,
+			&s.Habitat.Terrarium,
+			&climates,
+			&minArea,
+			&s.WeightNorms.MinWeight,
+			&s.WeightNorms.MaxWeight,
+			&s.WeightNorms.MaxChangePercent,
+			&window,
+		); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scanning species: %w", err)
+		}
+
+		if err := json.Unmarshal([]byte(climates), &s.Habitat.Climates); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("decoding climates of species %s: %w", name, err)
+		}
+
+		s.Name = domain.AnimalSpecies(name)
+		s.MinArea = domain.EnclosureSize(minArea)
+		s.WeightNorms.Window = time.Duration(window)
+		species = append(species, &s)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying species: %w", err)
+	}
+
+	return species, nil
+}
+
+func encodeClimates(climates []domain.Climate) (string, error) {
+	if climates == nil {
+		climates = []domain.Climate{}
+	}
+
+	encoded, err := json.Marshal(climates)
+	if err != nil {
+		return "", fmt.Errorf("encoding climates: %w", err)
+	}
+
+	return string(encoded), nil
+}
@@ -0,0 +1,193 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	"github.com/maklybae/ddd-zoo/pkg/events"
+)
+
+// Static check that the interface is implemented.
+var _ domain.UnitOfWork = (*UnitOfWork)(nil)
+
+// UnitOfWork runs every unit of work in its own database transaction. Views run on a
+// separate read-only database, so they do not queue behind writers for the write lock.
+type UnitOfWork struct {
+	db     *sql.DB
+	readDB *sql.DB
+}
+
+func NewUnitOfWork(db, readDB *sql.DB) *UnitOfWork {
+	return &UnitOfWork{db: db, readDB: readDB}
+}
+
+type (
+	txKey struct {
+		uow *UnitOfWork
+	}
+	viewKey struct {
+		uow *UnitOfWork
+	}
+)
+
+type repositories struct {
+	animals          *AnimalRepository
+	enclosures       *EnclosureRepository
+	feedingSchedules *FeedingScheduleRepository
+	medicalRecords   *MedicalRecordRepository
+	quarantines      *QuarantineRepository
+	species          *SpeciesRepository
+	foodStocks       *FoodStockRepository
+	weightRecords    *WeightRecordRepository
+	vetAppointments  *VetAppointmentRepository
+	drugs            *DrugRepository
+	keepers          *KeeperRepository
+	shifts           *ShiftRepository
+	careTasks        *CareTaskRepository
+	webhooks         *WebhookRepository
+	outbox           *OutboxRepository
+}
+
+func newRepositories(q querier) *repositories {
+	return &repositories{
+		animals:          &AnimalRepository{q: q},
+		enclosures:       &EnclosureRepository{q: q},
+		feedingSchedules: &FeedingScheduleRepository{q: q},
+		medicalRecords:   &MedicalRecordRepository{q: q},
+		quarantines:      &QuarantineRepository{q: q},
+		species:          &SpeciesRepository{q: q},
+		foodStocks:       &FoodStockRepository{q: q},
+		weightRecords:    &WeightRecordRepository{q: q},
+		vetAppointments:  &VetAppointmentRepository{q: q},
+		drugs:            &DrugRepository{q: q},
+		keepers:          &KeeperRepository{q: q},
+		shifts:           &ShiftRepository{q: q},
+		careTasks:        &CareTaskRepository{q: q},
+		webhooks:         &WebhookRepository{q: q},
+		outbox:           &OutboxRepository{q: q},
+	}
+}
+
+func (r *repositories) Animals() domain.AnimalRepository {
+	return r.animals
+}
+
+func (r *repositories) Enclosures() domain.EnclosureRepository {
+	return r.enclosures
+}
+
+func (r *repositories) FeedingSchedules() domain.FeedingScheduleRepository {
+	return r.feedingSchedules
+}
+
+func (r *repositories) MedicalRecords() domain.MedicalRecordRepository {
+	return r.medicalRecords
+}
+
+func (r *repositories) Quarantines() domain.QuarantineRepository {
+	return r.quarantines
+}
+
+func (r *repositories) Species() domain.SpeciesRepository {
+	return r.species
+}
+
+func (r *repositories) FoodStocks() domain.FoodStockRepository {
+	return r.foodStocks
+}
+
+func (r *repositories) WeightRecords() domain.WeightRecordRepository {
+	return r.weightRecords
+}
+
+func (r *repositories) VetAppointments() domain.VetAppointmentRepository {
+	return r.vetAppointments
+}
+
+func (r *repositories) Drugs() domain.DrugRepository {
+	return r.drugs
+}
+
+func (r *repositories) Keepers() domain.KeeperRepository {
+	return r.keepers
+}
+
+func (r *repositories) Shifts() domain.ShiftRepository {
+	return r.shifts
+}
+
+func (r *repositories) CareTasks() domain.CareTaskRepository {
+	return r.careTasks
+}
+
+func (r *repositories) Webhooks() domain.WebhookRepository {
+	return r.webhooks
+}
+
+func (r *repositories) Outbox() events.Outbox {
+	return r.outbox
+}
+
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, repos domain.Repositories) error) (err error) {
+	if repos, ok := ctx.Value(txKey{u}).(*repositories); ok {
+		return fn(ctx, repos)
+	}
+
+	if ctx.Value(viewKey{u}) != nil {
+		return domain.ErrWriteInView
+	}
+
+	tx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+				err = errors.Join(err, fmt.Errorf("rolling back transaction: %w", rollbackErr))
+			}
+		}
+	}()
+
+	repos := newRepositories(tx)
+
+	if err = fn(context.WithValue(ctx, txKey{u}, repos), repos); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// View runs fn in a read-only transaction that is always rolled back.
+func (u *UnitOfWork) View(ctx context.Context, fn func(ctx context.Context, repos domain.Repositories) error) error {
+	if repos, ok := ctx.Value(txKey{u}).(*repositories); ok {
+		return fn(ctx, repos)
+	}
+
+	if repos, ok := ctx.Value(viewKey{u}).(*repositories); ok {
+		return fn(ctx, repos)
+	}
+
+	tx, err := u.readDB.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("beginning read-only transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repos := newRepositories(tx)
+
+	return fn(context.WithValue(ctx, viewKey{u}, repos), repos)
+}
@@ -0,0 +1,175 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Static check that the interface is implemented.
+var _ domain.VetAppointmentRepository = (*VetAppointmentRepository)(nil)
+
+const vetAppointmentColumns = "id, animal_id, vet, procedure, anesthesia, start_time, end_time, status, outcome"
+
+type VetAppointmentRepository struct {
+	q querier
+}
+
+func NewVetAppointmentRepository(db *sql.DB) *VetAppointmentRepository {
+	return &VetAppointmentRepository{q: db}
+}
+
+func (r *VetAppointmentRepository) GetVetAppointment(ctx context.Context, id domain.VetAppointmentID) (*domain.VetAppointment, error) {
+	appointments, err := r.loadVetAppointments(ctx, "WHERE id = ?", id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(appointments) == 0 {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrVetAppointmentNotFound, id)
+	}
+
+	return appointments[0], nil
+}
+
+func (r *VetAppointmentRepository) AddVetAppointment(ctx context.Context, appointment *domain.VetAppointment) error {
+	if appointment.ID == domain.VetAppointmentID(uuid.Nil) {
+		return fmt.Errorf("vet appointment: %w", domain.ErrNilID)
+	}
+
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM vet_appointments WHERE id = ?", appointment.ID.String())
+	if err != nil {
+		return fmt.Errorf("checking vet appointment existence: %w", err)
+	}
+
+	if exists > 0 {
+		return fmt.Errorf("%w: id %s", domain.ErrVetAppointmentAlreadyExists, appointment.ID)
+	}
+
+	_, err = r.q.ExecContext(ctx,
+		"INSERT INTO vet_appointments ("+vetAppointmentColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		appointment.ID.String(),
+		appointment.AnimalID.String(),
+		string(appointment.Vet),
+		string(appointment.Procedure),
+		appointment.Anesthesia,
+		toUnix(appointment.Slot.Start),
+		toUnix(appointment.Slot.End),
+		string(appointment.Status),
+		appointment.Outcome,
+	)
+	if err != nil {
+		return fmt.Errorf("inserting vet appointment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *VetAppointmentRepository) UpdateVetAppointment(ctx context.Context, appointment *domain.VetAppointment) error {
+	res, err := r.q.ExecContext(ctx,
+		`UPDATE vet_appointments
+		SET animal_id = ?, vet = ?, procedure = ?, anesthesia = ?, start_time = ?, end_time = ?, status = ?, outcome = ?
+		WHERE id = ?`,
+		appointment.AnimalID.String(),
+		string(appointment.Vet),
+		string(appointment.Procedure),
+		appointment.Anesthesia,
+		toUnix(appointment.Slot.Start),
+		toUnix(appointment.Slot.End),
+		string(appointment.Status),
+		appointment.Outcome,
+		appointment.ID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("updating vet appointment: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: id %s", domain.ErrVetAppointmentNotFound, appointment.ID))
+}
+
+func (r *VetAppointmentRepository) DeleteVetAppointment(ctx context.Context, id domain.VetAppointmentID) error {
+	res, err := r.q.ExecContext(ctx, "DELETE FROM vet_appointments WHERE id = ?", id.String())
+	if err != nil {
+		return fmt.Errorf("deleting vet appointment: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: id %s", domain.ErrVetAppointmentNotFound, id))
+}
+
+func (r *VetAppointmentRepository) GetAllVetAppointments(ctx context.Context) ([]*domain.VetAppointment, error) {
+	return r.loadVetAppointments(ctx, "")
+}
+
+func (r *VetAppointmentRepository) GetVetAppointmentsForTimeRange(
+	ctx context.Context,
+	startTime, endTime time.Time,
+) ([]*domain.VetAppointment, error) {
+	return r.loadVetAppointments(ctx, "WHERE start_time < ? AND end_time > ?", toUnix(endTime), toUnix(startTime))
+}
+
+func (r *VetAppointmentRepository) loadVetAppointments(ctx context.Context, where string, args ...any) ([]*domain.VetAppointment, error) {
+	rows, err := r.q.QueryContext(ctx, "SELECT "+vetAppointmentColumns+" FROM vet_appointments "+where+" ORDER BY start_time", args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying vet appointments: %w", err)
+	}
+
+	appointments := make([]*domain.VetAppointment, 0)
+
+	for rows.Next() {
+		appointment, err := scanVetAppointment(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		appointments = append(appointments, appointment)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying vet appointments: %w", err)
+	}
+
+	return appointments, nil
+}
+
+func scanVetAppointment(rows *sql.Rows) (*domain.VetAppointment, error) {
+	var (
+		rawID, rawAnimalID string
+		vet, procedure     string
+		anesthesia         bool
+		startTime, endTime int64
+		status, outcome    string
+	)
+
+	if err := rows.Scan(
+		&rawID, &rawAnimalID, &vet, &procedure, &anesthesia,
+		&startTime, &endTime, &status, &outcome,
+	); err != nil {
+		return nil, fmt.Errorf("scanning vet appointment: %w", err)
+	}
+
+	id, err := parseUUID(rawID)
+	if err != nil {
+		return nil, err
+	}
+
+	animalID, err := parseUUID(rawAnimalID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.VetAppointment{
+		ID:         domain.VetAppointmentID(id),
+		AnimalID:   domain.AnimalID(animalID),
+		Vet:        domain.VetName(vet),
+		Slot:       domain.TimeSlot{Start: fromUnix(startTime), End: fromUnix(endTime)},
+		Procedure:  domain.TreatmentDescription(procedure),
+		Anesthesia: anesthesia,
+		Status:     domain.VetAppointmentStatus(status),
+		Outcome:    outcome,
+	}, nil
+}
@@ -0,0 +1,253 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Static check that the interface is implemented.
+var _ domain.WebhookRepository = (*WebhookRepository)(nil)
+
+const (
+	webhookColumns         = "id, url, events, secret, created_at"
+	deliveryAttemptColumns = "id, webhook_id, delivery_id, event_type, attempt, status_code, error, succeeded, attempted_at, duration"
+)
+
+type WebhookRepository struct {
+	q querier
+}
+
+func NewWebhookRepository(db *sql.DB) *WebhookRepository {
+	return &WebhookRepository{q: db}
+}
+
+func (r *WebhookRepository) GetWebhook(ctx context.Context, id domain.WebhookID) (*domain.WebhookSubscription, error) {
+	webhooks, err := r.loadWebhooks(ctx, "WHERE id = ?", id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(webhooks) == 0 {
+		return nil, fmt.Errorf("%w: id %s", domain.ErrWebhookNotFound, id)
+	}
+
+	return webhooks[0], nil
+}
+
+func (r *WebhookRepository) AddWebhook(ctx context.Context, webhook *domain.WebhookSubscription) error {
+	if webhook.ID == domain.WebhookID(uuid.Nil) {
+		return fmt.Errorf("webhook: %w", domain.ErrNilID)
+	}
+
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM webhooks WHERE id = ?", webhook.ID.String())
+	if err != nil {
+		return fmt.Errorf("checking webhook existence: %w", err)
+	}
+
+	if exists > 0 {
+		return fmt.Errorf("%w: id %s", domain.ErrWebhookAlreadyExists, webhook.ID)
+	}
+
+	eventTypes, err := json.Marshal(webhook.Events)
+	if err != nil {
+		return fmt.Errorf("encoding webhook events: %w", err)
+	}
+
+	_, err = r.q.ExecContext(ctx,
+		"INSERT INTO webhooks ("+webhookColumns+") VALUES (?, ?, ?, ?, ?)",
+		webhook.ID.String(),
+		webhook.URL,
+		string(eventTypes),
+		string(webhook.Secret),
+		toUnix(webhook.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("inserting webhook: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteWebhook deletes the webhook together with its delivery attempts.
+func (r *WebhookRepository) DeleteWebhook(ctx context.Context, id domain.WebhookID) error {
+	res, err := r.q.ExecContext(ctx, "DELETE FROM webhooks WHERE id = ?", id.String())
+	if err != nil {
+		return fmt.Errorf("deleting webhook: %w", err)
+	}
+
+	return ensureAffected(res, fmt.Errorf("%w: id %s", domain.ErrWebhookNotFound, id))
+}
+
+func (r *WebhookRepository) GetAllWebhooks(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	return r.loadWebhooks(ctx, "")
+}
+
+// GetWebhooksForEvent returns webhooks subscribed to events with the given name.
+func (r *WebhookRepository) GetWebhooksForEvent(ctx context.Context, eventType string) ([]*domain.WebhookSubscription, error) {
+	webhooks, err := r.loadWebhooks(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []*domain.WebhookSubscription
+
+	for _, webhook := range webhooks {
+		if webhook.Events.Matches(eventType) {
+			matching = append(matching, webhook)
+		}
+	}
+
+	return matching, nil
+}
+
+func (r *WebhookRepository) AddDeliveryAttempt(ctx context.Context, attempt *domain.WebhookDeliveryAttempt) error {
+	_, err := r.q.ExecContext(ctx,
+		"INSERT INTO webhook_delivery_attempts ("+deliveryAttemptColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		attempt.ID.String(),
+		attempt.WebhookID.String(),
+		attempt.DeliveryID.String(),
+		attempt.EventType,
+		attempt.Attempt,
+		attempt.StatusCode,
+		attempt.Error,
+		attempt.Succeeded,
+		toUnix(attempt.AttemptedAt),
+		int64(attempt.Duration),
+	)
+	if err != nil {
+		return fmt.Errorf("inserting webhook delivery attempt: %w", err)
+	}
+
+	return nil
+}
+
+// GetDeliveryAttempts returns attempts to deliver events to the webhook, oldest first.
+func (r *WebhookRepository) GetDeliveryAttempts(ctx context.Context, webhookID domain.WebhookID) ([]*domain.WebhookDeliveryAttempt, error) {
+	if _, err := r.GetWebhook(ctx, webhookID); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.q.QueryContext(ctx,
+		"SELECT "+deliveryAttemptColumns+" FROM webhook_delivery_attempts WHERE webhook_id = ? ORDER BY seq",
+		webhookID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying webhook delivery attempts: %w", err)
+	}
+
+	attempts := make([]*domain.WebhookDeliveryAttempt, 0)
+
+	for rows.Next() {
+		attempt, err := scanDeliveryAttempt(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		attempts = append(attempts, attempt)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying webhook delivery attempts: %w", err)
+	}
+
+	return attempts, nil
+}
+
+func (r *WebhookRepository) loadWebhooks(ctx context.Context, where string, args ...any) ([]*domain.WebhookSubscription, error) {
+	rows, err := r.q.QueryContext(ctx, "SELECT "+webhookColumns+" FROM webhooks "+where+" ORDER BY created_at", args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying webhooks: %w", err)
+	}
+
+	webhooks := make([]*domain.WebhookSubscription, 0)
+
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+func scanWebhook(rows *sql.Rows) (*domain.WebhookSubscription, error) {
+	var (
+		rawID      string
+		webhook    domain.WebhookSubscription
+		eventTypes string
+		secret     string
+		createdAt  int64
+	)
+
+	if err := rows.Scan(&rawID, &webhook.URL, &eventTypes, &secret, &createdAt); err != nil {
+		return nil, fmt.Errorf("scanning webhook: %w", err)
+	}
+
+	id, err := parseUUID(rawID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(eventTypes), &webhook.Events); err != nil {
+		return nil, fmt.Errorf("decoding webhook events: %w", err)
+	}
+
+	webhook.ID = domain.WebhookID(id)
+	webhook.Secret = domain.WebhookSecret(secret)
+	webhook.CreatedAt = fromUnix(createdAt)
+
+	return &webhook, nil
+}
+
+func scanDeliveryAttempt(rows *sql.Rows) (*domain.WebhookDeliveryAttempt, error) {
+	var (
+		rawID, rawWebhookID, rawDeliveryID string
+		attempt                            domain.WebhookDeliveryAttempt
+		attemptedAt, duration              int64
+	)
+
+	if err := rows.Scan(
+		&rawID, &rawWebhookID, &rawDeliveryID, &attempt.EventType, &attempt.Attempt,
+		&attempt.StatusCode, &attempt.Error, &attempt.Succeeded, &attemptedAt, &duration,
+	); err != nil {
+		return nil, fmt.Errorf("scanning webhook delivery attempt: %w", err)
+	}
+
+	id, err := parseUUID(rawID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookID, err := parseUUID(rawWebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryID, err := parseUUID(rawDeliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt.ID = id
+	attempt.WebhookID = domain.WebhookID(webhookID)
+	attempt.DeliveryID = domain.WebhookDeliveryID(deliveryID)
+	attempt.AttemptedAt = fromUnix(attemptedAt)
+	attempt.Duration = time.Duration(duration)
+
+	return &attempt, nil
+}
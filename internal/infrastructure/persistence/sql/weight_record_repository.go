@@ -0,0 +1,150 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+)
+
+// Static check that the interface is implemented.
+var _ domain.WeightRecordRepository = (*WeightRecordRepository)(nil)
+
+type WeightRecordRepository struct {
+	q querier
+}
+
+func NewWeightRecordRepository(db *sql.DB) *WeightRecordRepository {
+	return &WeightRecordRepository{q: db}
+}
+
+func (r *WeightRecordRepository) GetWeightRecord(ctx context.Context, animalID domain.AnimalID) (*domain.WeightRecord, error) {
+	var openedAt int64
+
+	err := r.q.QueryRowContext(ctx,
+		"SELECT opened_at FROM weight_records WHERE animal_id = ?", animalID.String(),
+	).Scan(&openedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: animal id %s", domain.ErrWeightRecordNotFound, animalID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("querying weight record: %w", err)
+	}
+
+	record := domain.NewWeightRecord(animalID, fromUnix(openedAt))
+
+	if record.Measurements, err = r.loadMeasurements(ctx, animalID); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (r *WeightRecordRepository) AddWeightRecord(ctx context.Context, record *domain.WeightRecord) error {
+	if record.AnimalID == domain.AnimalID(uuid.Nil) {
+		return fmt.Errorf("weight record: %w", domain.ErrNilID)
+	}
+
+	exists, err := count(ctx, r.q, "SELECT COUNT(*) FROM weight_records WHERE animal_id = ?", record.AnimalID.String())
+	if err != nil {
+		return fmt.Errorf("checking weight record existence: %w", err)
+	}
+
+	if exists > 0 {
+		return fmt.Errorf("%w: animal id %s", domain.ErrWeightRecordAlreadyExists, record.AnimalID)
+	}
+
+	_, err = r.q.ExecContext(ctx,
+		"INSERT INTO weight_records (animal_id, opened_at) VALUES (?, ?)",
+		record.AnimalID.String(),
+		toUnix(record.OpenedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("inserting weight record: %w", err)
+	}
+
+	return r.insertMeasurements(ctx, record)
+}
+
+// UpdateWeightRecord replaces the measurements stored for the record.
+func (r *WeightRecordRepository) UpdateWeightRecord(ctx context.Context, record *domain.WeightRecord) error {
+	res, err := r.q.ExecContext(ctx,
+		"UPDATE weight_records SET opened_at = ? WHERE animal_id = ?",
+		toUnix(record.OpenedAt),
+		record.AnimalID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("updating weight record: %w", err)
+	}
+
+	if err := ensureAffected(res, fmt.Errorf("%w: animal id %s", domain.ErrWeightRecordNotFound, record.AnimalID)); err != nil {
+		return err
+	}
+
+	if _, err := r.q.ExecContext(ctx, "DELETE FROM weight_measurements WHERE animal_id = ?", record.AnimalID.String()); err != nil {
+		return fmt.Errorf("deleting weight measurements: %w", err)
+	}
+
+	return r.insertMeasurements(ctx, record)
+}
+
+func (r *WeightRecordRepository) insertMeasurements(ctx context.Context, record *domain.WeightRecord) error {
+	for _, measurement := range record.Measurements {
+		_, err := r.q.ExecContext(ctx,
+			"INSERT INTO weight_measurements (animal_id, weight, body_condition_score, measured_by, measured_at) VALUES (?, ?, ?, ?, ?)",
+			record.AnimalID.String(),
+			measurement.Weight,
+			int(measurement.BodyConditionScore),
+			string(measurement.MeasuredBy),
+			toUnix(measurement.MeasuredAt),
+		)
+		if err != nil {
+			return fmt.Errorf("inserting weight measurement: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *WeightRecordRepository) loadMeasurements(ctx context.Context, animalID domain.AnimalID) ([]domain.WeightMeasurement, error) {
+	rows, err := r.q.QueryContext(ctx,
+		"SELECT weight, body_condition_score, measured_by, measured_at FROM weight_measurements WHERE animal_id = ? ORDER BY measured_at, seq",
+		animalID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying weight measurements: %w", err)
+	}
+
+	var measurements []domain.WeightMeasurement
+
+	for rows.Next() {
+		var (
+			weight             float64
+			bodyConditionScore int
+			measuredBy         string
+			measuredAt         int64
+		)
+
+		if err := rows.Scan(&weight, &bodyConditionScore, &measuredBy, &measuredAt); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scanning weight measurement: %w", err)
+		}
+
+		measurements = append(measurements, domain.WeightMeasurement{
+			Weight:             weight,
+			BodyConditionScore: domain.BodyConditionScore(bodyConditionScore),
+			MeasuredBy:         domain.ReporterName(measuredBy),
+			MeasuredAt:         fromUnix(measuredAt),
+		})
+	}
+
+	if err := closeRows(rows); err != nil {
+		return nil, fmt.Errorf("querying weight measurements: %w", err)
+	}
+
+	return measurements, nil
+}
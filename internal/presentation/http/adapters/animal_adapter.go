@@ -1,6 +1,7 @@
 package adapters
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,11 +19,6 @@ func DomainAnimalToAPI(animal *domain.Animal) v1.Animal {
 		enclosureID = uuid.UUID(animal.Enclosure.ID)
 	}
 
-	status := v1.AnimalStatusHealthy
-	if animal.Status == domain.AnimalStatusSick {
-		status = v1.AnimalStatusSick
-	}
-
 	gender := v1.AnimalGenderMale
 	if animal.Gender == domain.Female {
 		gender = v1.AnimalGenderFemale
@@ -38,10 +34,19 @@ func DomainAnimalToAPI(animal *domain.Animal) v1.Animal {
 		BirthDate:    birthDate,
 		Gender:       gender,
 		FavoriteFood: string(animal.FavoriteFood),
-		Status:       status,
+		Status:       DomainAnimalStatusToAPI(animal.Status),
 	}
 }
 
+// DomainAnimalStatusToAPI relies on the API status names matching the domain ones.
+func DomainAnimalStatusToAPI(status domain.AnimalStatus) v1.AnimalStatus {
+	return v1.AnimalStatus(status.String())
+}
+
+func APIAnimalStatusToDomain(status v1.AnimalStatusInputStatus) (domain.AnimalStatus, error) {
+	return domain.ParseAnimalStatus(string(status))
+}
+
 func APIToNewDomainAnimal(input v1.AnimalInput) (*domain.Animal, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
@@ -55,9 +60,13 @@ func APIToNewDomainAnimal(input v1.AnimalInput) (*domain.Animal, error) {
 		gender = domain.Female
 	}
 
-	status := domain.AnimalStatusHealthy
-	if input.Status == v1.AnimalInputStatusSick {
-		status = domain.AnimalStatusSick
+	// New animals are healthy: an animal becomes sick only through an illness report
+	switch string(input.Status) {
+	case string(v1.AnimalInputStatusHealthy):
+	case domain.AnimalStatusSick.String():
+		return nil, domain.ErrIllnessNotReported
+	default:
+		return nil, fmt.Errorf("%w: %q", domain.ErrUnknownAnimalStatus, input.Status)
 	}
 
 	return &domain.Animal{
@@ -67,7 +76,7 @@ func APIToNewDomainAnimal(input v1.AnimalInput) (*domain.Animal, error) {
 		BirthDate:    domain.BirthDate(birthDate),
 		Gender:       gender,
 		FavoriteFood: domain.Food(input.FavoriteFood),
-		Status:       status,
+		Status:       domain.AnimalStatusHealthy,
 	}, nil
 }
 
@@ -0,0 +1,40 @@
+package adapters
+
+import (
+	"encoding/json"
+
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+	"github.com/maklybae/ddd-zoo/pkg/events"
+)
+
+func DeadLetterToAPI(letter *events.DeadLetter) (v1.DeadLetter, error) {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(letter.Payload, &payload); err != nil {
+		return v1.DeadLetter{}, err
+	}
+
+	return v1.DeadLetter{
+		Id:        letter.ID,
+		EventType: letter.EventType,
+		Handler:   letter.Handler,
+		Payload:   payload,
+		Error:     letter.Error,
+		Attempts:  letter.Attempts,
+		FailedAt:  letter.FailedAt,
+	}, nil
+}
+
+func DeadLetterToAPIList(letters []*events.DeadLetter) ([]v1.DeadLetter, error) {
+	result := make([]v1.DeadLetter, len(letters))
+
+	for i, letter := range letters {
+		apiLetter, err := DeadLetterToAPI(letter)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = apiLetter
+	}
+
+	return result, nil
+}
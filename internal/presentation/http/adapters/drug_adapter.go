@@ -0,0 +1,99 @@
+package adapters
+
+import (
+	"github.com/maklybae/ddd-zoo/internal/application/services"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+)
+
+func DomainDrugToAPI(drug *domain.Drug) v1.Drug {
+	contraindications := append(make([]string, 0, len(drug.Contraindications)), drug.Contraindications...)
+
+	result := v1.Drug{
+		Name:              string(drug.Name),
+		Dosages:           make([]v1.SpeciesDosage, len(drug.Dosages)),
+		Contraindications: &contraindications,
+	}
+
+	for i, dosage := range drug.Dosages {
+		result.Dosages[i] = v1.SpeciesDosage{
+			Species:         string(dosage.Species),
+			MinMgPerKg:      dosage.MinMgPerKg,
+			MaxMgPerKg:      dosage.MaxMgPerKg,
+			MaxDailyMgPerKg: &dosage.MaxDailyMgPerKg,
+		}
+	}
+
+	return result
+}
+
+func DomainDrugsToAPI(drugs []*domain.Drug) []v1.Drug {
+	result := make([]v1.Drug, len(drugs))
+	for i, drug := range drugs {
+		result[i] = DomainDrugToAPI(drug)
+	}
+
+	return result
+}
+
+func APIDrugToDomain(input v1.Drug) (*domain.Drug, error) {
+	return APIDrugRulesToDomain(domain.DrugName(input.Name), v1.DrugRules{
+		Dosages:           input.Dosages,
+		Contraindications: input.Contraindications,
+	})
+}
+
+func APIDrugRulesToDomain(name domain.DrugName, rules v1.DrugRules) (*domain.Drug, error) {
+	dosages := make([]domain.SpeciesDosage, len(rules.Dosages))
+	for i, dosage := range rules.Dosages {
+		dosages[i] = domain.SpeciesDosage{
+			Species:         domain.AnimalSpecies(dosage.Species),
+			MinMgPerKg:      dosage.MinMgPerKg,
+			MaxMgPerKg:      dosage.MaxMgPerKg,
+			MaxDailyMgPerKg: valueOr(dosage.MaxDailyMgPerKg, 0),
+		}
+	}
+
+	return domain.NewDrug(name, dosages, valueOr(rules.Contraindications, nil))
+}
+
+func DomainDoseRangeToAPI(dose *domain.DoseRange) v1.DoseRange {
+	result := v1.DoseRange{
+		Drug:      string(dose.Drug),
+		Weight:    dose.Weight,
+		WeighedAt: dose.WeighedAt,
+		MinDoseMg: dose.MinMg,
+		MaxDoseMg: dose.MaxMg,
+		GivenMg:   dose.GivenMg,
+	}
+
+	if dose.MaxDailyMg > 0 {
+		maxDaily, remaining := dose.MaxDailyMg, dose.RemainingDailyMg()
+		result.MaxDailyDoseMg = &maxDaily
+		result.RemainingDailyMg = &remaining
+	}
+
+	return result
+}
+
+func DomainDrugAdministrationToAPI(administration *domain.DrugAdministration) v1.DrugAdministration {
+	return v1.DrugAdministration{
+		Id:             administration.ID.UUID(),
+		TreatmentId:    administration.TreatmentID.UUID(),
+		Drug:           string(administration.Drug),
+		DoseMg:         administration.DoseMg,
+		Weight:         administration.Weight,
+		AdministeredBy: string(administration.AdministeredBy),
+		AdministeredAt: administration.AdministeredAt,
+	}
+}
+
+func APIDrugAdministrationInputToService(input v1.DrugAdministrationInput) services.AdministrationInput {
+	return services.AdministrationInput{
+		TreatmentID:    domain.TreatmentID(input.TreatmentId),
+		Drug:           domain.DrugName(input.Drug),
+		DoseMg:         input.DoseMg,
+		AdministeredBy: domain.ReporterName(input.AdministeredBy),
+		AdministeredAt: input.AdministeredAt,
+	}
+}
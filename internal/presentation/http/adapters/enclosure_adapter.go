@@ -6,7 +6,8 @@ import (
 	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
 )
 
-func DomainEnclosureToAPI(enclosure *domain.Enclosure) v1.Enclosure {
+// DomainEnclosureToAPI converts the enclosure, using the species catalog to report the space left in it.
+func DomainEnclosureToAPI(enclosure *domain.Enclosure, catalog domain.SpeciesCatalog) v1.Enclosure {
 	if enclosure == nil {
 		return v1.Enclosure{}
 	}
@@ -24,14 +25,22 @@ func DomainEnclosureToAPI(enclosure *domain.Enclosure) v1.Enclosure {
 	return v1.Enclosure{
 		Id:             enclosure.ID.UUID(),
 		Animals:        &animals,
-		Type:           string(enclosure.Type),
+		Type:           v1.EnclosureType(enclosure.Type),
+		Habitat:        DomainHabitatToAPI(enclosure.Type.Habitat()),
 		Size:           int(enclosure.Size),
 		CurrentAnimals: enclosure.Occupancy.CountAnimals(),
 		MaxCapacity:    enclosure.Occupancy.Capacity,
+		RemainingArea:  int(enclosure.RemainingArea(catalog)),
+		RemainingSlots: remainingSlots(enclosure, catalog),
 	}
 }
 
 func APIToNewDomainEnclosure(input v1.EnclosureInput) (*domain.Enclosure, error) {
+	enclosureType, err := domain.ParseEnclosureType(string(input.Type))
+	if err != nil {
+		return nil, err
+	}
+
 	id, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
@@ -39,7 +48,7 @@ func APIToNewDomainEnclosure(input v1.EnclosureInput) (*domain.Enclosure, error)
 
 	return &domain.Enclosure{
 		ID:   domain.EnclosureID(id),
-		Type: domain.EnclosureType(input.Type),
+		Type: enclosureType,
 		Size: domain.EnclosureSize(input.Size),
 		Occupancy: domain.EnclosureOccupancy{
 			Capacity: input.MaxCapacity,
@@ -48,14 +57,66 @@ func APIToNewDomainEnclosure(input v1.EnclosureInput) (*domain.Enclosure, error)
 	}, nil
 }
 
-func DomainEnclosureToAPIList(enclosures []*domain.Enclosure) []v1.Enclosure {
+func remainingSlots(enclosure *domain.Enclosure, catalog domain.SpeciesCatalog) map[string]int {
+	slots := make(map[string]int, len(catalog))
+	for species := range catalog {
+		slots[string(species)] = enclosure.RemainingSlots(species, catalog)
+	}
+
+	return slots
+}
+
+func DomainEnclosureToAPIList(enclosures []*domain.Enclosure, catalog domain.SpeciesCatalog) []v1.Enclosure {
 	if enclosures == nil {
 		return []v1.Enclosure{}
 	}
 
 	result := make([]v1.Enclosure, len(enclosures))
 	for i, enclosure := range enclosures {
-		result[i] = DomainEnclosureToAPI(enclosure)
+		result[i] = DomainEnclosureToAPI(enclosure, catalog)
+	}
+
+	return result
+}
+
+func DomainHabitatToAPI(habitat domain.Habitat) v1.Habitat {
+	result := v1.Habitat{
+		Aquatic:   habitat.Aquatic,
+		Aviary:    habitat.Aviary,
+		Terrarium: habitat.Terrarium,
+	}
+
+	if habitat.Climate != "" {
+		climate := v1.Climate(habitat.Climate)
+		result.Climate = &climate
+	}
+
+	return result
+}
+
+func DomainEnclosureTypesToAPI(types []domain.EnclosureType) []v1.EnclosureTypeInfo {
+	result := make([]v1.EnclosureTypeInfo, len(types))
+	for i, enclosureType := range types {
+		result[i] = v1.EnclosureTypeInfo{
+			Type:    v1.EnclosureType(enclosureType),
+			Habitat: DomainHabitatToAPI(enclosureType.Habitat()),
+		}
+	}
+
+	return result
+}
+
+func DomainEnclosureSuitabilityToAPIList(
+	suitable []domain.EnclosureSuitability,
+	catalog domain.SpeciesCatalog,
+) []v1.SuitableEnclosure {
+	result := make([]v1.SuitableEnclosure, len(suitable))
+	for i, s := range suitable {
+		result[i] = v1.SuitableEnclosure{
+			Enclosure: DomainEnclosureToAPI(s.Enclosure, catalog),
+			Fit:       s.Fit,
+			FreeSpace: s.FreeSpace,
+		}
 	}
 
 	return result
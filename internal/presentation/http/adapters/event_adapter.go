@@ -0,0 +1,91 @@
+package adapters
+
+import (
+	"encoding/json"
+
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+	"github.com/maklybae/ddd-zoo/pkg/events"
+)
+
+func StoredEventToAPI(event *events.StoredEvent) (v1.StoredEvent, error) {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return v1.StoredEvent{}, err
+	}
+
+	aggregateIDs := event.AggregateIDs
+	if aggregateIDs == nil {
+		aggregateIDs = []string{}
+	}
+
+	return v1.StoredEvent{
+		Sequence:     int64(event.Sequence),
+		Type:         event.EventType,
+		AggregateIds: aggregateIDs,
+		Payload:      payload,
+		RecordedAt:   event.RecordedAt,
+	}, nil
+}
+
+func StoredEventToAPIList(storedEvents []*events.StoredEvent) ([]v1.StoredEvent, error) {
+	result := make([]v1.StoredEvent, len(storedEvents))
+
+	for i, event := range storedEvents {
+		apiEvent, err := StoredEventToAPI(event)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = apiEvent
+	}
+
+	return result, nil
+}
+
+func APIToEventQuery(params v1.GetApiV1EventsParams, limit int) events.EventQuery {
+	query := events.EventQuery{Limit: limit}
+
+	if params.Type != nil {
+		query.EventTypes = *params.Type
+	}
+
+	if params.AggregateId != nil {
+		query.AggregateID = *params.AggregateId
+	}
+
+	if params.From != nil {
+		query.From = *params.From
+	}
+
+	if params.To != nil {
+		query.To = *params.To
+	}
+
+	if params.AfterSequence != nil {
+		query.AfterSequence = uint64(*params.AfterSequence)
+	}
+
+	return query
+}
+
+func APIToReplayQuery(input v1.ReplayInput) events.EventQuery {
+	var query events.EventQuery
+
+	if input.Types != nil {
+		query.EventTypes = *input.Types
+	}
+
+	if input.AggregateId != nil {
+		query.AggregateID = *input.AggregateId
+	}
+
+	if input.From != nil {
+		query.From = *input.From
+	}
+
+	if input.To != nil {
+		query.To = *input.To
+	}
+
+	return query
+}
@@ -1,7 +1,7 @@
 package adapters
 
 import (
-	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,11 +9,6 @@ import (
 	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
 )
 
-var (
-	ErrAnimalNotFound          = errors.New("animal not found")
-	ErrFeedingScheduleNotFound = errors.New("feeding schedule not found")
-)
-
 func DomainFeedingScheduleToAPI(schedule *domain.FeedingSchedule) v1.FeedingSchedule {
 	if schedule == nil {
 		return v1.FeedingSchedule{}
@@ -24,18 +19,34 @@ func DomainFeedingScheduleToAPI(schedule *domain.FeedingSchedule) v1.FeedingSche
 		animal = DomainAnimalToAPI(schedule.Animal)
 	}
 
-	return v1.FeedingSchedule{
+	apiSchedule := v1.FeedingSchedule{
 		Id:          schedule.ID.UUID(),
 		Animal:      animal,
 		FeedingTime: time.Time(schedule.Time),
 		FoodType:    string(schedule.Food),
 		Completed:   schedule.Status == domain.FeedingStatusDone,
 	}
+
+	if schedule.Recurrence != nil {
+		recurrence := schedule.Recurrence.String()
+		timeZone := schedule.Recurrence.TimeZone()
+		apiSchedule.Recurrence = &recurrence
+		apiSchedule.TimeZone = &timeZone
+	}
+
+	if !schedule.Portion.IsZero() {
+		portion := DomainFoodQuantityToAPI(schedule.Portion)
+		apiSchedule.Portion = &portion
+	}
+
+	apiSchedule.AssigneeId = DomainAssigneeToAPI(schedule.Assignee)
+
+	return apiSchedule
 }
 
 func APIToNewDomainFeedingSchedule(input v1.FeedingScheduleInput, animal *domain.Animal) (*domain.FeedingSchedule, error) {
 	if animal == nil {
-		return nil, ErrAnimalNotFound
+		return nil, domain.ErrAnimalNotFound
 	}
 
 	id, err := uuid.NewRandom()
@@ -43,13 +54,44 @@ func APIToNewDomainFeedingSchedule(input v1.FeedingScheduleInput, animal *domain
 		return nil, err
 	}
 
-	return &domain.FeedingSchedule{
-		ID:     domain.FeedingScheduleID(id),
-		Animal: animal,
-		Food:   domain.Food(input.FoodType),
-		Time:   domain.FeedingScheduleTime(input.FeedingTime),
-		Status: domain.FeedingStatusNotDone,
-	}, nil
+	schedule := &domain.FeedingSchedule{
+		ID:       domain.FeedingScheduleID(id),
+		Animal:   animal,
+		Food:     domain.Food(input.FoodType),
+		Time:     domain.FeedingScheduleTime(input.FeedingTime),
+		Status:   domain.FeedingStatusNotDone,
+		Assignee: APIAssigneeToDomain(input.AssigneeId),
+	}
+
+	if schedule.Recurrence, err = APIRecurrenceToDomain(input.Recurrence, input.TimeZone); err != nil {
+		return nil, err
+	}
+
+	if input.Portion != nil {
+		if schedule.Portion, err = APIFoodQuantityToDomain(*input.Portion); err != nil {
+			return nil, err
+		}
+	}
+
+	return schedule, nil
+}
+
+// APIRecurrenceToDomain parses the optional rule in the time zone, nil if there is no rule.
+func APIRecurrenceToDomain(rule, timeZone *string) (*domain.Recurrence, error) {
+	if rule == nil {
+		if timeZone != nil {
+			return nil, fmt.Errorf("%w: time zone is set without a rule", domain.ErrInvalidRecurrence)
+		}
+
+		return nil, nil
+	}
+
+	var zone string
+	if timeZone != nil {
+		zone = *timeZone
+	}
+
+	return domain.ParseRecurrence(*rule, zone)
 }
 
 func DomainFeedingScheduleToAPIList(schedules []*domain.FeedingSchedule) []v1.FeedingSchedule {
@@ -64,3 +106,15 @@ func DomainFeedingScheduleToAPIList(schedules []*domain.FeedingSchedule) []v1.Fe
 
 	return result
 }
+
+func DomainFeedingOccurrencesToAPI(occurrences []domain.FeedingOccurrence) []v1.FeedingOccurrence {
+	result := make([]v1.FeedingOccurrence, len(occurrences))
+	for i, occurrence := range occurrences {
+		result[i] = v1.FeedingOccurrence{
+			Time:   occurrence.Time,
+			Status: v1.FeedingOccurrenceStatus(occurrence.Status),
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,90 @@
+package adapters
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+)
+
+var foodForecastCSVHeader = []string{"food", "unit", "feedings", "unportioned_feedings", "required", "in_stock", "shortfall", "error"}
+
+func DomainFoodDemandToAPI(demand domain.FoodDemand) v1.FoodDemand {
+	apiDemand := v1.FoodDemand{
+		Food:                string(demand.Food),
+		Feedings:            demand.Feedings,
+		UnportionedFeedings: demand.UnportionedFeedings,
+		Required:            demand.Required.Amount,
+		Shortfall:           demand.Shortfall().Amount,
+		Covered:             demand.IsCovered(),
+	}
+
+	if demand.Required.Unit != "" {
+		unit := v1.FoodUnit(demand.Required.Unit)
+		apiDemand.Unit = &unit
+	}
+
+	if demand.Stock != nil {
+		apiDemand.InStock = &demand.Stock.Quantity.Amount
+	}
+
+	if demand.Error != "" {
+		apiDemand.Error = &demand.Error
+	}
+
+	return apiDemand
+}
+
+func DomainFoodForecastToAPI(forecast *domain.FoodForecast) v1.FoodForecast {
+	demands := make([]v1.FoodDemand, len(forecast.Demands))
+	for i, demand := range forecast.Demands {
+		demands[i] = DomainFoodDemandToAPI(demand)
+	}
+
+	return v1.FoodForecast{
+		From:    forecast.From,
+		To:      forecast.To,
+		Demands: demands,
+	}
+}
+
+// WriteFoodForecastCSV writes a row per food. The stock of unstocked foods and the error of forecast ones are left empty.
+func WriteFoodForecastCSV(w io.Writer, forecast *domain.FoodForecast) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(foodForecastCSVHeader); err != nil {
+		return err
+	}
+
+	formatAmount := func(amount float64) string {
+		return strconv.FormatFloat(amount, 'f', -1, 64)
+	}
+
+	for _, demand := range forecast.Demands {
+		inStock := ""
+		if demand.Stock != nil {
+			inStock = formatAmount(demand.Stock.Quantity.Amount)
+		}
+
+		record := []string{
+			string(demand.Food),
+			string(demand.Required.Unit),
+			strconv.Itoa(demand.Feedings),
+			strconv.Itoa(demand.UnportionedFeedings),
+			formatAmount(demand.Required.Amount),
+			inStock,
+			formatAmount(demand.Shortfall().Amount),
+			demand.Error,
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,58 @@
+package adapters
+
+import (
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+)
+
+func DomainFoodStockToAPI(stock *domain.FoodStock) v1.FoodStock {
+	return v1.FoodStock{
+		Food:              string(stock.Food),
+		Quantity:          stock.Quantity.Amount,
+		Unit:              v1.FoodUnit(stock.Quantity.Unit),
+		LowStockThreshold: stock.LowStockThreshold,
+		Low:               stock.IsLow(),
+		Short:             stock.Short,
+	}
+}
+
+func DomainFoodStockToAPIList(stocks []*domain.FoodStock) []v1.FoodStock {
+	result := make([]v1.FoodStock, len(stocks))
+	for i, stock := range stocks {
+		result[i] = DomainFoodStockToAPI(stock)
+	}
+
+	return result
+}
+
+// APIToNewDomainFoodStock creates a stock holding the initial quantity, empty if it is not set.
+func APIToNewDomainFoodStock(input v1.FoodStockInput) (*domain.FoodStock, error) {
+	threshold := 0.0
+	if input.LowStockThreshold != nil {
+		threshold = *input.LowStockThreshold
+	}
+
+	stock, err := domain.NewFoodStock(domain.Food(input.Food), domain.FoodUnit(input.Unit), threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Quantity != nil && *input.Quantity != 0 {
+		if err := stock.Deliver(domain.FoodQuantity{Amount: *input.Quantity, Unit: stock.Quantity.Unit}); err != nil {
+			return nil, err
+		}
+	}
+
+	return stock, nil
+}
+
+func DomainFoodQuantityToAPI(quantity domain.FoodQuantity) v1.FoodQuantity {
+	return v1.FoodQuantity{
+		Amount: quantity.Amount,
+		Unit:   v1.FoodUnit(quantity.Unit),
+	}
+}
+
+func APIFoodQuantityToDomain(quantity v1.FoodQuantity) (domain.FoodQuantity, error) {
+	return domain.NewFoodQuantity(quantity.Amount, domain.FoodUnit(quantity.Unit))
+}
@@ -0,0 +1,137 @@
+package adapters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/application/services"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+)
+
+func DomainShiftToAPI(shift *domain.Shift) v1.Shift {
+	days := make([]v1.Weekday, len(shift.Days))
+	for i, day := range shift.Days {
+		days[i] = v1.Weekday(domain.WeekdayCode(day))
+	}
+
+	timeZone := shift.TimeZone()
+
+	return v1.Shift{
+		Name:     string(shift.Name),
+		Start:    domain.FormatTimeOfDay(shift.Start),
+		End:      domain.FormatTimeOfDay(shift.End),
+		Days:     &days,
+		TimeZone: &timeZone,
+	}
+}
+
+func DomainShiftsToAPI(shifts []*domain.Shift) []v1.Shift {
+	result := make([]v1.Shift, len(shifts))
+	for i, shift := range shifts {
+		result[i] = DomainShiftToAPI(shift)
+	}
+
+	return result
+}
+
+func APIShiftToDomain(input v1.Shift) (*domain.Shift, error) {
+	return APIShiftRulesToDomain(domain.ShiftName(input.Name), v1.ShiftRules{
+		Start:    input.Start,
+		End:      input.End,
+		Days:     input.Days,
+		TimeZone: input.TimeZone,
+	})
+}
+
+// APIShiftRulesToDomain applies the defaults of the API: every day in UTC.
+func APIShiftRulesToDomain(name domain.ShiftName, rules v1.ShiftRules) (*domain.Shift, error) {
+	start, err := domain.ParseTimeOfDay(rules.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := domain.ParseTimeOfDay(rules.End)
+	if err != nil {
+		return nil, err
+	}
+
+	var days []time.Weekday
+
+	for _, code := range valueOr(rules.Days, nil) {
+		day, ok := domain.ParseWeekday(string(code))
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", domain.ErrUnknownWeekday, code)
+		}
+
+		days = append(days, day)
+	}
+
+	location, err := domain.LoadLocation(valueOr(rules.TimeZone, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewShift(name, start, end, days, location)
+}
+
+func DomainKeeperToAPI(keeper *domain.Keeper) v1.Keeper {
+	result := v1.Keeper{
+		Id:             keeper.ID.UUID(),
+		Name:           string(keeper.Name),
+		Qualifications: make([]string, len(keeper.Qualifications)),
+		MaxEnclosures:  keeper.MaxEnclosures,
+		Assignments:    make([]v1.KeeperAssignment, len(keeper.Assignments)),
+	}
+
+	for i, species := range keeper.Qualifications {
+		result.Qualifications[i] = string(species)
+	}
+
+	for i, assignment := range keeper.Assignments {
+		result.Assignments[i] = v1.KeeperAssignment{
+			EnclosureId: uuid.UUID(assignment.EnclosureID),
+			Shift:       string(assignment.Shift),
+		}
+	}
+
+	return result
+}
+
+func DomainKeepersToAPI(keepers []*domain.Keeper) []v1.Keeper {
+	result := make([]v1.Keeper, len(keepers))
+	for i, keeper := range keepers {
+		result[i] = DomainKeeperToAPI(keeper)
+	}
+
+	return result
+}
+
+func APIKeeperInputToService(input v1.KeeperInput) services.KeeperInput {
+	result := services.KeeperInput{
+		Name:          domain.KeeperName(input.Name),
+		MaxEnclosures: input.MaxEnclosures,
+	}
+
+	for _, species := range valueOr(input.Qualifications, nil) {
+		result.Qualifications = append(result.Qualifications, domain.AnimalSpecies(species))
+	}
+
+	return result
+}
+
+func DomainKeepersOnDutyToAPI(onDuty []domain.KeeperOnDuty) []v1.KeeperOnDuty {
+	result := make([]v1.KeeperOnDuty, len(onDuty))
+	for i, duty := range onDuty {
+		result[i] = v1.KeeperOnDuty{
+			KeeperId: duty.Keeper.ID.UUID(),
+			Name:     string(duty.Keeper.Name),
+			Shift:    string(duty.Shift.Name),
+			From:     duty.Slot.Start,
+			Until:    duty.Slot.End,
+		}
+	}
+
+	return result
+}
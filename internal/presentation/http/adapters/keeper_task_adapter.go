@@ -0,0 +1,124 @@
+package adapters
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+)
+
+func DomainCareTaskToAPI(task *domain.CareTask) v1.CareTask {
+	apiTask := v1.CareTask{
+		Id:          task.ID.UUID(),
+		Kind:        v1.TaskKind(task.Kind),
+		EnclosureId: task.EnclosureID.UUID(),
+		Time:        task.Time,
+		AssigneeId:  DomainAssigneeToAPI(task.Assignee),
+		Completed:   make([]time.Time, len(task.Completed)),
+	}
+
+	copy(apiTask.Completed, task.Completed)
+
+	if task.Recurrence != nil {
+		recurrence := task.Recurrence.String()
+		timeZone := task.Recurrence.TimeZone()
+		apiTask.Recurrence = &recurrence
+		apiTask.TimeZone = &timeZone
+	}
+
+	return apiTask
+}
+
+func DomainCareTasksToAPI(tasks []*domain.CareTask) []v1.CareTask {
+	result := make([]v1.CareTask, len(tasks))
+	for i, task := range tasks {
+		result[i] = DomainCareTaskToAPI(task)
+	}
+
+	return result
+}
+
+func APIToNewDomainCareTask(input v1.CareTaskInput) (*domain.CareTask, error) {
+	recurrence, err := APIRecurrenceToDomain(input.Recurrence, input.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := domain.NewCareTask(domain.TaskKind(input.Kind), domain.EnclosureID(input.EnclosureId), input.Time, recurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Assignee = APIAssigneeToDomain(input.AssigneeId)
+
+	return task, nil
+}
+
+func DomainKeeperTaskToAPI(task domain.KeeperTask) v1.KeeperTask {
+	apiTask := v1.KeeperTask{
+		Kind:       v1.TaskKind(task.Kind),
+		TaskId:     task.TaskID,
+		Time:       task.Time,
+		Status:     v1.KeeperTaskStatus(task.Status),
+		Assignment: v1.KeeperTaskAssignmentShift,
+	}
+
+	if task.Explicit {
+		apiTask.Assignment = v1.KeeperTaskAssignmentExplicit
+	}
+
+	if task.EnclosureID != nil {
+		enclosureID := task.EnclosureID.UUID()
+		apiTask.EnclosureId = &enclosureID
+	}
+
+	if schedule := task.Schedule; schedule != nil {
+		food := string(schedule.Food)
+		apiTask.FoodType = &food
+
+		if schedule.Animal != nil {
+			animalID := schedule.Animal.ID.UUID()
+			animalName := string(schedule.Animal.Name)
+			apiTask.AnimalId = &animalID
+			apiTask.AnimalName = &animalName
+		}
+
+		if !schedule.Portion.IsZero() {
+			portion := DomainFoodQuantityToAPI(schedule.Portion)
+			apiTask.Portion = &portion
+		}
+	}
+
+	return apiTask
+}
+
+func DomainKeeperTasksToAPI(tasks []domain.KeeperTask) []v1.KeeperTask {
+	result := make([]v1.KeeperTask, len(tasks))
+	for i, task := range tasks {
+		result[i] = DomainKeeperTaskToAPI(task)
+	}
+
+	return result
+}
+
+// APIAssigneeToDomain returns the keeper a task is assigned to, nil if it falls to the keeper on duty.
+func APIAssigneeToDomain(keeperID *uuid.UUID) *domain.KeeperID {
+	if keeperID == nil {
+		return nil
+	}
+
+	assignee := domain.KeeperID(*keeperID)
+
+	return &assignee
+}
+
+func DomainAssigneeToAPI(assignee *domain.KeeperID) *uuid.UUID {
+	if assignee == nil {
+		return nil
+	}
+
+	keeperID := assignee.UUID()
+
+	return &keeperID
+}
@@ -0,0 +1,112 @@
+package adapters
+
+import (
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/application/services"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+)
+
+func DomainIllnessToAPI(illness *domain.Illness) v1.Illness {
+	return v1.Illness{
+		Id:         illness.ID.UUID(),
+		Diagnosis:  string(illness.Diagnosis),
+		Severity:   v1.IllnessSeverity(illness.Severity),
+		ReportedBy: string(illness.ReportedBy),
+		ReportedAt: illness.ReportedAt,
+	}
+}
+
+func DomainDiagnosisToAPI(diagnosis *domain.Diagnosis) v1.Diagnosis {
+	return v1.Diagnosis{
+		Id:          diagnosis.ID.UUID(),
+		Description: string(diagnosis.Description),
+		Vet:         string(diagnosis.Vet),
+		DiagnosedAt: diagnosis.DiagnosedAt,
+	}
+}
+
+func DomainTreatmentToAPI(treatment *domain.Treatment) v1.Treatment {
+	result := v1.Treatment{
+		Id:          treatment.ID.UUID(),
+		Description: string(treatment.Description),
+		Vet:         string(treatment.Vet),
+		Medications: make([]v1.Medication, len(treatment.Medications)),
+		TreatedAt:   treatment.TreatedAt,
+	}
+
+	for i, medication := range treatment.Medications {
+		result.Medications[i] = v1.Medication{Name: medication.Name, Dosage: medication.Dosage}
+	}
+
+	if treatment.DiagnosisID != nil {
+		diagnosisID := treatment.DiagnosisID.UUID()
+		result.DiagnosisId = &diagnosisID
+	}
+
+	return result
+}
+
+func DomainMedicalRecordToAPI(record *domain.MedicalRecord) v1.MedicalRecord {
+	result := v1.MedicalRecord{
+		AnimalId:        record.AnimalID.UUID(),
+		Illnesses:       make([]v1.Illness, len(record.Illnesses)),
+		Diagnoses:       make([]v1.Diagnosis, len(record.Diagnoses)),
+		Treatments:      make([]v1.Treatment, len(record.Treatments)),
+		Administrations: make([]v1.DrugAdministration, len(record.Administrations)),
+	}
+
+	if !record.OpenedAt.IsZero() {
+		openedAt := record.OpenedAt
+		result.OpenedAt = &openedAt
+	}
+
+	for i := range record.Illnesses {
+		result.Illnesses[i] = DomainIllnessToAPI(&record.Illnesses[i])
+	}
+
+	for i := range record.Diagnoses {
+		result.Diagnoses[i] = DomainDiagnosisToAPI(&record.Diagnoses[i])
+	}
+
+	for i := range record.Treatments {
+		result.Treatments[i] = DomainTreatmentToAPI(&record.Treatments[i])
+	}
+
+	for i := range record.Administrations {
+		result.Administrations[i] = DomainDrugAdministrationToAPI(&record.Administrations[i])
+	}
+
+	return result
+}
+
+func APITreatmentInputToDomain(input v1.TreatmentInput) services.TreatmentInput {
+	result := services.TreatmentInput{
+		Description: domain.TreatmentDescription(input.Description),
+		Vet:         domain.VetName(input.Vet),
+	}
+
+	if input.Medications != nil {
+		for _, medication := range *input.Medications {
+			result.Medications = append(result.Medications, domain.Medication{
+				Name:   medication.Name,
+				Dosage: medication.Dosage,
+			})
+		}
+	}
+
+	if input.DiagnosisId != nil {
+		diagnosisID := domain.DiagnosisID(uuid.UUID(*input.DiagnosisId))
+		result.DiagnosisID = &diagnosisID
+	}
+
+	return result
+}
+
+func APIIllnessInputToDomain(input v1.IllnessInput) services.IllnessReport {
+	return services.IllnessReport{
+		Diagnosis:  domain.DiagnosisDescription(input.Diagnosis),
+		Severity:   domain.IllnessSeverity(input.Severity),
+		ReportedBy: domain.ReporterName(input.ReportedBy),
+	}
+}
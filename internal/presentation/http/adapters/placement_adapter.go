@@ -0,0 +1,152 @@
+package adapters
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/application/services"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+)
+
+func APIPlacementRequestToDomain(input v1.PlacementRequest) (services.PlacementRequest, error) {
+	var request services.PlacementRequest
+
+	if input.AnimalIds != nil {
+		for _, id := range *input.AnimalIds {
+			request.AnimalIDs = append(request.AnimalIDs, domain.AnimalID(id))
+		}
+	}
+
+	if input.Arrivals != nil {
+		for _, arrival := range *input.Arrivals {
+			animal, err := APIArrivingAnimalToDomain(arrival, uuid.Nil)
+			if err != nil {
+				return services.PlacementRequest{}, err
+			}
+
+			request.Arrivals = append(request.Arrivals, animal)
+		}
+	}
+
+	return request, nil
+}
+
+// APIArrivingAnimalToDomain creates the arriving animal with the given ID, or a random one if it is nil.
+func APIArrivingAnimalToDomain(input v1.ArrivingAnimal, id uuid.UUID) (*domain.Animal, error) {
+	animal, err := APIToNewDomainAnimal(v1.AnimalInput{
+		Species:      input.Species,
+		Name:         input.Name,
+		BirthDate:    input.BirthDate,
+		Gender:       v1.AnimalInputGender(input.Gender),
+		FavoriteFood: input.FavoriteFood,
+		Status:       v1.AnimalInputStatus(input.Status),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if id != uuid.Nil {
+		animal.ID = domain.AnimalID(id)
+	}
+
+	return animal, nil
+}
+
+func DomainPlacementPlanToAPI(plan *domain.PlacementPlan) v1.PlacementPlan {
+	placements := make([]v1.PlacementAssignment, len(plan.Placements))
+	for i, placement := range plan.Placements {
+		placements[i] = DomainPlacementToAPI(placement)
+	}
+
+	unplaced := make([]v1.UnplacedAnimal, len(plan.Unplaced))
+	for i, u := range plan.Unplaced {
+		unplaced[i] = v1.UnplacedAnimal{
+			AnimalId: u.Animal.ID.UUID(),
+			Reason:   errorCode(u.Reason),
+		}
+
+		if u.New {
+			unplaced[i].Arrival = domainAnimalToArriving(u.Animal)
+		}
+	}
+
+	return v1.PlacementPlan{
+		Placements: placements,
+		Unplaced:   unplaced,
+		Moves:      plan.Moves(),
+	}
+}
+
+func DomainPlacementToAPI(placement domain.Placement) v1.PlacementAssignment {
+	action := v1.Move
+
+	switch {
+	case placement.New:
+		action = v1.Arrive
+	case placement.Stays():
+		action = v1.Stay
+	}
+
+	assignment := v1.PlacementAssignment{
+		AnimalId:      placement.Animal.ID.UUID(),
+		Action:        &action,
+		ToEnclosureId: placement.To.UUID(),
+		Fit:           &placement.Fit,
+	}
+
+	if placement.From != nil {
+		from := placement.From.UUID()
+		assignment.FromEnclosureId = &from
+	}
+
+	if placement.New {
+		assignment.Arrival = domainAnimalToArriving(placement.Animal)
+	}
+
+	return assignment
+}
+
+func APIPlacementExecutionToDomain(input v1.PlacementExecutionInput) ([]services.PlacementStep, error) {
+	steps := make([]services.PlacementStep, len(input.Placements))
+
+	for i, placement := range input.Placements {
+		steps[i] = services.PlacementStep{
+			AnimalID:      domain.AnimalID(placement.AnimalId),
+			ToEnclosureID: domain.EnclosureID(placement.ToEnclosureId),
+		}
+
+		if placement.Arrival != nil {
+			animal, err := APIArrivingAnimalToDomain(*placement.Arrival, placement.AnimalId)
+			if err != nil {
+				return nil, err
+			}
+
+			steps[i].Arrival = animal
+		}
+	}
+
+	return steps, nil
+}
+
+func domainAnimalToArriving(animal *domain.Animal) *v1.ArrivingAnimal {
+	api := DomainAnimalToAPI(animal)
+
+	return &v1.ArrivingAnimal{
+		Species:      api.Species,
+		Name:         api.Name,
+		BirthDate:    api.BirthDate,
+		Gender:       v1.ArrivingAnimalGender(api.Gender),
+		FavoriteFood: api.FavoriteFood,
+		Status:       v1.ArrivingAnimalStatus(api.Status),
+	}
+}
+
+func errorCode(err error) string {
+	var domainErr *domain.Error
+	if errors.As(err, &domainErr) {
+		return domainErr.Code
+	}
+
+	return "internal_error"
+}
@@ -0,0 +1,79 @@
+package adapters
+
+import (
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/application/services"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+)
+
+func DomainQuarantineToAPI(quarantine *domain.Quarantine) v1.Quarantine {
+	result := v1.Quarantine{
+		Id:          quarantine.ID.UUID(),
+		AnimalId:    quarantine.AnimalID.UUID(),
+		EnclosureId: quarantine.EnclosureID.UUID(),
+		Reason:      string(quarantine.Reason),
+		OrderedBy:   string(quarantine.OrderedBy),
+		StartedAt:   quarantine.StartedAt,
+		EndedAt:     quarantine.EndedAt,
+		Active:      quarantine.IsActive(),
+	}
+
+	if quarantine.FromEnclosureID != domain.EnclosureID(uuid.Nil) {
+		fromEnclosureID := quarantine.FromEnclosureID.UUID()
+		result.FromEnclosureId = &fromEnclosureID
+	}
+
+	if quarantine.ClearedBy != "" {
+		clearedBy := string(quarantine.ClearedBy)
+		result.ClearedBy = &clearedBy
+	}
+
+	return result
+}
+
+func DomainQuarantineToAPIList(quarantines []*domain.Quarantine) []v1.Quarantine {
+	result := make([]v1.Quarantine, len(quarantines))
+	for i, quarantine := range quarantines {
+		result[i] = DomainQuarantineToAPI(quarantine)
+	}
+
+	return result
+}
+
+func APIQuarantineInputToDomain(input v1.QuarantineInput) services.QuarantineInput {
+	result := services.QuarantineInput{
+		Reason:    domain.QuarantineReason(input.Reason),
+		OrderedBy: domain.VetName(input.OrderedBy),
+	}
+
+	if input.EnclosureId != nil {
+		enclosureID := domain.EnclosureID(*input.EnclosureId)
+		result.EnclosureID = &enclosureID
+	}
+
+	return result
+}
+
+func APIQuarantineClearanceToDomain(input v1.QuarantineClearanceInput) (services.QuarantineClearance, error) {
+	result := services.QuarantineClearance{
+		ClearedBy: domain.VetName(input.ClearedBy),
+		Status:    domain.AnimalStatusHealthy,
+	}
+
+	if input.Status != nil {
+		status, err := domain.ParseAnimalStatus(string(*input.Status))
+		if err != nil {
+			return services.QuarantineClearance{}, err
+		}
+
+		result.Status = status
+	}
+
+	if input.ToEnclosureId != nil {
+		enclosureID := domain.EnclosureID(*input.ToEnclosureId)
+		result.ToEnclosureID = &enclosureID
+	}
+
+	return result, nil
+}
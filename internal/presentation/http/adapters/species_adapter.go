@@ -0,0 +1,97 @@
+package adapters
+
+import (
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+)
+
+func DomainSpeciesToAPI(species *domain.Species) v1.Species {
+	minArea := int(species.MinArea)
+
+	return v1.Species{
+		Name:            string(species.Name),
+		Predator:        &species.Predator,
+		Solitary:        &species.Solitary,
+		MaxPerEnclosure: &species.MaxPerEnclosure,
+		MixedGenders:    &species.MixedGenders,
+		Habitat:         DomainHabitatRequirementsToAPI(species.Habitat),
+		MinArea:         &minArea,
+		WeightNorms:     DomainWeightNormsToAPI(species.WeightNorms),
+	}
+}
+
+func DomainHabitatRequirementsToAPI(requirements domain.HabitatRequirements) *v1.HabitatRequirements {
+	climates := make([]v1.Climate, len(requirements.Climates))
+	for i, climate := range requirements.Climates {
+		climates[i] = v1.Climate(climate)
+	}
+
+	return &v1.HabitatRequirements{
+		Aquatic:   &requirements.Aquatic,
+		Aviary:    &requirements.Aviary,
+		Terrarium: &requirements.Terrarium,
+		Climates:  &climates,
+	}
+}
+
+func DomainSpeciesToAPIList(species []*domain.Species) []v1.Species {
+	result := make([]v1.Species, len(species))
+	for i, s := range species {
+		result[i] = DomainSpeciesToAPI(s)
+	}
+
+	return result
+}
+
+func APISpeciesToDomain(input v1.Species) (*domain.Species, error) {
+	return APISpeciesRulesToDomain(domain.AnimalSpecies(input.Name), v1.SpeciesRules{
+		Predator:        input.Predator,
+		Solitary:        input.Solitary,
+		MaxPerEnclosure: input.MaxPerEnclosure,
+		MixedGenders:    input.MixedGenders,
+		Habitat:         input.Habitat,
+		MinArea:         input.MinArea,
+		WeightNorms:     input.WeightNorms,
+	})
+}
+
+// APISpeciesRulesToDomain applies the defaults of the API: no restrictions, mixed genders allowed,
+// a land habitat of any climate and no area requirement.
+func APISpeciesRulesToDomain(name domain.AnimalSpecies, rules v1.SpeciesRules) (*domain.Species, error) {
+	return domain.NewSpecies(
+		name,
+		valueOr(rules.Predator, false),
+		valueOr(rules.Solitary, false),
+		valueOr(rules.MaxPerEnclosure, 0),
+		valueOr(rules.MixedGenders, true),
+		APIHabitatRequirementsToDomain(valueOr(rules.Habitat, v1.HabitatRequirements{})),
+		domain.EnclosureSize(valueOr(rules.MinArea, 0)),
+		APIWeightNormsToDomain(valueOr(rules.WeightNorms, v1.WeightNorms{})),
+	)
+}
+
+func APIHabitatRequirementsToDomain(requirements v1.HabitatRequirements) domain.HabitatRequirements {
+	var climates []domain.Climate
+
+	if requirements.Climates != nil {
+		climates = make([]domain.Climate, len(*requirements.Climates))
+		for i, climate := range *requirements.Climates {
+			climates[i] = domain.Climate(climate)
+		}
+	}
+
+	return domain.HabitatRequirements{
+		Aquatic:   valueOr(requirements.Aquatic, false),
+		Aviary:    valueOr(requirements.Aviary, false),
+		Terrarium: valueOr(requirements.Terrarium, false),
+		Climates:  climates,
+	}
+}
+
+func valueOr[T any](value *T, fallback T) T {
+	if value == nil {
+		return fallback
+	}
+
+	return *value
+}
@@ -0,0 +1,42 @@
+package adapters
+
+import (
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/application/services"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+)
+
+func APITransferBatchToDomain(input v1.TransferBatchInput) []services.TransferRequest {
+	requests := make([]services.TransferRequest, len(input.Transfers))
+
+	for i, transfer := range input.Transfers {
+		requests[i] = services.TransferRequest{
+			AnimalID:      domain.AnimalID(transfer.AnimalId),
+			ToEnclosureID: domain.EnclosureID(transfer.ToEnclosureId),
+		}
+	}
+
+	return requests
+}
+
+func DomainTransferBatchToAPI(batch *domain.TransferBatch) v1.TransferBatch {
+	transfers := make([]v1.TransferRecord, len(batch.Transfers))
+
+	for i, record := range batch.Transfers {
+		transfers[i] = v1.TransferRecord{
+			AnimalId:      record.AnimalID.UUID(),
+			ToEnclosureId: record.ToEnclosureID.UUID(),
+		}
+
+		if from := record.FromEnclosureID.UUID(); from != uuid.Nil {
+			transfers[i].FromEnclosureId = &from
+		}
+	}
+
+	return v1.TransferBatch{
+		Id:            batch.ID.UUID(),
+		Transfers:     transfers,
+		TransferredAt: batch.TransferredAt,
+	}
+}
@@ -0,0 +1,76 @@
+package adapters
+
+import (
+	"github.com/maklybae/ddd-zoo/internal/application/services"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func DomainVetAppointmentToAPI(appointment *domain.VetAppointment) v1.VetAppointment {
+	result := v1.VetAppointment{
+		Id:         appointment.ID.UUID(),
+		AnimalId:   appointment.AnimalID.UUID(),
+		Vet:        string(appointment.Vet),
+		Start:      appointment.Slot.Start,
+		End:        appointment.Slot.End,
+		Procedure:  string(appointment.Procedure),
+		Anesthesia: appointment.Anesthesia,
+		Status:     v1.VetAppointmentStatus(appointment.Status),
+	}
+
+	if appointment.Outcome != "" {
+		outcome := appointment.Outcome
+		result.Outcome = &outcome
+	}
+
+	return result
+}
+
+func DomainVetAppointmentToAPIList(appointments []*domain.VetAppointment) []v1.VetAppointment {
+	result := make([]v1.VetAppointment, len(appointments))
+	for i, appointment := range appointments {
+		result[i] = DomainVetAppointmentToAPI(appointment)
+	}
+
+	return result
+}
+
+func APIVetAppointmentRescheduleToDomain(input v1.VetAppointmentRescheduleInput) services.AppointmentInput {
+	result := services.AppointmentInput{
+		Vet:       domain.VetName(input.Vet),
+		Slot:      domain.TimeSlot{Start: input.Start, End: input.End},
+		Procedure: domain.TreatmentDescription(input.Procedure),
+	}
+
+	if input.Anesthesia != nil {
+		result.Anesthesia = *input.Anesthesia
+	}
+
+	return result
+}
+
+func APIVetAppointmentInputToDomain(input v1.VetAppointmentInput) (domain.AnimalID, services.AppointmentInput) {
+	return domain.AnimalID(input.AnimalId), APIVetAppointmentRescheduleToDomain(v1.VetAppointmentRescheduleInput{
+		Vet:        input.Vet,
+		Start:      input.Start,
+		End:        input.End,
+		Procedure:  input.Procedure,
+		Anesthesia: input.Anesthesia,
+	})
+}
+
+func DomainVetCalendarToAPI(calendar []domain.VetCalendarDay, timeZone string) v1.VetCalendar {
+	days := make([]v1.VetCalendarDay, len(calendar))
+	for i, day := range calendar {
+		days[i] = v1.VetCalendarDay{
+			Date:         openapi_types.Date{Time: day.Date},
+			Appointments: DomainVetAppointmentToAPIList(day.Appointments),
+		}
+	}
+
+	return v1.VetCalendar{
+		TimeZone: timeZone,
+		Days:     days,
+	}
+}
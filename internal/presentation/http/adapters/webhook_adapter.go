@@ -0,0 +1,90 @@
+package adapters
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+)
+
+const webhookSecretBytes = 32
+
+// DomainWebhookToAPI converts a webhook without exposing its secret.
+func DomainWebhookToAPI(webhook *domain.WebhookSubscription) v1.Webhook {
+	if webhook == nil {
+		return v1.Webhook{}
+	}
+
+	return v1.Webhook{
+		Id:        webhook.ID.UUID(),
+		Url:       webhook.URL,
+		Events:    append([]string{}, webhook.Events...),
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+func DomainWebhookToAPIList(webhooks []*domain.WebhookSubscription) []v1.Webhook {
+	result := make([]v1.Webhook, len(webhooks))
+	for i, webhook := range webhooks {
+		result[i] = DomainWebhookToAPI(webhook)
+	}
+
+	return result
+}
+
+// APIToNewDomainWebhook creates a webhook with a freshly generated secret.
+func APIToNewDomainWebhook(input v1.WebhookInput, now time.Time) (*domain.WebhookSubscription, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	secret := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	return domain.NewWebhookSubscription(
+		domain.WebhookID(id),
+		input.Url,
+		input.Events,
+		domain.WebhookSecret(hex.EncodeToString(secret)),
+		now,
+	)
+}
+
+func DomainDeliveryAttemptToAPI(attempt *domain.WebhookDeliveryAttempt) v1.WebhookDeliveryAttempt {
+	result := v1.WebhookDeliveryAttempt{
+		Id:          attempt.ID,
+		DeliveryId:  attempt.DeliveryID.UUID(),
+		Event:       attempt.EventType,
+		Attempt:     attempt.Attempt,
+		Succeeded:   attempt.Succeeded,
+		AttemptedAt: attempt.AttemptedAt,
+		DurationMs:  attempt.Duration.Milliseconds(),
+	}
+
+	if attempt.StatusCode != 0 {
+		statusCode := attempt.StatusCode
+		result.StatusCode = &statusCode
+	}
+
+	if attempt.Error != "" {
+		errorMessage := attempt.Error
+		result.Error = &errorMessage
+	}
+
+	return result
+}
+
+func DomainDeliveryAttemptToAPIList(attempts []*domain.WebhookDeliveryAttempt) []v1.WebhookDeliveryAttempt {
+	result := make([]v1.WebhookDeliveryAttempt, len(attempts))
+	for i, attempt := range attempts {
+		result[i] = DomainDeliveryAttemptToAPI(attempt)
+	}
+
+	return result
+}
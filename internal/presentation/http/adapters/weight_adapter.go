@@ -0,0 +1,94 @@
+package adapters
+
+import (
+	"time"
+
+	"github.com/maklybae/ddd-zoo/internal/application/services"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+)
+
+const day = 24 * time.Hour
+
+func APIWeightMeasurementInputToService(input v1.WeightMeasurementInput) services.WeightInput {
+	return services.WeightInput{
+		Weight:             input.Weight,
+		BodyConditionScore: domain.BodyConditionScore(valueOr(input.BodyConditionScore, 0)),
+		MeasuredBy:         domain.ReporterName(valueOr(input.MeasuredBy, "")),
+		MeasuredAt:         input.MeasuredAt,
+	}
+}
+
+func DomainWeightMeasurementToAPI(measurement domain.WeightMeasurement) v1.WeightMeasurement {
+	result := v1.WeightMeasurement{
+		Weight:     measurement.Weight,
+		MeasuredAt: measurement.MeasuredAt,
+	}
+
+	if measurement.BodyConditionScore != 0 {
+		score := int(measurement.BodyConditionScore)
+		result.BodyConditionScore = &score
+	}
+
+	if measurement.MeasuredBy != "" {
+		measuredBy := string(measurement.MeasuredBy)
+		result.MeasuredBy = &measuredBy
+	}
+
+	return result
+}
+
+func DomainWeightMeasurementsToAPI(measurements []domain.WeightMeasurement) []v1.WeightMeasurement {
+	result := make([]v1.WeightMeasurement, len(measurements))
+	for i, measurement := range measurements {
+		result[i] = DomainWeightMeasurementToAPI(measurement)
+	}
+
+	return result
+}
+
+func DomainWeightTrendToAPI(trend *domain.WeightTrend) v1.WeightTrend {
+	result := v1.WeightTrend{
+		WindowDays:    float64(trend.Window) / float64(day),
+		Change:        trend.Change,
+		ChangePercent: trend.ChangePercent,
+		Anomalies:     make([]v1.WeightAnomaly, len(trend.Anomalies)),
+		Abnormal:      trend.IsAbnormal(),
+	}
+
+	for i, anomaly := range trend.Anomalies {
+		result.Anomalies[i] = v1.WeightAnomaly(anomaly)
+	}
+
+	if trend.Latest != nil {
+		latest := DomainWeightMeasurementToAPI(*trend.Latest)
+		result.Latest = &latest
+	}
+
+	if trend.Baseline != nil {
+		baseline := DomainWeightMeasurementToAPI(*trend.Baseline)
+		result.Baseline = &baseline
+	}
+
+	return result
+}
+
+func DomainWeightNormsToAPI(norms domain.WeightNorms) *v1.WeightNorms {
+	windowDays := float64(norms.Window) / float64(day)
+
+	return &v1.WeightNorms{
+		MinWeight:        &norms.MinWeight,
+		MaxWeight:        &norms.MaxWeight,
+		MaxChangePercent: &norms.MaxChangePercent,
+		WindowDays:       &windowDays,
+	}
+}
+
+func APIWeightNormsToDomain(norms v1.WeightNorms) domain.WeightNorms {
+	return domain.WeightNorms{
+		MinWeight:        valueOr(norms.MinWeight, 0),
+		MaxWeight:        valueOr(norms.MaxWeight, 0),
+		MaxChangePercent: valueOr(norms.MaxChangePercent, 0),
+		Window:           time.Duration(valueOr(norms.WindowDays, 0) * float64(day)),
+	}
+}
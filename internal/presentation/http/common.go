@@ -1,18 +1,122 @@
 package http
 
 import (
+	"errors"
+	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/maklybae/ddd-zoo/internal/domain"
 	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+	"github.com/maklybae/ddd-zoo/pkg/events"
 )
 
+const (
+	problemContentType = "application/problem+json"
+	problemTypePrefix  = "/problems/"
+)
+
+// errInternal replaces unexpected errors in responses, as their messages may reveal internals
+// such as SQL statements or file paths.
+var errInternal = errors.New("internal server error")
+
+// SendBadRequestResponse reports malformed client input.
 func (s *Server) SendBadRequestResponse(c *gin.Context, err error, details map[string]interface{}) {
-	response := v1.ApiErrorResponse{
-		Details:   &details,
-		Error:     err.Error(),
-		Message:   "Something went wrong",
+	s.sendProblem(c, http.StatusBadRequest, "bad_request", err, details)
+}
+
+// SendErrorResponse reports an error returned by the domain, repositories or services.
+// The status code is chosen by the domain error kind: not found, conflict or invariant violation.
+// Event infrastructure errors, such as a missing dead letter or handler, are mapped as well.
+// Domain errors carrying data, such as the conflicting animals of a placement, fill in the details.
+// Other errors are logged and reported as internal without their message.
+func (s *Server) SendErrorResponse(c *gin.Context, err error, details map[string]interface{}) {
+	status, code := classifyError(err)
+
+	if status == http.StatusInternalServerError {
+		log.Printf("Internal error on %s %s: %v", c.Request.Method, c.Request.URL.Path, err)
+
+		s.sendProblem(c, status, code, errInternal, nil)
+
+		return
+	}
+
+	if details == nil {
+		details = errorDetails(err)
+	}
+
+	s.sendProblem(c, status, code, err, details)
+}
+
+// SendParameterErrorResponse reports invalid path or query parameters rejected by the generated router.
+func (s *Server) SendParameterErrorResponse(c *gin.Context, err error, statusCode int) {
+	s.sendProblem(c, statusCode, "invalid_parameter", err, nil)
+}
+
+func (s *Server) sendProblem(c *gin.Context, status int, code string, err error, details map[string]interface{}) {
+	detail := err.Error()
+	instance := c.Request.URL.Path
+
+	problem := v1.Problem{
+		Type:      problemTypePrefix + code,
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    &detail,
+		Instance:  &instance,
+		Code:      code,
 		Timestamp: s.timeProvider.Now(),
 	}
-	c.JSON(http.StatusBadRequest, response)
+
+	if len(details) > 0 {
+		problem.Details = &details
+	}
+
+	c.Header("Content-Type", problemContentType)
+	c.JSON(status, problem)
+}
+
+func errorDetails(err error) map[string]interface{} {
+	var incompatibleErr *domain.IncompatibleSpeciesError
+	if errors.As(err, &incompatibleErr) {
+		return map[string]interface{}{
+			"animalId":           incompatibleErr.AnimalID,
+			"species":            incompatibleErr.Species,
+			"rule":               incompatibleErr.Rule,
+			"conflictingAnimals": incompatibleErr.ConflictingAnimals,
+		}
+	}
+
+	return nil
+}
+
+func classifyError(err error) (status int, code string) {
+	var domainErr *domain.Error
+	if errors.As(err, &domainErr) {
+		code = domainErr.Code
+	}
+
+	var fallbackCode string
+
+	switch {
+	case errors.Is(err, events.ErrDeadLetterNotFound):
+		status, fallbackCode = http.StatusNotFound, "dead_letter_not_found"
+	case errors.Is(err, events.ErrHandlerNotFound):
+		status, fallbackCode = http.StatusNotFound, "handler_not_found"
+	case errors.Is(err, events.ErrHandlerNotReplayable):
+		status, fallbackCode = http.StatusConflict, "handler_not_replayable"
+	case errors.Is(err, domain.ErrNotFound):
+		status, fallbackCode = http.StatusNotFound, "not_found"
+	case errors.Is(err, domain.ErrConflict):
+		status, fallbackCode = http.StatusConflict, "conflict"
+	case errors.Is(err, domain.ErrInvariantViolation):
+		status, fallbackCode = http.StatusUnprocessableEntity, "invariant_violation"
+	default:
+		return http.StatusInternalServerError, "internal_error"
+	}
+
+	if code == "" {
+		code = fallbackCode
+	}
+
+	return status, code
 }
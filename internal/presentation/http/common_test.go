@@ -0,0 +1,78 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maklybae/ddd-zoo/internal/application/services"
+	"github.com/maklybae/ddd-zoo/internal/domain"
+	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendErrorResponse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	server := &Server{timeProvider: services.NewRealTimeProvider()}
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+		detail string
+	}{
+		{
+			name:   "not found",
+			err:    fmt.Errorf("getting animal: %w", domain.ErrAnimalNotFound),
+			status: http.StatusNotFound,
+			code:   "animal_not_found",
+			detail: "getting animal: animal not found",
+		},
+		{
+			name:   "conflict",
+			err:    domain.ErrAnimalQuarantined,
+			status: http.StatusConflict,
+			code:   "animal_quarantined",
+			detail: domain.ErrAnimalQuarantined.Error(),
+		},
+		{
+			name:   "invariant",
+			err:    domain.ErrIllnessNotReported,
+			status: http.StatusUnprocessableEntity,
+			code:   "illness_not_reported",
+			detail: domain.ErrIllnessNotReported.Error(),
+		},
+		{
+			name:   "unexpected error is not echoed",
+			err:    errors.New("querying animals: no such table: animals"),
+			status: http.StatusInternalServerError,
+			code:   "internal_error",
+			detail: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/animals", nil)
+
+			server.SendErrorResponse(c, tt.err, nil)
+
+			var problem v1.Problem
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+
+			assert.Equal(t, tt.status, recorder.Code)
+			assert.Equal(t, tt.code, problem.Code)
+			require.NotNil(t, problem.Detail)
+			assert.Equal(t, tt.detail, *problem.Detail)
+		})
+	}
+}
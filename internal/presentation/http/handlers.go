@@ -1,7 +1,13 @@
 package http
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -14,9 +20,17 @@ import (
 // Get all animals
 // (GET /api/v1/animals)
 func (server *Server) GetApiV1Animals(c *gin.Context) {
-	animals, err := server.animalRepo.GetAllAnimals(c.Request.Context())
+	var animals []*domain.Animal
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		animals, err = repos.Animals().GetAllAnimals(ctx)
+
+		return err
+	})
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
@@ -41,22 +55,47 @@ func (server *Server) PostApiV1Animals(c *gin.Context) {
 	// Convert API input to domain animal
 	animal, err := adapters.APIToNewDomainAnimal(input)
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	enclosure, err := server.enclosureRepo.GetEnclosure(c.Request.Context(), domain.EnclosureID(input.EnclosureId))
-	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
-		return
-	}
+	// Create a new animal and place it into its enclosure
+	err = server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		enclosure, err := repos.Enclosures().GetEnclosure(ctx, domain.EnclosureID(input.EnclosureId))
+		if err != nil {
+			return err
+		}
+
+		catalog, err := domain.LoadSpeciesCatalog(ctx, repos.Species())
+		if err != nil {
+			return err
+		}
+
+		keepers, err := repos.Keepers().GetAllKeepers(ctx)
+		if err != nil {
+			return err
+		}
 
-	animal.Enclosure = enclosure
+		if err := domain.CheckKeepersQualified(animal, enclosure.ID, keepers, catalog); err != nil {
+			return err
+		}
 
-	// Create a new animal
-	err = server.animalRepo.AddAnimal(c.Request.Context(), animal)
+		if err := enclosure.AddAnimal(animal, catalog); err != nil {
+			return err
+		}
+
+		if err := animal.MoveToEnclosure(enclosure); err != nil {
+			return err
+		}
+
+		if err := repos.Animals().AddAnimal(ctx, animal); err != nil {
+			return err
+		}
+
+		return repos.Enclosures().UpdateEnclosure(ctx, enclosure)
+	})
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
@@ -65,11 +104,6 @@ func (server *Server) PostApiV1Animals(c *gin.Context) {
 		gender = v1.AnimalGenderMale
 	}
 
-	var status v1.AnimalStatus = v1.AnimalStatusHealthy
-	if animal.Status == domain.AnimalStatusSick {
-		status = v1.AnimalStatusSick
-	}
-
 	c.JSON(http.StatusCreated, v1.Animal{
 		Id:           animal.ID.UUID(),
 		EnclosureId:  animal.Enclosure.ID.UUID(),
@@ -78,7 +112,7 @@ func (server *Server) PostApiV1Animals(c *gin.Context) {
 		Species:      string(animal.Species),
 		Name:         string(animal.Name),
 		BirthDate:    time.Time(animal.BirthDate),
-		Status:       status,
+		Status:       adapters.DomainAnimalStatusToAPI(animal.Status),
 	})
 }
 
@@ -87,8 +121,9 @@ func (server *Server) PostApiV1Animals(c *gin.Context) {
 func (server *Server) DeleteApiV1AnimalsAnimalId(c *gin.Context, animalId openapi_types.UUID) {
 	animalIdDomain := domain.AnimalID(animalId)
 
-	if err := server.animalRepo.DeleteAnimal(c.Request.Context(), animalIdDomain); err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+	err := server.transferSvc.RemoveAnimal(c.Request.Context(), animalIdDomain)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
@@ -100,9 +135,17 @@ func (server *Server) DeleteApiV1AnimalsAnimalId(c *gin.Context, animalId openap
 func (server *Server) GetApiV1AnimalsAnimalId(c *gin.Context, animalId openapi_types.UUID) {
 	animalIdDomain := domain.AnimalID(animalId)
 
-	animal, err := server.animalRepo.GetAnimal(c.Request.Context(), animalIdDomain)
+	var animal *domain.Animal
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		animal, err = repos.Animals().GetAnimal(ctx, animalIdDomain)
+
+		return err
+	})
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
@@ -129,14 +172,22 @@ func (server *Server) PostApiV1AnimalsAnimalIdMove(c *gin.Context, animalId open
 	// Transfer the animal
 	err := server.transferSvc.TransferAnimal(c.Request.Context(), animalIdDomain, newEnclosureId)
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
 	// Get the updated animal
-	animal, err := server.animalRepo.GetAnimal(c.Request.Context(), animalIdDomain)
+	var animal *domain.Animal
+
+	err = server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		animal, err = repos.Animals().GetAnimal(ctx, animalIdDomain)
+
+		return err
+	})
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
@@ -150,22 +201,17 @@ func (server *Server) PostApiV1AnimalsAnimalIdMove(c *gin.Context, animalId open
 func (server *Server) PostApiV1AnimalsAnimalIdTreat(c *gin.Context, animalId openapi_types.UUID) {
 	animalIdDomain := domain.AnimalID(animalId)
 
-	// Get the animal
-	animal, err := server.animalRepo.GetAnimal(c.Request.Context(), animalIdDomain)
-	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
-		return
-	}
-
-	if err = animal.Treat(); err != nil {
+	// Parse the request body
+	var input v1.TreatmentInput
+	if err := c.ShouldBindJSON(&input); err != nil {
 		server.SendBadRequestResponse(c, err, nil)
 		return
 	}
 
-	// Save the updated animal
-	err = server.animalRepo.UpdateAnimal(c.Request.Context(), animal)
+	// Treat the animal and add the treatment to its medical record
+	animal, _, err := server.medicalCareSvc.TreatAnimal(c.Request.Context(), animalIdDomain, adapters.APITreatmentInputToDomain(input))
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
@@ -174,279 +220,2270 @@ func (server *Server) PostApiV1AnimalsAnimalIdTreat(c *gin.Context, animalId ope
 	c.JSON(http.StatusOK, apiAnimal)
 }
 
-// Get all enclosures
-// (GET /api/v1/enclosures)
-func (server *Server) GetApiV1Enclosures(c *gin.Context) {
-	enclosures, err := server.enclosureRepo.GetAllEnclosures(c.Request.Context())
-	if err != nil {
+// Report an illness
+// (POST /api/v1/animals/{animalId}/illnesses)
+func (server *Server) PostApiV1AnimalsAnimalIdIllnesses(c *gin.Context, animalId openapi_types.UUID) {
+	animalIdDomain := domain.AnimalID(animalId)
+
+	// Parse the request body
+	var input v1.IllnessInput
+	if err := c.ShouldBindJSON(&input); err != nil {
 		server.SendBadRequestResponse(c, err, nil)
 		return
 	}
 
-	// Convert domain enclosures to API enclosures
-	apiEnclosures := adapters.DomainEnclosureToAPIList(enclosures)
+	animal, illness, err := server.medicalCareSvc.ReportIllness(c.Request.Context(), animalIdDomain, adapters.APIIllnessInputToDomain(input))
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
 
-	c.JSON(http.StatusOK, v1.EnclosureListResponse{
-		Enclosures: apiEnclosures,
+	c.JSON(http.StatusCreated, v1.IllnessReportResult{
+		Animal:  adapters.DomainAnimalToAPI(animal),
+		Illness: adapters.DomainIllnessToAPI(illness),
 	})
 }
 
-// Add a new enclosure
-// (POST /api/v1/enclosures)
-func (server *Server) PostApiV1Enclosures(c *gin.Context) {
+// Change the health status of an animal
+// (POST /api/v1/animals/{animalId}/status)
+func (server *Server) PostApiV1AnimalsAnimalIdStatus(c *gin.Context, animalId openapi_types.UUID) {
+	animalIdDomain := domain.AnimalID(animalId)
+
 	// Parse the request body
-	var input v1.EnclosureInput
+	var input v1.AnimalStatusInput
 	if err := c.ShouldBindJSON(&input); err != nil {
 		server.SendBadRequestResponse(c, err, nil)
 		return
 	}
 
-	// Create a new enclosure
-	enclosure, err := adapters.APIToNewDomainEnclosure(input)
+	status, err := adapters.APIAnimalStatusToDomain(input.Status)
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	// Save the enclosure
-	err = server.enclosureRepo.AddEnclosure(c.Request.Context(), enclosure)
+	animal, err := server.medicalCareSvc.ChangeAnimalStatus(c.Request.Context(), animalIdDomain, status)
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	// Return the created enclosure
-	apiEnclosure := adapters.DomainEnclosureToAPI(enclosure)
-	c.JSON(http.StatusCreated, apiEnclosure)
+	c.JSON(http.StatusOK, adapters.DomainAnimalToAPI(animal))
 }
 
-// Delete an enclosure
-// (DELETE /api/v1/enclosures/{enclosureId})
-func (server *Server) DeleteApiV1EnclosuresEnclosureId(c *gin.Context, enclosureId openapi_types.UUID) {
-	enclosureIdDomain := domain.EnclosureID(enclosureId)
+// Quarantine an animal
+// (POST /api/v1/animals/{animalId}/quarantine)
+func (server *Server) PostApiV1AnimalsAnimalIdQuarantine(c *gin.Context, animalId openapi_types.UUID) {
+	animalIdDomain := domain.AnimalID(animalId)
 
-	// Delete the enclosure
-	err := server.enclosureRepo.DeleteEnclosure(c.Request.Context(), enclosureIdDomain)
-	if err != nil {
+	// Parse the request body
+	var input v1.QuarantineInput
+	if err := c.ShouldBindJSON(&input); err != nil {
 		server.SendBadRequestResponse(c, err, nil)
 		return
 	}
 
-	c.Status(http.StatusNoContent)
+	quarantine, err := server.quarantineSvc.QuarantineAnimal(c.Request.Context(), animalIdDomain, adapters.APIQuarantineInputToDomain(input))
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusCreated, adapters.DomainQuarantineToAPI(quarantine))
 }
 
-// Get enclosure by ID
-// (GET /api/v1/enclosures/{enclosureId})
-func (server *Server) GetApiV1EnclosuresEnclosureId(c *gin.Context, enclosureId openapi_types.UUID) {
-	enclosureIdDomain := domain.EnclosureID(enclosureId)
+// Diagnose an animal
+// (POST /api/v1/animals/{animalId}/diagnoses)
+func (server *Server) PostApiV1AnimalsAnimalIdDiagnoses(c *gin.Context, animalId openapi_types.UUID) {
+	animalIdDomain := domain.AnimalID(animalId)
 
-	// Get the enclosure
-	enclosure, err := server.enclosureRepo.GetEnclosure(c.Request.Context(), enclosureIdDomain)
-	if err != nil {
+	// Parse the request body
+	var input v1.DiagnosisInput
+	if err := c.ShouldBindJSON(&input); err != nil {
 		server.SendBadRequestResponse(c, err, nil)
 		return
 	}
 
-	// Convert domain enclosure to API enclosure
-	apiEnclosure := adapters.DomainEnclosureToAPI(enclosure)
+	diagnosis, err := server.medicalCareSvc.DiagnoseAnimal(
+		c.Request.Context(),
+		animalIdDomain,
+		domain.DiagnosisDescription(input.Description),
+		domain.VetName(input.Vet),
+	)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
 
-	c.JSON(http.StatusOK, apiEnclosure)
+	c.JSON(http.StatusCreated, adapters.DomainDiagnosisToAPI(diagnosis))
 }
 
-// Clean an enclosure
-// (POST /api/v1/enclosures/{enclosureId}/clean)
-func (server *Server) PostApiV1EnclosuresEnclosureIdClean(c *gin.Context, enclosureId openapi_types.UUID) {
-	enclosureIdDomain := domain.EnclosureID(enclosureId)
+// Get the medical record of an animal
+// (GET /api/v1/animals/{animalId}/medical-record)
+func (server *Server) GetApiV1AnimalsAnimalIdMedicalRecord(c *gin.Context, animalId openapi_types.UUID) {
+	animalIdDomain := domain.AnimalID(animalId)
 
-	// Get the enclosure
-	enclosure, err := server.enclosureRepo.GetEnclosure(c.Request.Context(), enclosureIdDomain)
+	record, err := server.medicalCareSvc.GetMedicalRecord(c.Request.Context(), animalIdDomain)
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	// Trigger the cleaned event
-	enclosure.Clean()
+	c.JSON(http.StatusOK, adapters.DomainMedicalRecordToAPI(record))
+}
+
+// Get the weight history of an animal
+// (GET /api/v1/animals/{animalId}/weights)
+func (server *Server) GetApiV1AnimalsAnimalIdWeights(
+	c *gin.Context,
+	animalId openapi_types.UUID,
+	params v1.GetApiV1AnimalsAnimalIdWeightsParams,
+) {
+	var from time.Time
+	if params.From != nil {
+		from = *params.From
+	}
+
+	to := server.timeProvider.Now()
+	if params.To != nil {
+		to = *params.To
+	}
+
+	if to.Before(from) {
+		server.SendBadRequestResponse(c, errors.New("to must not be before from"), nil)
+		return
+	}
 
-	// Save the updated enclosure
-	err = server.enclosureRepo.UpdateEnclosure(c.Request.Context(), enclosure)
+	measurements, trend, err := server.weightTrackingSvc.GetWeightHistory(c.Request.Context(), domain.AnimalID(animalId), from, to)
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	// Return the updated enclosure
-	apiEnclosure := adapters.DomainEnclosureToAPI(enclosure)
-	c.JSON(http.StatusOK, apiEnclosure)
+	c.JSON(http.StatusOK, v1.WeightHistory{
+		AnimalId:     animalId,
+		Measurements: adapters.DomainWeightMeasurementsToAPI(measurements),
+		Trend:        adapters.DomainWeightTrendToAPI(trend),
+	})
 }
 
-// Get all feeding schedules
-// (GET /api/v1/feeding-schedules)
-func (server *Server) GetApiV1FeedingSchedules(c *gin.Context) {
-	schedules, err := server.feedingScheduleRepo.GetAllFeedingSchedules(c.Request.Context())
-	if err != nil {
+// Record a weighing of an animal
+// (POST /api/v1/animals/{animalId}/weights)
+func (server *Server) PostApiV1AnimalsAnimalIdWeights(c *gin.Context, animalId openapi_types.UUID) {
+	// Parse the request body
+	var input v1.WeightMeasurementInput
+	if err := c.ShouldBindJSON(&input); err != nil {
 		server.SendBadRequestResponse(c, err, nil)
 		return
 	}
 
-	// Convert domain schedules to API schedules
-	apiSchedules := adapters.DomainFeedingScheduleToAPIList(schedules)
+	measurement, trend, err := server.weightTrackingSvc.RecordWeight(
+		c.Request.Context(),
+		domain.AnimalID(animalId),
+		adapters.APIWeightMeasurementInputToService(input),
+	)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
 
-	c.JSON(http.StatusOK, v1.FeedingScheduleListResponse{
-		Schedules: apiSchedules,
+	c.JSON(http.StatusCreated, v1.WeightMeasurementResult{
+		Measurement: adapters.DomainWeightMeasurementToAPI(*measurement),
+		Trend:       adapters.DomainWeightTrendToAPI(trend),
 	})
 }
 
-// Add a new feeding schedule
-// (POST /api/v1/feeding-schedules)
-func (server *Server) PostApiV1FeedingSchedules(c *gin.Context) {
+// Calculate the dose of a drug for an animal
+// (GET /api/v1/animals/{animalId}/doses)
+func (server *Server) GetApiV1AnimalsAnimalIdDoses(
+	c *gin.Context,
+	animalId openapi_types.UUID,
+	params v1.GetApiV1AnimalsAnimalIdDosesParams,
+) {
+	dose, err := server.medicationDosingSvc.CalculateDose(c.Request.Context(), domain.AnimalID(animalId), domain.DrugName(params.Drug))
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainDoseRangeToAPI(dose))
+}
+
+// Log a drug administration
+// (POST /api/v1/animals/{animalId}/administrations)
+func (server *Server) PostApiV1AnimalsAnimalIdAdministrations(c *gin.Context, animalId openapi_types.UUID) {
 	// Parse the request body
-	var input v1.FeedingScheduleInput
+	var input v1.DrugAdministrationInput
 	if err := c.ShouldBindJSON(&input); err != nil {
 		server.SendBadRequestResponse(c, err, nil)
 		return
 	}
 
-	animalId := domain.AnimalID(input.AnimalId)
-
-	// Get the animal
-	animal, err := server.animalRepo.GetAnimal(c.Request.Context(), animalId)
+	administration, err := server.medicationDosingSvc.AdministerDrug(
+		c.Request.Context(),
+		domain.AnimalID(animalId),
+		adapters.APIDrugAdministrationInputToService(input),
+	)
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	// Create a new feeding schedule
-	schedule, err := adapters.APIToNewDomainFeedingSchedule(input, animal)
+	c.JSON(http.StatusCreated, adapters.DomainDrugAdministrationToAPI(administration))
+}
+
+// Get enclosures suitable for an animal
+// (GET /api/v1/animals/{animalId}/suitable-enclosures)
+func (server *Server) GetApiV1AnimalsAnimalIdSuitableEnclosures(c *gin.Context, animalId openapi_types.UUID) {
+	animalIdDomain := domain.AnimalID(animalId)
+
+	suitable, err := server.transferSvc.SuitableEnclosures(c.Request.Context(), animalIdDomain)
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	// Save the feeding schedule
-	err = server.feedingScheduleRepo.AddFeedingSchedule(c.Request.Context(), schedule)
+	var catalog domain.SpeciesCatalog
+
+	err = server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		catalog, err = domain.LoadSpeciesCatalog(ctx, repos.Species())
+
+		return err
+	})
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	// Return the created feeding schedule
-	apiSchedule := adapters.DomainFeedingScheduleToAPI(schedule)
-	c.JSON(http.StatusCreated, apiSchedule)
+	c.JSON(http.StatusOK, v1.SuitableEnclosureListResponse{
+		Enclosures: adapters.DomainEnclosureSuitabilityToAPIList(suitable, catalog),
+	})
 }
 
-// Delete a feeding schedule
-// (DELETE /api/v1/feeding-schedules/{scheduleId})
-func (server *Server) DeleteApiV1FeedingSchedulesScheduleId(c *gin.Context, scheduleId openapi_types.UUID) {
-	scheduleIdDomain := domain.FeedingScheduleID(scheduleId)
+// Get the species catalog
+// (GET /api/v1/species)
+func (server *Server) GetApiV1Species(c *gin.Context) {
+	var species []*domain.Species
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		species, err = repos.Species().GetAllSpecies(ctx)
 
-	err := server.feedingScheduleRepo.DeleteFeedingSchedule(c.Request.Context(), scheduleIdDomain)
+		return err
+	})
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	c.Status(http.StatusNoContent)
+	c.JSON(http.StatusOK, v1.SpeciesListResponse{
+		Species: adapters.DomainSpeciesToAPIList(species),
+	})
 }
 
-// Get feeding schedule by ID
-// (GET /api/v1/feeding-schedules/{scheduleId})
-func (server *Server) GetApiV1FeedingSchedulesScheduleId(c *gin.Context, scheduleId openapi_types.UUID) {
-	scheduleIdDomain := domain.FeedingScheduleID(scheduleId)
+// Add a species to the catalog
+// (POST /api/v1/species)
+func (server *Server) PostApiV1Species(c *gin.Context) {
+	// Parse the request body
+	var input v1.Species
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
 
-	schedule, err := server.feedingScheduleRepo.GetFeedingSchedule(c.Request.Context(), scheduleIdDomain)
+	species, err := adapters.APISpeciesToDomain(input)
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	// Convert domain schedule to API schedule
-	apiSchedule := adapters.DomainFeedingScheduleToAPI(schedule)
+	err = server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		if err := repos.Species().AddSpecies(ctx, species); err != nil {
+			return err
+		}
 
-	c.JSON(http.StatusOK, apiSchedule)
-}
+		return checkSpeciesKeepers(ctx, repos, species.Name)
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
 
-// Mark a feeding schedule as completed
-// (POST /api/v1/feeding-schedules/{scheduleId}/complete)
-func (server *Server) PostApiV1FeedingSchedulesScheduleIdComplete(c *gin.Context, scheduleId openapi_types.UUID) {
-	scheduleIdDomain := domain.FeedingScheduleID(scheduleId)
+	c.JSON(http.StatusCreated, adapters.DomainSpeciesToAPI(species))
+}
 
-	// Process the completion through the service
-	schedule, err := server.feedingScheduleRepo.GetFeedingSchedule(c.Request.Context(), scheduleIdDomain)
+// Remove a species from the catalog
+// (DELETE /api/v1/species/{speciesName})
+func (server *Server) DeleteApiV1SpeciesSpeciesName(c *gin.Context, speciesName string) {
+	err := server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Species().DeleteSpecies(ctx, domain.AnimalSpecies(speciesName))
+	})
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	schedule.Done()
-
-	// Return the updated schedule
-	apiSchedule := adapters.DomainFeedingScheduleToAPI(schedule)
-	c.JSON(http.StatusOK, apiSchedule)
+	c.Status(http.StatusNoContent)
 }
 
-// Get zoo statistics
-// (GET /api/v1/statistics)
-func (server *Server) GetApiV1Statistics(c *gin.Context) {
-	// Get the statistics
-	totalAnimals, err := server.statisticsSvc.GetAnimalCount(c.Request.Context())
+// Get the rules of a species
+// (GET /api/v1/species/{speciesName})
+func (server *Server) GetApiV1SpeciesSpeciesName(c *gin.Context, speciesName string) {
+	var species *domain.Species
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		species, err = repos.Species().GetSpecies(ctx, domain.AnimalSpecies(speciesName))
+
+		return err
+	})
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	healthyAnimals, err := server.statisticsSvc.GetHealthyAnimalCount(c.Request.Context())
-	if err != nil {
+	c.JSON(http.StatusOK, adapters.DomainSpeciesToAPI(species))
+}
+
+// Update the rules of a species
+// (PUT /api/v1/species/{speciesName})
+func (server *Server) PutApiV1SpeciesSpeciesName(c *gin.Context, speciesName string) {
+	// Parse the request body
+	var input v1.SpeciesRules
+	if err := c.ShouldBindJSON(&input); err != nil {
 		server.SendBadRequestResponse(c, err, nil)
 		return
 	}
 
-	sickAnimals, err := server.statisticsSvc.GetSickAnimalCount(c.Request.Context())
+	species, err := adapters.APISpeciesRulesToDomain(domain.AnimalSpecies(speciesName), input)
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	totalEnclosures, err := server.statisticsSvc.GetEnclosureCount(c.Request.Context())
+	err = server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		if err := repos.Species().UpdateSpecies(ctx, species); err != nil {
+			return err
+		}
+
+		return checkSpeciesKeepers(ctx, repos, species.Name)
+	})
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	freeEnclosures, err := server.statisticsSvc.GetFreeEnclosureCount(c.Request.Context())
+	c.JSON(http.StatusOK, adapters.DomainSpeciesToAPI(species))
+}
+
+// checkSpeciesKeepers returns an error if, under the stored catalog, a keeper assigned to an enclosure
+// housing the species is not qualified for it.
+func checkSpeciesKeepers(ctx context.Context, repos domain.Repositories, name domain.AnimalSpecies) error {
+	catalog, err := domain.LoadSpeciesCatalog(ctx, repos.Species())
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
-		return
+		return err
 	}
 
-	feedingSchedulesCount, err := server.statisticsSvc.GetFeedingScheduleCount(c.Request.Context())
+	enclosures, err := repos.Enclosures().GetAllEnclosures(ctx)
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
-		return
+		return err
 	}
 
-	completedFeedingsToday, err := server.statisticsSvc.GetCompletedFeedingsTodayCount(c.Request.Context())
+	keepers, err := repos.Keepers().GetAllKeepers(ctx)
+	if err != nil {
+		return err
+	}
+
+	return domain.CheckKeepersQualifiedForSpecies(name, enclosures, keepers, catalog)
+}
+
+// Get the drug formulary
+// (GET /api/v1/drugs)
+func (server *Server) GetApiV1Drugs(c *gin.Context) {
+	var drugs []*domain.Drug
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		drugs, err = repos.Drugs().GetAllDrugs(ctx)
+
+		return err
+	})
 	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.DrugListResponse{
+		Drugs: adapters.DomainDrugsToAPI(drugs),
+	})
+}
+
+// Add a drug to the formulary
+// (POST /api/v1/drugs)
+func (server *Server) PostApiV1Drugs(c *gin.Context) {
+	// Parse the request body
+	var input v1.Drug
+	if err := c.ShouldBindJSON(&input); err != nil {
 		server.SendBadRequestResponse(c, err, nil)
 		return
 	}
 
-	pendingFeedingsToday, err := server.statisticsSvc.GetPendingFeedingsTodayCount(c.Request.Context())
+	drug, err := adapters.APIDrugToDomain(input)
 	if err != nil {
-		server.SendBadRequestResponse(c, err, nil)
+		server.SendErrorResponse(c, err, nil)
 		return
 	}
 
-	stats := v1.ZooStatistics{
-		TotalAnimals:           totalAnimals,
-		HealthyAnimals:         healthyAnimals,
-		SickAnimals:            sickAnimals,
-		TotalEnclosures:        totalEnclosures,
-		FreeEnclosures:         freeEnclosures,
-		FeedingSchedulesCount:  feedingSchedulesCount,
-		CompletedFeedingsToday: completedFeedingsToday,
-		PendingFeedingsToday:   pendingFeedingsToday,
+	err = server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Drugs().AddDrug(ctx, drug)
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusCreated, adapters.DomainDrugToAPI(drug))
+}
+
+// Remove a drug from the formulary
+// (DELETE /api/v1/drugs/{drugName})
+func (server *Server) DeleteApiV1DrugsDrugName(c *gin.Context, drugName string) {
+	err := server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Drugs().DeleteDrug(ctx, domain.DrugName(drugName))
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Get a drug of the formulary
+// (GET /api/v1/drugs/{drugName})
+func (server *Server) GetApiV1DrugsDrugName(c *gin.Context, drugName string) {
+	var drug *domain.Drug
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		drug, err = repos.Drugs().GetDrug(ctx, domain.DrugName(drugName))
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainDrugToAPI(drug))
+}
+
+// Update a drug of the formulary
+// (PUT /api/v1/drugs/{drugName})
+func (server *Server) PutApiV1DrugsDrugName(c *gin.Context, drugName string) {
+	// Parse the request body
+	var input v1.DrugRules
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	drug, err := adapters.APIDrugRulesToDomain(domain.DrugName(drugName), input)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	err = server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Drugs().UpdateDrug(ctx, drug)
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainDrugToAPI(drug))
+}
+
+// Get all keepers
+// (GET /api/v1/keepers)
+func (server *Server) GetApiV1Keepers(c *gin.Context) {
+	var keepers []*domain.Keeper
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		keepers, err = repos.Keepers().GetAllKeepers(ctx)
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.KeeperListResponse{
+		Keepers: adapters.DomainKeepersToAPI(keepers),
+	})
+}
+
+// Add a keeper
+// (POST /api/v1/keepers)
+func (server *Server) PostApiV1Keepers(c *gin.Context) {
+	// Parse the request body
+	var input v1.KeeperInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	details := adapters.APIKeeperInputToService(input)
+
+	keeper, err := domain.NewKeeper(details.Name, details.Qualifications, details.MaxEnclosures)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	err = server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Keepers().AddKeeper(ctx, keeper)
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusCreated, adapters.DomainKeeperToAPI(keeper))
+}
+
+// Remove a keeper with their assignments
+// (DELETE /api/v1/keepers/{keeperId})
+func (server *Server) DeleteApiV1KeepersKeeperId(c *gin.Context, keeperId openapi_types.UUID) {
+	err := server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Keepers().DeleteKeeper(ctx, domain.KeeperID(keeperId))
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Get a keeper
+// (GET /api/v1/keepers/{keeperId})
+func (server *Server) GetApiV1KeepersKeeperId(c *gin.Context, keeperId openapi_types.UUID) {
+	var keeper *domain.Keeper
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		keeper, err = repos.Keepers().GetKeeper(ctx, domain.KeeperID(keeperId))
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainKeeperToAPI(keeper))
+}
+
+// Update a keeper
+// (PUT /api/v1/keepers/{keeperId})
+func (server *Server) PutApiV1KeepersKeeperId(c *gin.Context, keeperId openapi_types.UUID) {
+	// Parse the request body
+	var input v1.KeeperInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	keeper, err := server.staffSchedulingSvc.UpdateKeeper(
+		c.Request.Context(),
+		domain.KeeperID(keeperId),
+		adapters.APIKeeperInputToService(input),
+	)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainKeeperToAPI(keeper))
+}
+
+// Assign a keeper to an enclosure in a shift
+// (POST /api/v1/keepers/{keeperId}/assignments)
+func (server *Server) PostApiV1KeepersKeeperIdAssignments(c *gin.Context, keeperId openapi_types.UUID) {
+	// Parse the request body
+	var input v1.KeeperAssignment
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	keeper, err := server.staffSchedulingSvc.AssignKeeper(
+		c.Request.Context(),
+		domain.KeeperID(keeperId),
+		domain.EnclosureID(input.EnclosureId),
+		domain.ShiftName(input.Shift),
+	)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusCreated, adapters.DomainKeeperToAPI(keeper))
+}
+
+// Unassign a keeper from an enclosure in a shift
+// (DELETE /api/v1/keepers/{keeperId}/assignments/{shiftName}/{enclosureId})
+func (server *Server) DeleteApiV1KeepersKeeperIdAssignmentsShiftNameEnclosureId(
+	c *gin.Context,
+	keeperId openapi_types.UUID,
+	shiftName string,
+	enclosureId openapi_types.UUID,
+) {
+	_, err := server.staffSchedulingSvc.UnassignKeeper(
+		c.Request.Context(),
+		domain.KeeperID(keeperId),
+		domain.EnclosureID(enclosureId),
+		domain.ShiftName(shiftName),
+	)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Get the daily task list of a keeper
+// (GET /api/v1/keepers/{keeperId}/tasks)
+func (server *Server) GetApiV1KeepersKeeperIdTasks(
+	c *gin.Context,
+	keeperId openapi_types.UUID,
+	params v1.GetApiV1KeepersKeeperIdTasksParams,
+) {
+	var timeZone string
+	if params.TimeZone != nil {
+		timeZone = *params.TimeZone
+	}
+
+	location, err := domain.LoadLocation(timeZone)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	date := server.timeProvider.Now().In(location)
+	if params.Date != nil {
+		date = params.Date.Time
+	}
+
+	// The day starts at midnight in the time zone
+	day := domain.TimeSlot{Start: time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location)}
+	day.End = day.Start.AddDate(0, 0, 1)
+
+	tasks, err := server.keeperTasksSvc.TaskList(c.Request.Context(), domain.KeeperID(keeperId), day)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.KeeperTaskList{
+		KeeperId: keeperId,
+		Date:     openapi_types.Date{Time: day.Start},
+		TimeZone: location.String(),
+		Tasks:    adapters.DomainKeeperTasksToAPI(tasks),
+	})
+}
+
+// Complete a task of a keeper
+// (POST /api/v1/keepers/{keeperId}/tasks/complete)
+func (server *Server) PostApiV1KeepersKeeperIdTasksComplete(c *gin.Context, keeperId openapi_types.UUID) {
+	// Parse the request body
+	var input v1.KeeperTaskCompletionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	task, err := server.keeperTasksSvc.CompleteTask(
+		c.Request.Context(),
+		domain.KeeperID(keeperId),
+		domain.TaskKind(input.Kind),
+		input.TaskId,
+		input.Time,
+	)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainKeeperTaskToAPI(task))
+}
+
+// Get all shifts
+// (GET /api/v1/shifts)
+func (server *Server) GetApiV1Shifts(c *gin.Context) {
+	var shifts []*domain.Shift
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		shifts, err = repos.Shifts().GetAllShifts(ctx)
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.ShiftListResponse{
+		Shifts: adapters.DomainShiftsToAPI(shifts),
+	})
+}
+
+// Define a shift
+// (POST /api/v1/shifts)
+func (server *Server) PostApiV1Shifts(c *gin.Context) {
+	// Parse the request body
+	var input v1.Shift
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	shift, err := adapters.APIShiftToDomain(input)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	err = server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Shifts().AddShift(ctx, shift)
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusCreated, adapters.DomainShiftToAPI(shift))
+}
+
+// Remove a shift
+// (DELETE /api/v1/shifts/{shiftName})
+func (server *Server) DeleteApiV1ShiftsShiftName(c *gin.Context, shiftName string) {
+	if err := server.staffSchedulingSvc.DeleteShift(c.Request.Context(), domain.ShiftName(shiftName)); err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Get a shift
+// (GET /api/v1/shifts/{shiftName})
+func (server *Server) GetApiV1ShiftsShiftName(c *gin.Context, shiftName string) {
+	var shift *domain.Shift
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		shift, err = repos.Shifts().GetShift(ctx, domain.ShiftName(shiftName))
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainShiftToAPI(shift))
+}
+
+// Update a shift
+// (PUT /api/v1/shifts/{shiftName})
+func (server *Server) PutApiV1ShiftsShiftName(c *gin.Context, shiftName string) {
+	// Parse the request body
+	var input v1.ShiftRules
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	shift, err := adapters.APIShiftRulesToDomain(domain.ShiftName(shiftName), input)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	err = server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Shifts().UpdateShift(ctx, shift)
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainShiftToAPI(shift))
+}
+
+// Get all care tasks
+// (GET /api/v1/care-tasks)
+func (server *Server) GetApiV1CareTasks(c *gin.Context) {
+	var tasks []*domain.CareTask
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		tasks, err = repos.CareTasks().GetAllCareTasks(ctx)
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.CareTaskListResponse{
+		Tasks: adapters.DomainCareTasksToAPI(tasks),
+	})
+}
+
+// Add a care task
+// (POST /api/v1/care-tasks)
+func (server *Server) PostApiV1CareTasks(c *gin.Context) {
+	// Parse the request body
+	var input v1.CareTaskInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	task, err := adapters.APIToNewDomainCareTask(input)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	err = server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		if _, err := repos.Enclosures().GetEnclosure(ctx, task.EnclosureID); err != nil {
+			return err
+		}
+
+		if task.Assignee != nil {
+			if _, err := repos.Keepers().GetKeeper(ctx, *task.Assignee); err != nil {
+				return err
+			}
+		}
+
+		return repos.CareTasks().AddCareTask(ctx, task)
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusCreated, adapters.DomainCareTaskToAPI(task))
+}
+
+// Delete a care task
+// (DELETE /api/v1/care-tasks/{taskId})
+func (server *Server) DeleteApiV1CareTasksTaskId(c *gin.Context, taskId openapi_types.UUID) {
+	err := server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.CareTasks().DeleteCareTask(ctx, domain.CareTaskID(taskId))
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Get a care task
+// (GET /api/v1/care-tasks/{taskId})
+func (server *Server) GetApiV1CareTasksTaskId(c *gin.Context, taskId openapi_types.UUID) {
+	var task *domain.CareTask
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		task, err = repos.CareTasks().GetCareTask(ctx, domain.CareTaskID(taskId))
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainCareTaskToAPI(task))
+}
+
+// Assign a care task to a keeper
+// (PUT /api/v1/care-tasks/{taskId}/assignee)
+func (server *Server) PutApiV1CareTasksTaskIdAssignee(c *gin.Context, taskId openapi_types.UUID) {
+	// Parse the request body
+	var input v1.TaskAssigneeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	task, err := server.keeperTasksSvc.AssignCareTask(
+		c.Request.Context(),
+		domain.CareTaskID(taskId),
+		adapters.APIAssigneeToDomain(input.KeeperId),
+	)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainCareTaskToAPI(task))
+}
+
+// Get quarantines
+// (GET /api/v1/quarantines)
+func (server *Server) GetApiV1Quarantines(c *gin.Context, params v1.GetApiV1QuarantinesParams) {
+	activeOnly := params.Active != nil && *params.Active
+
+	quarantines, err := server.quarantineSvc.GetQuarantines(c.Request.Context(), activeOnly)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.QuarantineListResponse{
+		Quarantines: adapters.DomainQuarantineToAPIList(quarantines),
+	})
+}
+
+// Get quarantine by ID
+// (GET /api/v1/quarantines/{quarantineId})
+func (server *Server) GetApiV1QuarantinesQuarantineId(c *gin.Context, quarantineId openapi_types.UUID) {
+	quarantine, err := server.quarantineSvc.GetQuarantine(c.Request.Context(), domain.QuarantineID(quarantineId))
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainQuarantineToAPI(quarantine))
+}
+
+// Clear a quarantine
+// (POST /api/v1/quarantines/{quarantineId}/clear)
+func (server *Server) PostApiV1QuarantinesQuarantineIdClear(c *gin.Context, quarantineId openapi_types.UUID) {
+	// Parse the request body
+	var input v1.QuarantineClearanceInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	clearance, err := adapters.APIQuarantineClearanceToDomain(input)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	quarantine, err := server.quarantineSvc.ClearQuarantine(c.Request.Context(), domain.QuarantineID(quarantineId), clearance)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainQuarantineToAPI(quarantine))
+}
+
+// Get vet appointments
+// (GET /api/v1/vet-appointments)
+func (server *Server) GetApiV1VetAppointments(c *gin.Context, params v1.GetApiV1VetAppointmentsParams) {
+	if params.From != nil && params.To != nil && params.To.Before(*params.From) {
+		server.SendBadRequestResponse(c, errors.New("to must not be before from"), nil)
+		return
+	}
+
+	var appointments []*domain.VetAppointment
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		appointments, err = repos.VetAppointments().GetAllVetAppointments(ctx)
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	filtered := make([]*domain.VetAppointment, 0, len(appointments))
+
+	for _, appointment := range appointments {
+		switch {
+		case params.From != nil && !appointment.Slot.End.After(*params.From):
+		case params.To != nil && !appointment.Slot.Start.Before(*params.To):
+		case params.Vet != nil && appointment.Vet != domain.VetName(*params.Vet):
+		case params.AnimalId != nil && appointment.AnimalID != domain.AnimalID(*params.AnimalId):
+		case params.Status != nil && appointment.Status != domain.VetAppointmentStatus(*params.Status):
+		default:
+			filtered = append(filtered, appointment)
+		}
+	}
+
+	c.JSON(http.StatusOK, v1.VetAppointmentListResponse{
+		Appointments: adapters.DomainVetAppointmentToAPIList(filtered),
+	})
+}
+
+// Schedule a vet appointment
+// (POST /api/v1/vet-appointments)
+func (server *Server) PostApiV1VetAppointments(c *gin.Context) {
+	// Parse the request body
+	var input v1.VetAppointmentInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	animalID, appointmentInput := adapters.APIVetAppointmentInputToDomain(input)
+
+	appointment, err := server.vetSchedulingSvc.ScheduleAppointment(c.Request.Context(), animalID, appointmentInput)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusCreated, adapters.DomainVetAppointmentToAPI(appointment))
+}
+
+const (
+	defaultVetCalendarDays = 7
+	maxVetCalendarDays     = 366
+)
+
+// Get the vet calendar
+// (GET /api/v1/vet-appointments/calendar)
+func (server *Server) GetApiV1VetAppointmentsCalendar(c *gin.Context, params v1.GetApiV1VetAppointmentsCalendarParams) {
+	days := defaultVetCalendarDays
+	if params.Days != nil {
+		days = *params.Days
+	}
+
+	if days < 1 || days > maxVetCalendarDays {
+		server.SendBadRequestResponse(c, fmt.Errorf("days must be between 1 and %d", maxVetCalendarDays), nil)
+		return
+	}
+
+	var timeZone string
+	if params.TimeZone != nil {
+		timeZone = *params.TimeZone
+	}
+
+	location, err := domain.LoadLocation(timeZone)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	from := server.timeProvider.Now().In(location)
+	if params.From != nil {
+		from = params.From.Time
+	}
+
+	// The calendar starts at midnight of the day in the time zone
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, location)
+	to := from.AddDate(0, 0, days)
+
+	var appointments []*domain.VetAppointment
+
+	err = server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		appointments, err = repos.VetAppointments().GetVetAppointmentsForTimeRange(ctx, from, to)
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	booked := make([]*domain.VetAppointment, 0, len(appointments))
+
+	for _, appointment := range appointments {
+		if appointment.Status == domain.VetAppointmentStatusCancelled {
+			continue
+		}
+
+		if params.Vet != nil && appointment.Vet != domain.VetName(*params.Vet) {
+			continue
+		}
+
+		booked = append(booked, appointment)
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainVetCalendarToAPI(domain.NewVetCalendar(booked, from, days), location.String()))
+}
+
+// Get vet appointment by ID
+// (GET /api/v1/vet-appointments/{appointmentId})
+func (server *Server) GetApiV1VetAppointmentsAppointmentId(c *gin.Context, appointmentId openapi_types.UUID) {
+	var appointment *domain.VetAppointment
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		appointment, err = repos.VetAppointments().GetVetAppointment(ctx, domain.VetAppointmentID(appointmentId))
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainVetAppointmentToAPI(appointment))
+}
+
+// Reschedule a vet appointment
+// (PUT /api/v1/vet-appointments/{appointmentId})
+func (server *Server) PutApiV1VetAppointmentsAppointmentId(c *gin.Context, appointmentId openapi_types.UUID) {
+	// Parse the request body
+	var input v1.VetAppointmentRescheduleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	appointment, err := server.vetSchedulingSvc.RescheduleAppointment(
+		c.Request.Context(),
+		domain.VetAppointmentID(appointmentId),
+		adapters.APIVetAppointmentRescheduleToDomain(input),
+	)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainVetAppointmentToAPI(appointment))
+}
+
+// Delete a vet appointment
+// (DELETE /api/v1/vet-appointments/{appointmentId})
+func (server *Server) DeleteApiV1VetAppointmentsAppointmentId(c *gin.Context, appointmentId openapi_types.UUID) {
+	err := server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.VetAppointments().DeleteVetAppointment(ctx, domain.VetAppointmentID(appointmentId))
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Complete a vet appointment
+// (POST /api/v1/vet-appointments/{appointmentId}/complete)
+func (server *Server) PostApiV1VetAppointmentsAppointmentIdComplete(c *gin.Context, appointmentId openapi_types.UUID) {
+	// Parse the request body
+	var input v1.VetAppointmentOutcomeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	appointment, err := server.vetSchedulingSvc.CompleteAppointment(c.Request.Context(), domain.VetAppointmentID(appointmentId), input.Outcome)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainVetAppointmentToAPI(appointment))
+}
+
+// Cancel a vet appointment
+// (POST /api/v1/vet-appointments/{appointmentId}/cancel)
+func (server *Server) PostApiV1VetAppointmentsAppointmentIdCancel(c *gin.Context, appointmentId openapi_types.UUID) {
+	appointment, err := server.vetSchedulingSvc.CancelAppointment(c.Request.Context(), domain.VetAppointmentID(appointmentId))
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainVetAppointmentToAPI(appointment))
+}
+
+// Plan the placement of animals
+// (POST /api/v1/placement/plan)
+func (server *Server) PostApiV1PlacementPlan(c *gin.Context) {
+	// Parse the request body
+	var input v1.PlacementRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	request, err := adapters.APIPlacementRequestToDomain(input)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	plan, err := server.placementSvc.PlanPlacement(c.Request.Context(), request)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainPlacementPlanToAPI(plan))
+}
+
+// Execute a placement plan
+// (POST /api/v1/placement/execute)
+func (server *Server) PostApiV1PlacementExecute(c *gin.Context) {
+	// Parse the request body
+	var input v1.PlacementExecutionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	steps, err := adapters.APIPlacementExecutionToDomain(input)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	animals, err := server.placementSvc.ExecutePlacement(c.Request.Context(), steps)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.AnimalListResponse{
+		Animals: adapters.DomainAnimalToAPIList(animals),
+	})
+}
+
+// Transfer animals in bulk
+// (POST /api/v1/transfers)
+func (server *Server) PostApiV1Transfers(c *gin.Context) {
+	// Parse the request body
+	var input v1.TransferBatchInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	batch, err := server.transferSvc.TransferAnimals(c.Request.Context(), adapters.APITransferBatchToDomain(input))
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainTransferBatchToAPI(batch))
+}
+
+// Get enclosure types
+// (GET /api/v1/enclosure-types)
+func (server *Server) GetApiV1EnclosureTypes(c *gin.Context) {
+	c.JSON(http.StatusOK, v1.EnclosureTypeListResponse{
+		EnclosureTypes: adapters.DomainEnclosureTypesToAPI(domain.EnclosureTypes()),
+	})
+}
+
+// Get all enclosures
+// (GET /api/v1/enclosures)
+func (server *Server) GetApiV1Enclosures(c *gin.Context) {
+	var (
+		enclosures []*domain.Enclosure
+		catalog    domain.SpeciesCatalog
+	)
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		if enclosures, err = repos.Enclosures().GetAllEnclosures(ctx); err != nil {
+			return err
+		}
+
+		catalog, err = domain.LoadSpeciesCatalog(ctx, repos.Species())
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	// Convert domain enclosures to API enclosures
+	apiEnclosures := adapters.DomainEnclosureToAPIList(enclosures, catalog)
+
+	c.JSON(http.StatusOK, v1.EnclosureListResponse{
+		Enclosures: apiEnclosures,
+	})
+}
+
+// Add a new enclosure
+// (POST /api/v1/enclosures)
+func (server *Server) PostApiV1Enclosures(c *gin.Context) {
+	// Parse the request body
+	var input v1.EnclosureInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	// Create a new enclosure
+	enclosure, err := adapters.APIToNewDomainEnclosure(input)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	var catalog domain.SpeciesCatalog
+
+	// Save the enclosure
+	err = server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		if err := repos.Enclosures().AddEnclosure(ctx, enclosure); err != nil {
+			return err
+		}
+
+		var err error
+
+		catalog, err = domain.LoadSpeciesCatalog(ctx, repos.Species())
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	// Return the created enclosure
+	apiEnclosure := adapters.DomainEnclosureToAPI(enclosure, catalog)
+	c.JSON(http.StatusCreated, apiEnclosure)
+}
+
+// Delete an enclosure
+// (DELETE /api/v1/enclosures/{enclosureId})
+func (server *Server) DeleteApiV1EnclosuresEnclosureId(c *gin.Context, enclosureId openapi_types.UUID) {
+	enclosureIdDomain := domain.EnclosureID(enclosureId)
+
+	// Delete the enclosure
+	err := server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Enclosures().DeleteEnclosure(ctx, enclosureIdDomain)
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Get enclosure by ID
+// (GET /api/v1/enclosures/{enclosureId})
+func (server *Server) GetApiV1EnclosuresEnclosureId(c *gin.Context, enclosureId openapi_types.UUID) {
+	enclosureIdDomain := domain.EnclosureID(enclosureId)
+
+	var (
+		enclosure *domain.Enclosure
+		catalog   domain.SpeciesCatalog
+	)
+
+	// Get the enclosure
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		if enclosure, err = repos.Enclosures().GetEnclosure(ctx, enclosureIdDomain); err != nil {
+			return err
+		}
+
+		catalog, err = domain.LoadSpeciesCatalog(ctx, repos.Species())
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	// Convert domain enclosure to API enclosure
+	apiEnclosure := adapters.DomainEnclosureToAPI(enclosure, catalog)
+
+	c.JSON(http.StatusOK, apiEnclosure)
+}
+
+// Clean an enclosure
+// (POST /api/v1/enclosures/{enclosureId}/clean)
+func (server *Server) PostApiV1EnclosuresEnclosureIdClean(c *gin.Context, enclosureId openapi_types.UUID) {
+	enclosureIdDomain := domain.EnclosureID(enclosureId)
+
+	var (
+		enclosure *domain.Enclosure
+		catalog   domain.SpeciesCatalog
+	)
+
+	err := server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		// Get the enclosure
+		if enclosure, err = repos.Enclosures().GetEnclosure(ctx, enclosureIdDomain); err != nil {
+			return err
+		}
+
+		// Trigger the cleaned event
+		if err := enclosure.Clean(); err != nil {
+			return err
+		}
+
+		// Save the updated enclosure
+		if err := repos.Enclosures().UpdateEnclosure(ctx, enclosure); err != nil {
+			return err
+		}
+
+		catalog, err = domain.LoadSpeciesCatalog(ctx, repos.Species())
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	// Return the updated enclosure
+	apiEnclosure := adapters.DomainEnclosureToAPI(enclosure, catalog)
+	c.JSON(http.StatusOK, apiEnclosure)
+}
+
+// Get the keepers responsible for an enclosure
+// (GET /api/v1/enclosures/{enclosureId}/keepers)
+func (server *Server) GetApiV1EnclosuresEnclosureIdKeepers(
+	c *gin.Context,
+	enclosureId openapi_types.UUID,
+	params v1.GetApiV1EnclosuresEnclosureIdKeepersParams,
+) {
+	at := server.timeProvider.Now()
+	if params.At != nil {
+		at = *params.At
+	}
+
+	onDuty, err := server.staffSchedulingSvc.ResponsibleKeepers(c.Request.Context(), domain.EnclosureID(enclosureId), at)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.ResponsibleKeepers{
+		EnclosureId: enclosureId,
+		At:          at,
+		Keepers:     adapters.DomainKeepersOnDutyToAPI(onDuty),
+	})
+}
+
+// Get all feeding schedules
+// (GET /api/v1/feeding-schedules)
+func (server *Server) GetApiV1FeedingSchedules(c *gin.Context) {
+	var schedules []*domain.FeedingSchedule
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		schedules, err = repos.FeedingSchedules().GetAllFeedingSchedules(ctx)
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	// Convert domain schedules to API schedules
+	apiSchedules := adapters.DomainFeedingScheduleToAPIList(schedules)
+
+	c.JSON(http.StatusOK, v1.FeedingScheduleListResponse{
+		Schedules: apiSchedules,
+	})
+}
+
+// Add a new feeding schedule
+// (POST /api/v1/feeding-schedules)
+func (server *Server) PostApiV1FeedingSchedules(c *gin.Context) {
+	// Parse the request body
+	var input v1.FeedingScheduleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	animalId := domain.AnimalID(input.AnimalId)
+
+	var schedule *domain.FeedingSchedule
+
+	err := server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		// Get the animal
+		animal, err := repos.Animals().GetAnimal(ctx, animalId)
+		if err != nil {
+			return err
+		}
+
+		// Create a new feeding schedule
+		if schedule, err = adapters.APIToNewDomainFeedingSchedule(input, animal); err != nil {
+			return err
+		}
+
+		if schedule.Assignee != nil {
+			if _, err := repos.Keepers().GetKeeper(ctx, *schedule.Assignee); err != nil {
+				return err
+			}
+		}
+
+		// Feedings may not fall on vet appointments of the animal, as appointments may not fall on feedings
+		appointments, err := repos.VetAppointments().GetAllVetAppointments(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := schedule.CheckVetConflicts(appointments); err != nil {
+			return err
+		}
+
+		// Save the feeding schedule
+		return repos.FeedingSchedules().AddFeedingSchedule(ctx, schedule)
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	// Return the created feeding schedule
+	apiSchedule := adapters.DomainFeedingScheduleToAPI(schedule)
+	c.JSON(http.StatusCreated, apiSchedule)
+}
+
+// Delete a feeding schedule
+// (DELETE /api/v1/feeding-schedules/{scheduleId})
+func (server *Server) DeleteApiV1FeedingSchedulesScheduleId(c *gin.Context, scheduleId openapi_types.UUID) {
+	scheduleIdDomain := domain.FeedingScheduleID(scheduleId)
+
+	err := server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.FeedingSchedules().DeleteFeedingSchedule(ctx, scheduleIdDomain)
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Get feeding schedule by ID
+// (GET /api/v1/feeding-schedules/{scheduleId})
+func (server *Server) GetApiV1FeedingSchedulesScheduleId(c *gin.Context, scheduleId openapi_types.UUID) {
+	scheduleIdDomain := domain.FeedingScheduleID(scheduleId)
+
+	var schedule *domain.FeedingSchedule
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		schedule, err = repos.FeedingSchedules().GetFeedingSchedule(ctx, scheduleIdDomain)
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	// Convert domain schedule to API schedule
+	apiSchedule := adapters.DomainFeedingScheduleToAPI(schedule)
+
+	c.JSON(http.StatusOK, apiSchedule)
+}
+
+// Mark a feeding schedule as completed
+// (POST /api/v1/feeding-schedules/{scheduleId}/complete)
+func (server *Server) PostApiV1FeedingSchedulesScheduleIdComplete(c *gin.Context, scheduleId openapi_types.UUID) {
+	scheduleIdDomain := domain.FeedingScheduleID(scheduleId)
+
+	var schedule *domain.FeedingSchedule
+
+	err := server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		if schedule, err = repos.FeedingSchedules().GetFeedingSchedule(ctx, scheduleIdDomain); err != nil {
+			return err
+		}
+
+		if err := schedule.Complete(server.timeProvider.Now()); err != nil {
+			return err
+		}
+
+		// Save the updated schedule
+		return repos.FeedingSchedules().UpdateFeedingSchedule(ctx, schedule)
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	// Return the updated schedule
+	apiSchedule := adapters.DomainFeedingScheduleToAPI(schedule)
+	c.JSON(http.StatusOK, apiSchedule)
+}
+
+const (
+	defaultOccurrencesRange = 7 * 24 * time.Hour
+	maxOccurrencesRange     = 366 * 24 * time.Hour
+)
+
+// Get feeding occurrences
+// (GET /api/v1/feeding-schedules/{scheduleId}/occurrences)
+func (server *Server) GetApiV1FeedingSchedulesScheduleIdOccurrences(
+	c *gin.Context,
+	scheduleId openapi_types.UUID,
+	params v1.GetApiV1FeedingSchedulesScheduleIdOccurrencesParams,
+) {
+	from := server.timeProvider.Now()
+	if params.From != nil {
+		from = *params.From
+	}
+
+	to := from.Add(defaultOccurrencesRange)
+	if params.To != nil {
+		to = *params.To
+	}
+
+	if to.Before(from) || to.Sub(from) > maxOccurrencesRange {
+		server.SendBadRequestResponse(c, errors.New("to must not be before from and the range may not exceed a year"), nil)
+		return
+	}
+
+	var schedule *domain.FeedingSchedule
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		schedule, err = repos.FeedingSchedules().GetFeedingScheduleWithHistory(ctx, domain.FeedingScheduleID(scheduleId), from, to)
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.FeedingOccurrenceListResponse{
+		Occurrences: adapters.DomainFeedingOccurrencesToAPI(schedule.Occurrences(from, to)),
+	})
+}
+
+// Complete a feeding occurrence
+// (POST /api/v1/feeding-schedules/{scheduleId}/occurrences/complete)
+func (server *Server) PostApiV1FeedingSchedulesScheduleIdOccurrencesComplete(c *gin.Context, scheduleId openapi_types.UUID) {
+	server.changeFeedingOccurrence(c, scheduleId, (*domain.FeedingSchedule).CompleteOccurrence)
+}
+
+// Skip a feeding occurrence
+// (POST /api/v1/feeding-schedules/{scheduleId}/occurrences/skip)
+func (server *Server) PostApiV1FeedingSchedulesScheduleIdOccurrencesSkip(c *gin.Context, scheduleId openapi_types.UUID) {
+	server.changeFeedingOccurrence(c, scheduleId, (*domain.FeedingSchedule).SkipOccurrence)
+}
+
+// changeFeedingOccurrence applies change to the occurrence given in the request body and saves the schedule.
+func (server *Server) changeFeedingOccurrence(
+	c *gin.Context,
+	scheduleId openapi_types.UUID,
+	change func(schedule *domain.FeedingSchedule, t time.Time) error,
+) {
+	var input v1.FeedingOccurrenceInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	var schedule *domain.FeedingSchedule
+
+	err := server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		if schedule, err = repos.FeedingSchedules().GetFeedingSchedule(ctx, domain.FeedingScheduleID(scheduleId)); err != nil {
+			return err
+		}
+
+		if err := change(schedule, input.Time); err != nil {
+			return err
+		}
+
+		return repos.FeedingSchedules().UpdateFeedingSchedule(ctx, schedule)
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainFeedingScheduleToAPI(schedule))
+}
+
+// Assign the feedings of a schedule to a keeper
+// (PUT /api/v1/feeding-schedules/{scheduleId}/assignee)
+func (server *Server) PutApiV1FeedingSchedulesScheduleIdAssignee(c *gin.Context, scheduleId openapi_types.UUID) {
+	// Parse the request body
+	var input v1.TaskAssigneeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	schedule, err := server.keeperTasksSvc.AssignFeeding(
+		c.Request.Context(),
+		domain.FeedingScheduleID(scheduleId),
+		adapters.APIAssigneeToDomain(input.KeeperId),
+	)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainFeedingScheduleToAPI(schedule))
+}
+
+// Run all due feedings
+// (POST /api/v1/feedings/run)
+func (server *Server) PostApiV1FeedingsRun(c *gin.Context) {
+	now := server.timeProvider.Now()
+
+	processed, err := server.feedingOrganizationSvc.FeedAll(c.Request.Context(), now)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.FeedingRunResult{
+		Processed: processed,
+		RanAt:     now,
+	})
+}
+
+// Get the food inventory
+// (GET /api/v1/food-stocks)
+func (server *Server) GetApiV1FoodStocks(c *gin.Context) {
+	var stocks []*domain.FoodStock
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		stocks, err = repos.FoodStocks().GetAllFoodStocks(ctx)
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.FoodStockListResponse{
+		Stocks: adapters.DomainFoodStockToAPIList(stocks),
+	})
+}
+
+// Add a food to the inventory
+// (POST /api/v1/food-stocks)
+func (server *Server) PostApiV1FoodStocks(c *gin.Context) {
+	// Parse the request body
+	var input v1.FoodStockInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	stock, err := adapters.APIToNewDomainFoodStock(input)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	err = server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.FoodStocks().AddFoodStock(ctx, stock)
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusCreated, adapters.DomainFoodStockToAPI(stock))
+}
+
+// Remove a food from the inventory
+// (DELETE /api/v1/food-stocks/{food})
+func (server *Server) DeleteApiV1FoodStocksFood(c *gin.Context, food string) {
+	err := server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.FoodStocks().DeleteFoodStock(ctx, domain.Food(food))
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Get the stock of a food
+// (GET /api/v1/food-stocks/{food})
+func (server *Server) GetApiV1FoodStocksFood(c *gin.Context, food string) {
+	var stock *domain.FoodStock
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		stock, err = repos.FoodStocks().GetFoodStock(ctx, domain.Food(food))
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainFoodStockToAPI(stock))
+}
+
+// Record a food delivery
+// (POST /api/v1/food-stocks/{food}/deliveries)
+func (server *Server) PostApiV1FoodStocksFoodDeliveries(c *gin.Context, food string) {
+	// Parse the request body
+	var input v1.FoodQuantity
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	delivered, err := adapters.APIFoodQuantityToDomain(input)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	stock, err := server.foodInventorySvc.DeliverFood(c.Request.Context(), domain.Food(food), delivered)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainFoodStockToAPI(stock))
+}
+
+// Get the low stock report
+// (GET /api/v1/reports/low-stock)
+func (server *Server) GetApiV1ReportsLowStock(c *gin.Context) {
+	stocks, err := server.foodInventorySvc.LowStockReport(c.Request.Context())
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.LowStockReport{
+		Stocks:      adapters.DomainFoodStockToAPIList(stocks),
+		GeneratedAt: server.timeProvider.Now(),
+	})
+}
+
+const (
+	defaultFoodForecastDays = 7
+	maxFoodForecastDays     = 366
+)
+
+// Get the food demand forecast
+// (GET /api/v1/reports/food-forecast)
+func (server *Server) GetApiV1ReportsFoodForecast(c *gin.Context, params v1.GetApiV1ReportsFoodForecastParams) {
+	days := defaultFoodForecastDays
+	if params.Days != nil {
+		days = *params.Days
+	}
+
+	if days < 1 || days > maxFoodForecastDays {
+		server.SendBadRequestResponse(c, fmt.Errorf("days must be between 1 and %d", maxFoodForecastDays), nil)
+		return
+	}
+
+	format := v1.Json
+	if params.Format != nil {
+		format = *params.Format
+	}
+
+	if format != v1.Json && format != v1.Csv {
+		server.SendBadRequestResponse(c, fmt.Errorf("unknown format %q", format), nil)
+		return
+	}
+
+	forecast, err := server.foodForecastSvc.ForecastFoodDemand(
+		c.Request.Context(),
+		server.timeProvider.Now(),
+		time.Duration(days)*24*time.Hour,
+	)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	if format == v1.Json {
+		c.JSON(http.StatusOK, adapters.DomainFoodForecastToAPI(forecast))
+		return
+	}
+
+	var body bytes.Buffer
+	if err := adapters.WriteFoodForecastCSV(&body, forecast); err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="food-forecast.csv"`)
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", body.Bytes())
+}
+
+// Get zoo statistics
+// (GET /api/v1/statistics)
+func (server *Server) GetApiV1Statistics(c *gin.Context) {
+	// Get the statistics
+	totalAnimals, err := server.statisticsSvc.GetAnimalCount(c.Request.Context())
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	healthyAnimals, err := server.statisticsSvc.GetHealthyAnimalCount(c.Request.Context())
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	sickAnimals, err := server.statisticsSvc.GetSickAnimalCount(c.Request.Context())
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	totalEnclosures, err := server.statisticsSvc.GetEnclosureCount(c.Request.Context())
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	freeEnclosures, err := server.statisticsSvc.GetFreeEnclosureCount(c.Request.Context())
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	feedingSchedulesCount, err := server.statisticsSvc.GetFeedingScheduleCount(c.Request.Context())
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	completedFeedingsToday, err := server.statisticsSvc.GetCompletedFeedingsTodayCount(c.Request.Context())
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	pendingFeedingsToday, err := server.statisticsSvc.GetPendingFeedingsTodayCount(c.Request.Context())
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	stats := v1.ZooStatistics{
+		TotalAnimals:           totalAnimals,
+		HealthyAnimals:         healthyAnimals,
+		SickAnimals:            sickAnimals,
+		TotalEnclosures:        totalEnclosures,
+		FreeEnclosures:         freeEnclosures,
+		FeedingSchedulesCount:  feedingSchedulesCount,
+		CompletedFeedingsToday: completedFeedingsToday,
+		PendingFeedingsToday:   pendingFeedingsToday,
 	}
 
 	c.JSON(http.StatusOK, stats)
 }
+
+// Get all dead letters
+// (GET /api/v1/dead-letters)
+func (server *Server) GetApiV1DeadLetters(c *gin.Context) {
+	letters, err := server.deadLetters.DeadLetters(c.Request.Context())
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	apiLetters, err := adapters.DeadLetterToAPIList(letters)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.DeadLetterListResponse{
+		DeadLetters: apiLetters,
+	})
+}
+
+// Re-drive a dead letter
+// (POST /api/v1/dead-letters/{deadLetterId}/redrive)
+func (server *Server) PostApiV1DeadLettersDeadLetterIdRedrive(c *gin.Context, deadLetterId openapi_types.UUID) {
+	if err := server.deadLetters.Redrive(c.Request.Context(), deadLetterId); err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+const (
+	defaultEventsLimit = 100
+	maxEventsLimit     = 1000
+)
+
+// Get stored events
+// (GET /api/v1/events)
+func (server *Server) GetApiV1Events(c *gin.Context, params v1.GetApiV1EventsParams) {
+	limit := defaultEventsLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	if limit < 1 || limit > maxEventsLimit {
+		server.SendBadRequestResponse(c, fmt.Errorf("limit must be between 1 and %d", maxEventsLimit), nil)
+		return
+	}
+
+	if params.AfterSequence != nil && *params.AfterSequence < 0 {
+		server.SendBadRequestResponse(c, errors.New("afterSequence must not be negative"), nil)
+		return
+	}
+
+	storedEvents, err := server.eventStore.Query(c.Request.Context(), adapters.APIToEventQuery(params, limit))
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	apiEvents, err := adapters.StoredEventToAPIList(storedEvents)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.StoredEventListResponse{
+		Events: apiEvents,
+	})
+}
+
+// Replay stored events
+// (POST /api/v1/events/replay)
+func (server *Server) PostApiV1EventsReplay(c *gin.Context) {
+	var input v1.ReplayInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	if input.Handler == "" {
+		server.SendBadRequestResponse(c, errors.New("handler is required"), nil)
+		return
+	}
+
+	replayed, err := server.replayer.Replay(c.Request.Context(), input.Handler, adapters.APIToReplayQuery(input))
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.ReplayResult{
+		Replayed: replayed,
+	})
+}
+
+const sseHeartbeatInterval = 15 * time.Second
+
+// Stream live events
+// (GET /api/v1/events/stream)
+func (server *Server) GetApiV1EventsStream(c *gin.Context, params v1.GetApiV1EventsStreamParams) {
+	var lastEventID uint64
+
+	if params.LastEventID != nil && *params.LastEventID != "" {
+		id, err := strconv.ParseUint(*params.LastEventID, 10, 64)
+		if err != nil {
+			server.SendBadRequestResponse(c, fmt.Errorf("invalid Last-Event-ID: %w", err), nil)
+			return
+		}
+
+		lastEventID = id
+	}
+
+	var eventTypes []string
+	if params.Type != nil {
+		eventTypes = *params.Type
+	}
+
+	subscription, err := server.broadcaster.Subscribe(c.Request.Context(), lastEventID, eventTypes)
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+	defer subscription.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := io.WriteString(c.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+		case event, ok := <-subscription.Events():
+			if !ok {
+				return
+			}
+
+			if _, err := fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.EventType, event.Payload); err != nil {
+				return
+			}
+		}
+
+		c.Writer.Flush()
+	}
+}
+
+// Get all webhooks
+// (GET /api/v1/webhooks)
+func (server *Server) GetApiV1Webhooks(c *gin.Context) {
+	var webhooks []*domain.WebhookSubscription
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		webhooks, err = repos.Webhooks().GetAllWebhooks(ctx)
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.WebhookListResponse{
+		Webhooks: adapters.DomainWebhookToAPIList(webhooks),
+	})
+}
+
+// Register a webhook
+// (POST /api/v1/webhooks)
+func (server *Server) PostApiV1Webhooks(c *gin.Context) {
+	var input v1.WebhookInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		server.SendBadRequestResponse(c, err, nil)
+		return
+	}
+
+	webhook, err := adapters.APIToNewDomainWebhook(input, server.timeProvider.Now())
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	err = server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Webhooks().AddWebhook(ctx, webhook)
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	// The secret is only disclosed once, when the webhook is registered
+	apiWebhook := adapters.DomainWebhookToAPI(webhook)
+	secret := string(webhook.Secret)
+	apiWebhook.Secret = &secret
+
+	c.JSON(http.StatusCreated, apiWebhook)
+}
+
+// Delete a webhook
+// (DELETE /api/v1/webhooks/{webhookId})
+func (server *Server) DeleteApiV1WebhooksWebhookId(c *gin.Context, webhookId openapi_types.UUID) {
+	err := server.unitOfWork.Do(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		return repos.Webhooks().DeleteWebhook(ctx, domain.WebhookID(webhookId))
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Get webhook by ID
+// (GET /api/v1/webhooks/{webhookId})
+func (server *Server) GetApiV1WebhooksWebhookId(c *gin.Context, webhookId openapi_types.UUID) {
+	var webhook *domain.WebhookSubscription
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		webhook, err = repos.Webhooks().GetWebhook(ctx, domain.WebhookID(webhookId))
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, adapters.DomainWebhookToAPI(webhook))
+}
+
+// Get webhook delivery attempts
+// (GET /api/v1/webhooks/{webhookId}/deliveries)
+func (server *Server) GetApiV1WebhooksWebhookIdDeliveries(c *gin.Context, webhookId openapi_types.UUID) {
+	var attempts []*domain.WebhookDeliveryAttempt
+
+	err := server.unitOfWork.View(c.Request.Context(), func(ctx context.Context, repos domain.Repositories) error {
+		var err error
+
+		attempts, err = repos.Webhooks().GetDeliveryAttempts(ctx, domain.WebhookID(webhookId))
+
+		return err
+	})
+	if err != nil {
+		server.SendErrorResponse(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.WebhookDeliveryListResponse{
+		Deliveries: adapters.DomainDeliveryAttemptToAPIList(attempts),
+	})
+}
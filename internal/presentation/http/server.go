@@ -4,36 +4,73 @@ import (
 	"github.com/maklybae/ddd-zoo/internal/application/services"
 	"github.com/maklybae/ddd-zoo/internal/domain"
 	v1 "github.com/maklybae/ddd-zoo/internal/types/openapi/v1"
+	"github.com/maklybae/ddd-zoo/pkg/events"
 )
 
 type Server struct {
-	animalRepo             domain.AnimalRepository
-	enclosureRepo          domain.EnclosureRepository
-	feedingScheduleRepo    domain.FeedingScheduleRepository
+	unitOfWork             domain.UnitOfWork
 	transferSvc            services.AnimalTransferService
 	feedingOrganizationSvc services.FeedingOrganizationService
+	medicalCareSvc         services.MedicalCareService
+	quarantineSvc          services.QuarantineService
+	placementSvc           services.PlacementPlannerService
+	foodInventorySvc       services.FoodInventoryService
+	foodForecastSvc        services.FoodForecastService
+	weightTrackingSvc      services.WeightTrackingService
+	vetSchedulingSvc       services.VetSchedulingService
+	medicationDosingSvc    services.MedicationDosingService
+	staffSchedulingSvc     services.StaffSchedulingService
+	keeperTasksSvc         services.KeeperTasksService
 	statisticsSvc          services.ZooStatisticsService
 	timeProvider           services.TimeProvider
+	deadLetters            events.DeadLetterQueue
+	eventStore             events.Store
+	replayer               *events.Replayer
+	broadcaster            *events.Broadcaster
 }
 
 var _ v1.ServerInterface = (*Server)(nil)
 
 func NewServer(
-	animalRepo domain.AnimalRepository,
-	enclosureRepo domain.EnclosureRepository,
-	feedingScheduleRepo domain.FeedingScheduleRepository,
+	unitOfWork domain.UnitOfWork,
 	transferSvc services.AnimalTransferService,
 	feedingOrganizationSvc services.FeedingOrganizationService,
+	medicalCareSvc services.MedicalCareService,
+	quarantineSvc services.QuarantineService,
+	placementSvc services.PlacementPlannerService,
+	foodInventorySvc services.FoodInventoryService,
+	foodForecastSvc services.FoodForecastService,
+	weightTrackingSvc services.WeightTrackingService,
+	vetSchedulingSvc services.VetSchedulingService,
+	medicationDosingSvc services.MedicationDosingService,
+	staffSchedulingSvc services.StaffSchedulingService,
+	keeperTasksSvc services.KeeperTasksService,
 	statisticsSvc services.ZooStatisticsService,
 	timeProvider services.TimeProvider,
+	deadLetters events.DeadLetterQueue,
+	eventStore events.Store,
+	replayer *events.Replayer,
+	broadcaster *events.Broadcaster,
 ) *Server {
 	return &Server{
-		animalRepo:             animalRepo,
-		enclosureRepo:          enclosureRepo,
-		feedingScheduleRepo:    feedingScheduleRepo,
+		unitOfWork:             unitOfWork,
 		transferSvc:            transferSvc,
 		feedingOrganizationSvc: feedingOrganizationSvc,
+		medicalCareSvc:         medicalCareSvc,
+		quarantineSvc:          quarantineSvc,
+		placementSvc:           placementSvc,
+		foodInventorySvc:       foodInventorySvc,
+		foodForecastSvc:        foodForecastSvc,
+		weightTrackingSvc:      weightTrackingSvc,
+		vetSchedulingSvc:       vetSchedulingSvc,
+		medicationDosingSvc:    medicationDosingSvc,
+		staffSchedulingSvc:     staffSchedulingSvc,
+		keeperTasksSvc:         keeperTasksSvc,
 		statisticsSvc:          statisticsSvc,
 		timeProvider:           timeProvider,
+		deadLetters:            deadLetters,
+		eventStore:             eventStore,
+		replayer:               replayer,
+		broadcaster:            broadcaster,
 	}
 }
@@ -21,8 +21,11 @@ const (
 
 // Defines values for AnimalStatus.
 const (
-	AnimalStatusHealthy AnimalStatus = "Healthy"
-	AnimalStatusSick    AnimalStatus = "Sick"
+	AnimalStatusHealthy          AnimalStatus = "Healthy"
+	AnimalStatusQuarantined      AnimalStatus = "Quarantined"
+	AnimalStatusRecovering       AnimalStatus = "Recovering"
+	AnimalStatusSick             AnimalStatus = "Sick"
+	AnimalStatusUnderObservation AnimalStatus = "UnderObservation"
 )
 
 // Defines values for AnimalInputGender.
@@ -34,7 +37,153 @@ const (
 // Defines values for AnimalInputStatus.
 const (
 	AnimalInputStatusHealthy AnimalInputStatus = "Healthy"
-	AnimalInputStatusSick    AnimalInputStatus = "Sick"
+)
+
+// Defines values for AnimalStatusInputStatus.
+const (
+	AnimalStatusInputStatusHealthy          AnimalStatusInputStatus = "Healthy"
+	AnimalStatusInputStatusQuarantined      AnimalStatusInputStatus = "Quarantined"
+	AnimalStatusInputStatusRecovering       AnimalStatusInputStatus = "Recovering"
+	AnimalStatusInputStatusSick             AnimalStatusInputStatus = "Sick"
+	AnimalStatusInputStatusUnderObservation AnimalStatusInputStatus = "UnderObservation"
+)
+
+// Defines values for ArrivingAnimalGender.
+const (
+	Female ArrivingAnimalGender = "Female"
+	Male   ArrivingAnimalGender = "Male"
+)
+
+// Defines values for ArrivingAnimalStatus.
+const (
+	ArrivingAnimalStatusHealthy ArrivingAnimalStatus = "Healthy"
+)
+
+// Defines values for Climate.
+const (
+	Arid      Climate = "Arid"
+	Polar     Climate = "Polar"
+	Temperate Climate = "Temperate"
+	Tropical  Climate = "Tropical"
+)
+
+// Defines values for EnclosureType.
+const (
+	EnclosureTypeAquarium        EnclosureType = "Aquarium"
+	EnclosureTypeAviary          EnclosureType = "Aviary"
+	EnclosureTypeDesert          EnclosureType = "Desert"
+	EnclosureTypeDesertTerrarium EnclosureType = "DesertTerrarium"
+	EnclosureTypeForest          EnclosureType = "Forest"
+	EnclosureTypePolarPool       EnclosureType = "PolarPool"
+	EnclosureTypePond            EnclosureType = "Pond"
+	EnclosureTypeQuarantine      EnclosureType = "Quarantine"
+	EnclosureTypeRainforest      EnclosureType = "Rainforest"
+	EnclosureTypeSavanna         EnclosureType = "Savanna"
+	EnclosureTypeTerrarium       EnclosureType = "Terrarium"
+	EnclosureTypeTropicalAviary  EnclosureType = "TropicalAviary"
+	EnclosureTypeTundra          EnclosureType = "Tundra"
+)
+
+// Defines values for FeedingOccurrenceStatus.
+const (
+	FeedingOccurrenceStatusDone    FeedingOccurrenceStatus = "Done"
+	FeedingOccurrenceStatusMissed  FeedingOccurrenceStatus = "Missed"
+	FeedingOccurrenceStatusPending FeedingOccurrenceStatus = "Pending"
+	FeedingOccurrenceStatusSkipped FeedingOccurrenceStatus = "Skipped"
+)
+
+// Defines values for FoodUnit.
+const (
+	G   FoodUnit = "g"
+	Kg  FoodUnit = "kg"
+	L   FoodUnit = "l"
+	Ml  FoodUnit = "ml"
+	Pcs FoodUnit = "pcs"
+)
+
+// Defines values for IllnessSeverity.
+const (
+	IllnessSeverityCritical IllnessSeverity = "Critical"
+	IllnessSeverityMild     IllnessSeverity = "Mild"
+	IllnessSeverityModerate IllnessSeverity = "Moderate"
+	IllnessSeveritySevere   IllnessSeverity = "Severe"
+)
+
+// Defines values for IllnessInputSeverity.
+const (
+	IllnessInputSeverityCritical IllnessInputSeverity = "Critical"
+	IllnessInputSeverityMild     IllnessInputSeverity = "Mild"
+	IllnessInputSeverityModerate IllnessInputSeverity = "Moderate"
+	IllnessInputSeveritySevere   IllnessInputSeverity = "Severe"
+)
+
+// Defines values for KeeperTaskAssignment.
+const (
+	KeeperTaskAssignmentExplicit KeeperTaskAssignment = "Explicit"
+	KeeperTaskAssignmentShift    KeeperTaskAssignment = "Shift"
+)
+
+// Defines values for KeeperTaskStatus.
+const (
+	KeeperTaskStatusDone    KeeperTaskStatus = "Done"
+	KeeperTaskStatusMissed  KeeperTaskStatus = "Missed"
+	KeeperTaskStatusPending KeeperTaskStatus = "Pending"
+	KeeperTaskStatusSkipped KeeperTaskStatus = "Skipped"
+)
+
+// Defines values for PlacementAssignmentAction.
+const (
+	Arrive PlacementAssignmentAction = "Arrive"
+	Move   PlacementAssignmentAction = "Move"
+	Stay   PlacementAssignmentAction = "Stay"
+)
+
+// Defines values for QuarantineClearanceInputStatus.
+const (
+	Healthy          QuarantineClearanceInputStatus = "Healthy"
+	Recovering       QuarantineClearanceInputStatus = "Recovering"
+	UnderObservation QuarantineClearanceInputStatus = "UnderObservation"
+)
+
+// Defines values for TaskKind.
+const (
+	Checkup  TaskKind = "Checkup"
+	Cleaning TaskKind = "Cleaning"
+	Feeding  TaskKind = "Feeding"
+)
+
+// Defines values for VetAppointmentStatus.
+const (
+	Cancelled VetAppointmentStatus = "Cancelled"
+	Completed VetAppointmentStatus = "Completed"
+	Scheduled VetAppointmentStatus = "Scheduled"
+)
+
+// Defines values for Weekday.
+const (
+	FR Weekday = "FR"
+	MO Weekday = "MO"
+	SA Weekday = "SA"
+	SU Weekday = "SU"
+	TH Weekday = "TH"
+	TU Weekday = "TU"
+	WE Weekday = "WE"
+)
+
+// Defines values for WeightAnomaly.
+const (
+	ExcessBodyCondition WeightAnomaly = "ExcessBodyCondition"
+	Overweight          WeightAnomaly = "Overweight"
+	PoorBodyCondition   WeightAnomaly = "PoorBodyCondition"
+	Underweight         WeightAnomaly = "Underweight"
+	WeightGain          WeightAnomaly = "WeightGain"
+	WeightLoss          WeightAnomaly = "WeightLoss"
+)
+
+// Defines values for GetApiV1ReportsFoodForecastParamsFormat.
+const (
+	Csv  GetApiV1ReportsFoodForecastParamsFormat = "csv"
+	Json GetApiV1ReportsFoodForecastParamsFormat = "json"
 )
 
 // Animal defines model for Animal.
@@ -63,13 +212,15 @@ type AnimalInput struct {
 	Gender       AnimalInputGender  `json:"gender"`
 	Name         string             `json:"name"`
 	Species      string             `json:"species"`
-	Status       AnimalInputStatus  `json:"status"`
+
+	// Status New animals are healthy; an animal becomes sick only through an illness report
+	Status AnimalInputStatus `json:"status"`
 }
 
 // AnimalInputGender defines model for AnimalInput.Gender.
 type AnimalInputGender string
 
-// AnimalInputStatus defines model for AnimalInput.Status.
+// AnimalInputStatus New animals are healthy; an animal becomes sick only through an illness report
 type AnimalInputStatus string
 
 // AnimalListResponse defines model for AnimalListResponse.
@@ -77,36 +228,212 @@ type AnimalListResponse struct {
 	Animals []Animal `json:"animals"`
 }
 
-// ApiErrorResponse defines model for ApiErrorResponse.
-type ApiErrorResponse struct {
-	// Details Additional error details
-	Details *map[string]interface{} `json:"details,omitempty"`
+// AnimalStatusInput defines model for AnimalStatusInput.
+type AnimalStatusInput struct {
+	Status AnimalStatusInputStatus `json:"status"`
+}
+
+// AnimalStatusInputStatus defines model for AnimalStatusInput.Status.
+type AnimalStatusInputStatus string
+
+// ArrivingAnimal An animal that does not exist yet
+type ArrivingAnimal struct {
+	BirthDate    time.Time            `json:"birthDate"`
+	FavoriteFood string               `json:"favoriteFood"`
+	Gender       ArrivingAnimalGender `json:"gender"`
+	Name         string               `json:"name"`
+	Species      string               `json:"species"`
+
+	// Status New animals are healthy; an animal becomes sick only through an illness report
+	Status ArrivingAnimalStatus `json:"status"`
+}
+
+// ArrivingAnimalGender defines model for ArrivingAnimal.Gender.
+type ArrivingAnimalGender string
+
+// ArrivingAnimalStatus New animals are healthy; an animal becomes sick only through an illness report
+type ArrivingAnimalStatus string
 
-	// Error Error type
+// CareTask defines model for CareTask.
+type CareTask struct {
+	// AssigneeId Keeper the task is assigned to, absent if it falls to the keeper on duty
+	AssigneeId *openapi_types.UUID `json:"assigneeId,omitempty"`
+
+	// Completed Occurrences that are done
+	Completed   []time.Time        `json:"completed"`
+	EnclosureId openapi_types.UUID `json:"enclosureId"`
+	Id          openapi_types.UUID `json:"id"`
+	Kind        TaskKind           `json:"kind"`
+
+	// Recurrence RRULE of a recurring task
+	Recurrence *string   `json:"recurrence,omitempty"`
+	Time       time.Time `json:"time"`
+
+	// TimeZone IANA time zone the occurrences of a recurring task are generated in
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// CareTaskInput defines model for CareTaskInput.
+type CareTaskInput struct {
+	// AssigneeId Keeper to assign the task to, the keeper on duty by default
+	AssigneeId  *openapi_types.UUID `json:"assigneeId,omitempty"`
+	EnclosureId openapi_types.UUID  `json:"enclosureId"`
+	Kind        TaskKind            `json:"kind"`
+
+	// Recurrence RFC 5545 RRULE making the task recurring, as for feeding schedules
+	Recurrence *string `json:"recurrence,omitempty"`
+
+	// Time Time of the task, or of the first occurrence of a recurring task
+	Time time.Time `json:"time"`
+
+	// TimeZone IANA time zone of the recurrence, UTC by default
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// CareTaskListResponse defines model for CareTaskListResponse.
+type CareTaskListResponse struct {
+	Tasks []CareTask `json:"tasks"`
+}
+
+// Climate defines model for Climate.
+type Climate string
+
+// DeadLetter defines model for DeadLetter.
+type DeadLetter struct {
+	// Attempts Number of delivery attempts made
+	Attempts int `json:"attempts"`
+
+	// Error Error returned by the last attempt
 	Error string `json:"error"`
 
-	// Message Human-readable error message
-	Message string `json:"message"`
+	// EventType Name of the event
+	EventType string    `json:"eventType"`
+	FailedAt  time.Time `json:"failedAt"`
 
-	// Timestamp When the error occurred
-	Timestamp time.Time `json:"timestamp"`
+	// Handler Name of the handler that failed to process the event
+	Handler string             `json:"handler"`
+	Id      openapi_types.UUID `json:"id"`
+
+	// Payload Serialized event
+	Payload map[string]interface{} `json:"payload"`
+}
+
+// DeadLetterListResponse defines model for DeadLetterListResponse.
+type DeadLetterListResponse struct {
+	DeadLetters []DeadLetter `json:"deadLetters"`
+}
+
+// Diagnosis defines model for Diagnosis.
+type Diagnosis struct {
+	Description string             `json:"description"`
+	DiagnosedAt time.Time          `json:"diagnosedAt"`
+	Id          openapi_types.UUID `json:"id"`
+	Vet         string             `json:"vet"`
+}
+
+// DiagnosisInput defines model for DiagnosisInput.
+type DiagnosisInput struct {
+	Description string `json:"description"`
+
+	// Vet Veterinarian who made the diagnosis
+	Vet string `json:"vet"`
+}
+
+// DoseRange defines model for DoseRange.
+type DoseRange struct {
+	Drug string `json:"drug"`
+
+	// GivenMg Largest amount of the drug given within any 24 hours containing the dose
+	GivenMg float64 `json:"givenMg"`
+
+	// MaxDailyDoseMg Largest total within 24 hours, absent if unlimited
+	MaxDailyDoseMg *float64 `json:"maxDailyDoseMg,omitempty"`
+	MaxDoseMg      float64  `json:"maxDoseMg"`
+	MinDoseMg      float64  `json:"minDoseMg"`
+
+	// RemainingDailyMg Amount that may still be given without exceeding the daily maximum in any 24 hours, absent if unlimited
+	RemainingDailyMg *float64  `json:"remainingDailyMg,omitempty"`
+	WeighedAt        time.Time `json:"weighedAt"`
+
+	// Weight Latest weight of the animal in kilograms the range is calculated for
+	Weight float64 `json:"weight"`
+}
+
+// Drug defines model for Drug.
+type Drug struct {
+	// Contraindications Conditions ruling the drug out. An animal has one if a diagnosis or an illness in its medical record mentions it, ignoring case.
+	Contraindications *[]string `json:"contraindications,omitempty"`
+
+	// Dosages Dosages of the drug, one per species it may be given to
+	Dosages []SpeciesDosage `json:"dosages"`
+	Name    string          `json:"name"`
+}
+
+// DrugAdministration defines model for DrugAdministration.
+type DrugAdministration struct {
+	AdministeredAt time.Time          `json:"administeredAt"`
+	AdministeredBy string             `json:"administeredBy"`
+	DoseMg         float64            `json:"doseMg"`
+	Drug           string             `json:"drug"`
+	Id             openapi_types.UUID `json:"id"`
+	TreatmentId    openapi_types.UUID `json:"treatmentId"`
+
+	// Weight Weight of the animal in kilograms the dose was checked against
+	Weight float64 `json:"weight"`
+}
+
+// DrugAdministrationInput defines model for DrugAdministrationInput.
+type DrugAdministrationInput struct {
+	// AdministeredAt Time the dose was given, now by default
+	AdministeredAt *time.Time `json:"administeredAt,omitempty"`
+
+	// AdministeredBy Keeper or vet who gave the dose
+	AdministeredBy string  `json:"administeredBy"`
+	DoseMg         float64 `json:"doseMg"`
+	Drug           string  `json:"drug"`
+
+	// TreatmentId Treatment in the medical record of the animal the dose is part of
+	TreatmentId openapi_types.UUID `json:"treatmentId"`
+}
+
+// DrugListResponse defines model for DrugListResponse.
+type DrugListResponse struct {
+	Drugs []Drug `json:"drugs"`
+}
+
+// DrugRules defines model for DrugRules.
+type DrugRules struct {
+	// Contraindications Conditions ruling the drug out. An animal has one if a diagnosis or an illness in its medical record mentions it, ignoring case.
+	Contraindications *[]string `json:"contraindications,omitempty"`
+
+	// Dosages Dosages of the drug, one per species it may be given to
+	Dosages []SpeciesDosage `json:"dosages"`
 }
 
 // Enclosure defines model for Enclosure.
 type Enclosure struct {
-	Animals        *[]Animal          `json:"animals,omitempty"`
-	CurrentAnimals int                `json:"currentAnimals"`
-	Id             openapi_types.UUID `json:"id"`
-	MaxCapacity    int                `json:"maxCapacity"`
-	Size           int                `json:"size"`
-	Type           string             `json:"type"`
+	Animals        *[]Animal `json:"animals,omitempty"`
+	CurrentAnimals int       `json:"currentAnimals"`
+
+	// Habitat Environment an enclosure provides. Quarantine enclosures have no climate and suit any species.
+	Habitat     Habitat            `json:"habitat"`
+	Id          openapi_types.UUID `json:"id"`
+	MaxCapacity int                `json:"maxCapacity"`
+
+	// RemainingArea Area not claimed by the residents, each of which claims the minimum area of its species
+	RemainingArea int `json:"remainingArea"`
+
+	// RemainingSlots Number of animals of every catalogued species the enclosure can still take, limited by its capacity and its remaining area. Species missing from the catalog can take every free place.
+	RemainingSlots map[string]int `json:"remainingSlots"`
+	Size           int            `json:"size"`
+	Type           EnclosureType  `json:"type"`
 }
 
 // EnclosureInput defines model for EnclosureInput.
 type EnclosureInput struct {
-	MaxCapacity int    `json:"maxCapacity"`
-	Size        int    `json:"size"`
-	Type        string `json:"type"`
+	MaxCapacity int           `json:"maxCapacity"`
+	Size        int           `json:"size"`
+	Type        EnclosureType `json:"type"`
 }
 
 // EnclosureListResponse defines model for EnclosureListResponse.
@@ -114,20 +441,88 @@ type EnclosureListResponse struct {
 	Enclosures []Enclosure `json:"enclosures"`
 }
 
+// EnclosureType defines model for EnclosureType.
+type EnclosureType string
+
+// EnclosureTypeInfo defines model for EnclosureTypeInfo.
+type EnclosureTypeInfo struct {
+	// Habitat Environment an enclosure provides. Quarantine enclosures have no climate and suit any species.
+	Habitat Habitat       `json:"habitat"`
+	Type    EnclosureType `json:"type"`
+}
+
+// EnclosureTypeListResponse defines model for EnclosureTypeListResponse.
+type EnclosureTypeListResponse struct {
+	EnclosureTypes []EnclosureTypeInfo `json:"enclosureTypes"`
+}
+
+// FeedingOccurrence defines model for FeedingOccurrence.
+type FeedingOccurrence struct {
+	// Status Missed feedings were still pending when a later one was completed
+	Status FeedingOccurrenceStatus `json:"status"`
+	Time   time.Time               `json:"time"`
+}
+
+// FeedingOccurrenceStatus Missed feedings were still pending when a later one was completed
+type FeedingOccurrenceStatus string
+
+// FeedingOccurrenceInput defines model for FeedingOccurrenceInput.
+type FeedingOccurrenceInput struct {
+	Time time.Time `json:"time"`
+}
+
+// FeedingOccurrenceListResponse defines model for FeedingOccurrenceListResponse.
+type FeedingOccurrenceListResponse struct {
+	Occurrences []FeedingOccurrence `json:"occurrences"`
+}
+
+// FeedingRunResult defines model for FeedingRunResult.
+type FeedingRunResult struct {
+	// Processed Number of feeding schedules processed during the run
+	Processed int `json:"processed"`
+
+	// RanAt When the run was performed
+	RanAt time.Time `json:"ranAt"`
+}
+
 // FeedingSchedule defines model for FeedingSchedule.
 type FeedingSchedule struct {
-	Animal      Animal             `json:"animal"`
+	Animal Animal `json:"animal"`
+
+	// AssigneeId Keeper the feedings are assigned to, absent if they fall to the keeper on duty
+	AssigneeId *openapi_types.UUID `json:"assigneeId,omitempty"`
+
+	// Completed For recurring schedules, whether every occurrence of a finite rule is done or skipped
 	Completed   bool               `json:"completed"`
 	FeedingTime time.Time          `json:"feedingTime"`
 	FoodType    string             `json:"foodType"`
 	Id          openapi_types.UUID `json:"id"`
+	Portion     *FoodQuantity      `json:"portion,omitempty"`
+
+	// Recurrence RRULE of a recurring schedule
+	Recurrence *string `json:"recurrence,omitempty"`
+
+	// TimeZone IANA time zone the occurrences of a recurring schedule are generated in
+	TimeZone *string `json:"timeZone,omitempty"`
 }
 
 // FeedingScheduleInput defines model for FeedingScheduleInput.
 type FeedingScheduleInput struct {
-	AnimalId    openapi_types.UUID `json:"animalId"`
-	FeedingTime time.Time          `json:"feedingTime"`
-	FoodType    string             `json:"foodType"`
+	AnimalId openapi_types.UUID `json:"animalId"`
+
+	// AssigneeId Keeper to assign the feedings to, the keeper on duty by default
+	AssigneeId *openapi_types.UUID `json:"assigneeId,omitempty"`
+
+	// FeedingTime Time of the feeding, or of the first feeding of a recurring schedule
+	FeedingTime time.Time     `json:"feedingTime"`
+	FoodType    string        `json:"foodType"`
+	Portion     *FoodQuantity `json:"portion,omitempty"`
+
+	// Recurrence RFC 5545 RRULE making the schedule recurring. FREQ may be HOURLY, DAILY or WEEKLY; INTERVAL, BYDAY, COUNT and UNTIL are supported.
+	Recurrence *string `json:"recurrence,omitempty"`
+
+	// TimeZone IANA time zone of the recurrence, UTC by default
+	TimeZone *string `json:"timeZone,omitempty"`
 }
 
 // FeedingScheduleListResponse defines model for FeedingScheduleListResponse.
@@ -135,59 +530,1130 @@ type FeedingScheduleListResponse struct {
 	Schedules []FeedingSchedule `json:"schedules"`
 }
 
+// FoodDemand defines model for FoodDemand.
+type FoodDemand struct {
+	// Covered Whether the stock holds enough food for the demand
+	Covered bool `json:"covered"`
+
+	// Error Why the portions of the food could not be added up, absent if they could
+	Error *string `json:"error,omitempty"`
+
+	// Feedings Number of pending feedings of the food within the horizon
+	Feedings int    `json:"feedings"`
+	Food     string `json:"food"`
+
+	// InStock Amount in stock, absent if the food is not stocked
+	InStock *float64 `json:"inStock,omitempty"`
+
+	// Required Total of the portions, in the stock's unit if the food is stocked
+	Required float64 `json:"required"`
+
+	// Shortfall Amount to order to cover the demand
+	Shortfall float64   `json:"shortfall"`
+	Unit      *FoodUnit `json:"unit,omitempty"`
+
+	// UnportionedFeedings Feedings without a portion, not included in the required amount
+	UnportionedFeedings int `json:"unportionedFeedings"`
+}
+
+// FoodForecast defines model for FoodForecast.
+type FoodForecast struct {
+	Demands []FoodDemand `json:"demands"`
+	From    time.Time    `json:"from"`
+	To      time.Time    `json:"to"`
+}
+
+// FoodQuantity defines model for FoodQuantity.
+type FoodQuantity struct {
+	Amount float64  `json:"amount"`
+	Unit   FoodUnit `json:"unit"`
+}
+
+// FoodStock defines model for FoodStock.
+type FoodStock struct {
+	Food string `json:"food"`
+	Low  bool   `json:"low"`
+
+	// LowStockThreshold Amount at or below which the stock is low
+	LowStockThreshold float64 `json:"lowStockThreshold"`
+
+	// Quantity Amount in stock, in the stock's unit
+	Quantity float64 `json:"quantity"`
+
+	// Short Whether a feeding did not take place for lack of the food since the last delivery
+	Short bool     `json:"short"`
+	Unit  FoodUnit `json:"unit"`
+}
+
+// FoodStockInput defines model for FoodStockInput.
+type FoodStockInput struct {
+	// Food Name of the food, matching the food type of feeding schedules
+	Food string `json:"food"`
+
+	// LowStockThreshold Amount at or below which the stock is low, 0 by default
+	LowStockThreshold *float64 `json:"lowStockThreshold,omitempty"`
+
+	// Quantity Initial amount in stock, in the stock's unit
+	Quantity *float64 `json:"quantity,omitempty"`
+	Unit     FoodUnit `json:"unit"`
+}
+
+// FoodStockListResponse defines model for FoodStockListResponse.
+type FoodStockListResponse struct {
+	Stocks []FoodStock `json:"stocks"`
+}
+
+// FoodUnit defines model for FoodUnit.
+type FoodUnit string
+
+// Habitat Environment an enclosure provides. Quarantine enclosures have no climate and suit any species.
+type Habitat struct {
+	Aquatic   bool     `json:"aquatic"`
+	Aviary    bool     `json:"aviary"`
+	Climate   *Climate `json:"climate,omitempty"`
+	Terrarium bool     `json:"terrarium"`
+}
+
+// HabitatRequirements Environment a species needs. The species lives only in aquatic enclosures, aviaries or terrariums exactly when it needs one.
+type HabitatRequirements struct {
+	Aquatic *bool `json:"aquatic,omitempty"`
+	Aviary  *bool `json:"aviary,omitempty"`
+
+	// Climates Tolerated climates, the preferred one first. Any climate suits the species if empty.
+	Climates  *[]Climate `json:"climates,omitempty"`
+	Terrarium *bool      `json:"terrarium,omitempty"`
+}
+
+// Illness defines model for Illness.
+type Illness struct {
+	Diagnosis  string             `json:"diagnosis"`
+	Id         openapi_types.UUID `json:"id"`
+	ReportedAt time.Time          `json:"reportedAt"`
+	ReportedBy string             `json:"reportedBy"`
+	Severity   IllnessSeverity    `json:"severity"`
+}
+
+// IllnessSeverity defines model for Illness.Severity.
+type IllnessSeverity string
+
+// IllnessInput defines model for IllnessInput.
+type IllnessInput struct {
+	Diagnosis string `json:"diagnosis"`
+
+	// ReportedBy Keeper or vet who noticed the illness
+	ReportedBy string               `json:"reportedBy"`
+	Severity   IllnessInputSeverity `json:"severity"`
+}
+
+// IllnessInputSeverity defines model for IllnessInput.Severity.
+type IllnessInputSeverity string
+
+// IllnessReportResult defines model for IllnessReportResult.
+type IllnessReportResult struct {
+	Animal  Animal  `json:"animal"`
+	Illness Illness `json:"illness"`
+}
+
+// Keeper defines model for Keeper.
+type Keeper struct {
+	Assignments    []KeeperAssignment `json:"assignments"`
+	Id             openapi_types.UUID `json:"id"`
+	MaxEnclosures  int                `json:"maxEnclosures"`
+	Name           string             `json:"name"`
+	Qualifications []string           `json:"qualifications"`
+}
+
+// KeeperAssignment defines model for KeeperAssignment.
+type KeeperAssignment struct {
+	EnclosureId openapi_types.UUID `json:"enclosureId"`
+	Shift       string             `json:"shift"`
+}
+
+// KeeperInput defines model for KeeperInput.
+type KeeperInput struct {
+	// MaxEnclosures Number of enclosures the keeper may be assigned in a single shift
+	MaxEnclosures int    `json:"maxEnclosures"`
+	Name          string `json:"name"`
+
+	// Qualifications Dangerous species the keeper may care for. Species are dangerous if the species catalog marks them as predators.
+	Qualifications *[]string `json:"qualifications,omitempty"`
+}
+
+// KeeperListResponse defines model for KeeperListResponse.
+type KeeperListResponse struct {
+	Keepers []Keeper `json:"keepers"`
+}
+
+// KeeperOnDuty defines model for KeeperOnDuty.
+type KeeperOnDuty struct {
+	// From Start of the shift in progress
+	From     time.Time          `json:"from"`
+	KeeperId openapi_types.UUID `json:"keeperId"`
+	Name     string             `json:"name"`
+	Shift    string             `json:"shift"`
+
+	// Until End of the shift in progress
+	Until time.Time `json:"until"`
+}
+
+// KeeperTask defines model for KeeperTask.
+type KeeperTask struct {
+	// AnimalId Fed animal of a feeding
+	AnimalId   *openapi_types.UUID `json:"animalId,omitempty"`
+	AnimalName *string             `json:"animalName,omitempty"`
+
+	// Assignment Whether the task is assigned to the keeper or falls to them by their shift
+	Assignment KeeperTaskAssignment `json:"assignment"`
+
+	// EnclosureId Enclosure of the task, absent for feedings of animals outside enclosures
+	EnclosureId *openapi_types.UUID `json:"enclosureId,omitempty"`
+	FoodType    *string             `json:"foodType,omitempty"`
+	Kind        TaskKind            `json:"kind"`
+	Portion     *FoodQuantity       `json:"portion,omitempty"`
+	Status      KeeperTaskStatus    `json:"status"`
+
+	// TaskId Identifier of the feeding schedule or of the care task
+	TaskId openapi_types.UUID `json:"taskId"`
+	Time   time.Time          `json:"time"`
+}
+
+// KeeperTaskAssignment Whether the task is assigned to the keeper or falls to them by their shift
+type KeeperTaskAssignment string
+
+// KeeperTaskStatus defines model for KeeperTask.Status.
+type KeeperTaskStatus string
+
+// KeeperTaskCompletionInput defines model for KeeperTaskCompletionInput.
+type KeeperTaskCompletionInput struct {
+	Kind   TaskKind           `json:"kind"`
+	TaskId openapi_types.UUID `json:"taskId"`
+
+	// Time Occurrence of the task to complete, which cannot be in the future
+	Time time.Time `json:"time"`
+}
+
+// KeeperTaskList defines model for KeeperTaskList.
+type KeeperTaskList struct {
+	Date     openapi_types.Date `json:"date"`
+	KeeperId openapi_types.UUID `json:"keeperId"`
+	Tasks    []KeeperTask       `json:"tasks"`
+	TimeZone string             `json:"timeZone"`
+}
+
+// LowStockReport defines model for LowStockReport.
+type LowStockReport struct {
+	GeneratedAt time.Time   `json:"generatedAt"`
+	Stocks      []FoodStock `json:"stocks"`
+}
+
+// MedicalRecord defines model for MedicalRecord.
+type MedicalRecord struct {
+	// Administrations Doses of drugs given as part of the treatments
+	Administrations []DrugAdministration `json:"administrations"`
+	AnimalId        openapi_types.UUID   `json:"animalId"`
+	Diagnoses       []Diagnosis          `json:"diagnoses"`
+	Illnesses       []Illness            `json:"illnesses"`
+
+	// OpenedAt Time of the first entry, absent if nothing has been recorded yet
+	OpenedAt   *time.Time  `json:"openedAt,omitempty"`
+	Treatments []Treatment `json:"treatments"`
+}
+
+// Medication defines model for Medication.
+type Medication struct {
+	Dosage string `json:"dosage"`
+	Name   string `json:"name"`
+}
+
 // MoveAnimalInput defines model for MoveAnimalInput.
 type MoveAnimalInput struct {
 	NewEnclosureId openapi_types.UUID `json:"newEnclosureId"`
 }
 
-// ZooStatistics defines model for ZooStatistics.
-type ZooStatistics struct {
-	CompletedFeedingsToday int `json:"completedFeedingsToday"`
-	FeedingSchedulesCount  int `json:"feedingSchedulesCount"`
-	FreeEnclosures         int `json:"freeEnclosures"`
-	HealthyAnimals         int `json:"healthyAnimals"`
-	PendingFeedingsToday   int `json:"pendingFeedingsToday"`
-	SickAnimals            int `json:"sickAnimals"`
-	TotalAnimals           int `json:"totalAnimals"`
-	TotalEnclosures        int `json:"totalEnclosures"`
+// PlacementAssignment defines model for PlacementAssignment.
+type PlacementAssignment struct {
+	Action *PlacementAssignmentAction `json:"action,omitempty"`
+
+	// AnimalId Identifier of the animal; arriving animals get it when they are planned
+	AnimalId openapi_types.UUID `json:"animalId"`
+
+	// Arrival An animal that does not exist yet
+	Arrival *ArrivingAnimal `json:"arrival,omitempty"`
+
+	// Fit How well the habitat suits the species, 1 for its preferred climate
+	Fit             *float64            `json:"fit,omitempty"`
+	FromEnclosureId *openapi_types.UUID `json:"fromEnclosureId,omitempty"`
+	ToEnclosureId   openapi_types.UUID  `json:"toEnclosureId"`
 }
 
-// PostApiV1AnimalsJSONRequestBody defines body for PostApiV1Animals for application/json ContentType.
-type PostApiV1AnimalsJSONRequestBody = AnimalInput
+// PlacementAssignmentAction defines model for PlacementAssignment.Action.
+type PlacementAssignmentAction string
 
-// PostApiV1AnimalsAnimalIdMoveJSONRequestBody defines body for PostApiV1AnimalsAnimalIdMove for application/json ContentType.
-type PostApiV1AnimalsAnimalIdMoveJSONRequestBody = MoveAnimalInput
+// PlacementExecutionInput defines model for PlacementExecutionInput.
+type PlacementExecutionInput struct {
+	Placements []PlacementAssignment `json:"placements"`
+}
 
-// PostApiV1EnclosuresJSONRequestBody defines body for PostApiV1Enclosures for application/json ContentType.
-type PostApiV1EnclosuresJSONRequestBody = EnclosureInput
+// PlacementPlan defines model for PlacementPlan.
+type PlacementPlan struct {
+	// Moves Number of existing animals that change their enclosure
+	Moves int `json:"moves"`
 
-// PostApiV1FeedingSchedulesJSONRequestBody defines body for PostApiV1FeedingSchedules for application/json ContentType.
-type PostApiV1FeedingSchedulesJSONRequestBody = FeedingScheduleInput
+	// Placements Placements in the order they must be executed
+	Placements []PlacementAssignment `json:"placements"`
+	Unplaced   []UnplacedAnimal      `json:"unplaced"`
+}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get all animals
-	// (GET /api/v1/animals)
-	GetApiV1Animals(c *gin.Context)
-	// Add a new animal
-	// (POST /api/v1/animals)
-	PostApiV1Animals(c *gin.Context)
-	// Delete an animal
-	// (DELETE /api/v1/animals/{animalId})
-	DeleteApiV1AnimalsAnimalId(c *gin.Context, animalId openapi_types.UUID)
-	// Get animal by ID
-	// (GET /api/v1/animals/{animalId})
-	GetApiV1AnimalsAnimalId(c *gin.Context, animalId openapi_types.UUID)
-	// Move an animal to a new enclosure
-	// (POST /api/v1/animals/{animalId}/move)
-	PostApiV1AnimalsAnimalIdMove(c *gin.Context, animalId openapi_types.UUID)
-	// Treat a sick animal
-	// (POST /api/v1/animals/{animalId}/treat)
-	PostApiV1AnimalsAnimalIdTreat(c *gin.Context, animalId openapi_types.UUID)
-	// Get all enclosures
-	// (GET /api/v1/enclosures)
-	GetApiV1Enclosures(c *gin.Context)
-	// Add a new enclosure
+// PlacementRequest defines model for PlacementRequest.
+type PlacementRequest struct {
+	// AnimalIds Existing animals to place
+	AnimalIds *[]openapi_types.UUID `json:"animalIds,omitempty"`
+
+	// Arrivals Arriving animals to place
+	Arrivals *[]ArrivingAnimal `json:"arrivals,omitempty"`
+}
+
+// Problem RFC 7807 problem details
+type Problem struct {
+	// Code Stable machine-readable error code
+	Code string `json:"code"`
+
+	// Detail Human-readable explanation of this occurrence
+	Detail *string `json:"detail,omitempty"`
+
+	// Details Additional error details
+	Details *map[string]interface{} `json:"details,omitempty"`
+
+	// Instance Request path that caused the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP status code
+	Status int `json:"status"`
+
+	// Timestamp When the error occurred
+	Timestamp time.Time `json:"timestamp"`
+
+	// Title Short human-readable summary of the problem type
+	Title string `json:"title"`
+
+	// Type URI reference identifying the problem type
+	Type string `json:"type"`
+}
+
+// Quarantine defines model for Quarantine.
+type Quarantine struct {
+	Active    bool               `json:"active"`
+	AnimalId  openapi_types.UUID `json:"animalId"`
+	ClearedBy *string            `json:"clearedBy,omitempty"`
+
+	// EnclosureId Quarantine enclosure
+	EnclosureId openapi_types.UUID `json:"enclosureId"`
+	EndedAt     *time.Time         `json:"endedAt,omitempty"`
+
+	// FromEnclosureId Enclosure the animal was taken from
+	FromEnclosureId *openapi_types.UUID `json:"fromEnclosureId,omitempty"`
+	Id              openapi_types.UUID  `json:"id"`
+	OrderedBy       string              `json:"orderedBy"`
+	Reason          string              `json:"reason"`
+	StartedAt       time.Time           `json:"startedAt"`
+}
+
+// QuarantineClearanceInput defines model for QuarantineClearanceInput.
+type QuarantineClearanceInput struct {
+	// ClearedBy Vet who cleared the quarantine
+	ClearedBy string `json:"clearedBy"`
+
+	// Status Status of the animal after the quarantine
+	Status *QuarantineClearanceInputStatus `json:"status,omitempty"`
+
+	// ToEnclosureId Regular enclosure to move the animal to, the enclosure it was taken from if omitted
+	ToEnclosureId *openapi_types.UUID `json:"toEnclosureId,omitempty"`
+}
+
+// QuarantineClearanceInputStatus Status of the animal after the quarantine
+type QuarantineClearanceInputStatus string
+
+// QuarantineInput defines model for QuarantineInput.
+type QuarantineInput struct {
+	// EnclosureId Quarantine enclosure to use, the first one with free space if omitted
+	EnclosureId *openapi_types.UUID `json:"enclosureId,omitempty"`
+
+	// OrderedBy Vet who ordered the quarantine
+	OrderedBy string `json:"orderedBy"`
+	Reason    string `json:"reason"`
+}
+
+// QuarantineListResponse defines model for QuarantineListResponse.
+type QuarantineListResponse struct {
+	Quarantines []Quarantine `json:"quarantines"`
+}
+
+// ReplayInput defines model for ReplayInput.
+type ReplayInput struct {
+	// AggregateId Only replay events concerning this aggregate
+	AggregateId *string `json:"aggregateId,omitempty"`
+
+	// From Only replay events recorded at or after this time
+	From *time.Time `json:"from,omitempty"`
+
+	// Handler Name of the handler to feed the events to
+	Handler string `json:"handler"`
+
+	// To Only replay events recorded before this time
+	To *time.Time `json:"to,omitempty"`
+
+	// Types Only replay events of these types
+	Types *[]string `json:"types,omitempty"`
+}
+
+// ReplayResult defines model for ReplayResult.
+type ReplayResult struct {
+	// Replayed Number of events handled by the handler
+	Replayed int `json:"replayed"`
+}
+
+// ResponsibleKeepers defines model for ResponsibleKeepers.
+type ResponsibleKeepers struct {
+	At          time.Time          `json:"at"`
+	EnclosureId openapi_types.UUID `json:"enclosureId"`
+
+	// Keepers Keepers on duty ordered by name, empty if nobody is responsible at the moment
+	Keepers []KeeperOnDuty `json:"keepers"`
+}
+
+// Shift defines model for Shift.
+type Shift struct {
+	// Days Weekdays the shift starts on, every day if empty
+	Days *[]Weekday `json:"days,omitempty"`
+
+	// End Wall-clock time the shift ends at, on the next day if it is not after the start
+	End  string `json:"end"`
+	Name string `json:"name"`
+
+	// Start Wall-clock time the shift starts at
+	Start string `json:"start"`
+
+	// TimeZone IANA time zone of the shift times, UTC by default
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// ShiftListResponse defines model for ShiftListResponse.
+type ShiftListResponse struct {
+	Shifts []Shift `json:"shifts"`
+}
+
+// ShiftRules defines model for ShiftRules.
+type ShiftRules struct {
+	// Days Weekdays the shift starts on, every day if empty
+	Days *[]Weekday `json:"days,omitempty"`
+
+	// End Wall-clock time the shift ends at, on the next day if it is not after the start
+	End string `json:"end"`
+
+	// Start Wall-clock time the shift starts at
+	Start string `json:"start"`
+
+	// TimeZone IANA time zone of the shift times, UTC by default
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// Species defines model for Species.
+type Species struct {
+	// Habitat Environment a species needs. The species lives only in aquatic enclosures, aviaries or terrariums exactly when it needs one.
+	Habitat *HabitatRequirements `json:"habitat,omitempty"`
+
+	// MaxPerEnclosure Maximum number of animals of the species in one enclosure, 0 if unlimited
+	MaxPerEnclosure *int `json:"maxPerEnclosure,omitempty"`
+
+	// MinArea Enclosure area every animal of the species needs, 0 if it has no requirement
+	MinArea *int `json:"minArea,omitempty"`
+
+	// MixedGenders Whether males and females of the species may share an enclosure
+	MixedGenders *bool  `json:"mixedGenders,omitempty"`
+	Name         string `json:"name"`
+
+	// Predator Predators only share enclosures with their own species
+	Predator *bool `json:"predator,omitempty"`
+
+	// Solitary Solitary animals are kept alone
+	Solitary *bool `json:"solitary,omitempty"`
+
+	// WeightNorms Normal weight of a species. Zero values mean no bound and the default window and change.
+	WeightNorms *WeightNorms `json:"weightNorms,omitempty"`
+}
+
+// SpeciesDosage defines model for SpeciesDosage.
+type SpeciesDosage struct {
+	// MaxDailyMgPerKg Largest total within 24 hours in milligrams per kilogram, 0 if unlimited
+	MaxDailyMgPerKg *float64 `json:"maxDailyMgPerKg,omitempty"`
+
+	// MaxMgPerKg Largest single dose in milligrams per kilogram of body weight
+	MaxMgPerKg float64 `json:"maxMgPerKg"`
+
+	// MinMgPerKg Smallest single dose in milligrams per kilogram of body weight
+	MinMgPerKg float64 `json:"minMgPerKg"`
+	Species    string  `json:"species"`
+}
+
+// SpeciesListResponse defines model for SpeciesListResponse.
+type SpeciesListResponse struct {
+	Species []Species `json:"species"`
+}
+
+// SpeciesRules defines model for SpeciesRules.
+type SpeciesRules struct {
+	// Habitat Environment a species needs. The species lives only in aquatic enclosures, aviaries or terrariums exactly when it needs one.
+	Habitat *HabitatRequirements `json:"habitat,omitempty"`
+
+	// MaxPerEnclosure Maximum number of animals of the species in one enclosure, 0 if unlimited
+	MaxPerEnclosure *int `json:"maxPerEnclosure,omitempty"`
+
+	// MinArea Enclosure area every animal of the species needs, 0 if it has no requirement
+	MinArea *int `json:"minArea,omitempty"`
+
+	// MixedGenders Whether males and females of the species may share an enclosure
+	MixedGenders *bool `json:"mixedGenders,omitempty"`
+
+	// Predator Predators only share enclosures with their own species
+	Predator *bool `json:"predator,omitempty"`
+
+	// Solitary Solitary animals are kept alone
+	Solitary *bool `json:"solitary,omitempty"`
+
+	// WeightNorms Normal weight of a species. Zero values mean no bound and the default window and change.
+	WeightNorms *WeightNorms `json:"weightNorms,omitempty"`
+}
+
+// StoredEvent defines model for StoredEvent.
+type StoredEvent struct {
+	// AggregateIds Identifiers of the aggregates the event concerns
+	AggregateIds []string `json:"aggregateIds"`
+
+	// Payload Serialized event
+	Payload    map[string]interface{} `json:"payload"`
+	RecordedAt time.Time              `json:"recordedAt"`
+
+	// Sequence Position of the event in the store
+	Sequence int64 `json:"sequence"`
+
+	// Type Name of the event
+	Type string `json:"type"`
+}
+
+// StoredEventListResponse defines model for StoredEventListResponse.
+type StoredEventListResponse struct {
+	Events []StoredEvent `json:"events"`
+}
+
+// SuitableEnclosure defines model for SuitableEnclosure.
+type SuitableEnclosure struct {
+	Enclosure Enclosure `json:"enclosure"`
+
+	// Fit How well the habitat suits the species, 1 for its preferred climate
+	Fit float64 `json:"fit"`
+
+	// FreeSpace Number of animals of the species the enclosure can still take
+	FreeSpace int `json:"freeSpace"`
+}
+
+// SuitableEnclosureListResponse defines model for SuitableEnclosureListResponse.
+type SuitableEnclosureListResponse struct {
+	Enclosures []SuitableEnclosure `json:"enclosures"`
+}
+
+// TaskAssigneeInput defines model for TaskAssigneeInput.
+type TaskAssigneeInput struct {
+	// KeeperId Keeper to assign the task to, absent to leave it to the keeper on duty
+	KeeperId *openapi_types.UUID `json:"keeperId,omitempty"`
+}
+
+// TaskKind defines model for TaskKind.
+type TaskKind string
+
+// TransferBatch defines model for TransferBatch.
+type TransferBatch struct {
+	Id            openapi_types.UUID `json:"id"`
+	TransferredAt time.Time          `json:"transferredAt"`
+	Transfers     []TransferRecord   `json:"transfers"`
+}
+
+// TransferBatchInput defines model for TransferBatchInput.
+type TransferBatchInput struct {
+	Transfers []TransferInput `json:"transfers"`
+}
+
+// TransferInput defines model for TransferInput.
+type TransferInput struct {
+	AnimalId      openapi_types.UUID `json:"animalId"`
+	ToEnclosureId openapi_types.UUID `json:"toEnclosureId"`
+}
+
+// TransferRecord defines model for TransferRecord.
+type TransferRecord struct {
+	AnimalId openapi_types.UUID `json:"animalId"`
+
+	// FromEnclosureId Absent if the animal had no enclosure
+	FromEnclosureId *openapi_types.UUID `json:"fromEnclosureId,omitempty"`
+	ToEnclosureId   openapi_types.UUID  `json:"toEnclosureId"`
+}
+
+// Treatment defines model for Treatment.
+type Treatment struct {
+	Description string              `json:"description"`
+	DiagnosisId *openapi_types.UUID `json:"diagnosisId,omitempty"`
+	Id          openapi_types.UUID  `json:"id"`
+	Medications []Medication        `json:"medications"`
+	TreatedAt   time.Time           `json:"treatedAt"`
+	Vet         string              `json:"vet"`
+}
+
+// TreatmentInput defines model for TreatmentInput.
+type TreatmentInput struct {
+	Description string `json:"description"`
+
+	// DiagnosisId Diagnosis from the animal's medical record addressed by the treatment
+	DiagnosisId *openapi_types.UUID `json:"diagnosisId,omitempty"`
+	Medications *[]Medication       `json:"medications,omitempty"`
+
+	// Vet Veterinarian who gave the treatment
+	Vet string `json:"vet"`
+}
+
+// UnplacedAnimal defines model for UnplacedAnimal.
+type UnplacedAnimal struct {
+	AnimalId openapi_types.UUID `json:"animalId"`
+
+	// Arrival An animal that does not exist yet
+	Arrival *ArrivingAnimal `json:"arrival,omitempty"`
+
+	// Reason Problem code explaining why the animal could not be placed
+	Reason string `json:"reason"`
+}
+
+// VetAppointment defines model for VetAppointment.
+type VetAppointment struct {
+	Anesthesia bool               `json:"anesthesia"`
+	AnimalId   openapi_types.UUID `json:"animalId"`
+	End        time.Time          `json:"end"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// Outcome Conclusion of the vet, set once the appointment is completed
+	Outcome   *string              `json:"outcome,omitempty"`
+	Procedure string               `json:"procedure"`
+	Start     time.Time            `json:"start"`
+	Status    VetAppointmentStatus `json:"status"`
+	Vet       string               `json:"vet"`
+}
+
+// VetAppointmentInput defines model for VetAppointmentInput.
+type VetAppointmentInput struct {
+	// Anesthesia Whether the procedure is under anesthesia, which requires 12 hours of fasting before it
+	Anesthesia *bool              `json:"anesthesia,omitempty"`
+	AnimalId   openapi_types.UUID `json:"animalId"`
+	End        time.Time          `json:"end"`
+	Procedure  string             `json:"procedure"`
+	Start      time.Time          `json:"start"`
+	Vet        string             `json:"vet"`
+}
+
+// VetAppointmentListResponse defines model for VetAppointmentListResponse.
+type VetAppointmentListResponse struct {
+	Appointments []VetAppointment `json:"appointments"`
+}
+
+// VetAppointmentOutcomeInput defines model for VetAppointmentOutcomeInput.
+type VetAppointmentOutcomeInput struct {
+	Outcome string `json:"outcome"`
+}
+
+// VetAppointmentRescheduleInput defines model for VetAppointmentRescheduleInput.
+type VetAppointmentRescheduleInput struct {
+	// Anesthesia Whether the procedure is under anesthesia, which requires 12 hours of fasting before it
+	Anesthesia *bool     `json:"anesthesia,omitempty"`
+	End        time.Time `json:"end"`
+	Procedure  string    `json:"procedure"`
+	Start      time.Time `json:"start"`
+	Vet        string    `json:"vet"`
+}
+
+// VetAppointmentStatus defines model for VetAppointmentStatus.
+type VetAppointmentStatus string
+
+// VetCalendar defines model for VetCalendar.
+type VetCalendar struct {
+	Days     []VetCalendarDay `json:"days"`
+	TimeZone string           `json:"timeZone"`
+}
+
+// VetCalendarDay defines model for VetCalendarDay.
+type VetCalendarDay struct {
+	Appointments []VetAppointment   `json:"appointments"`
+	Date         openapi_types.Date `json:"date"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Events Names of the events delivered to the webhook, "*" for all events
+	Events []string           `json:"events"`
+	Id     openapi_types.UUID `json:"id"`
+
+	// Secret Key of the HMAC-SHA256 signature, only returned when the webhook is registered
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Endpoint receiving the events
+	Url string `json:"url"`
+}
+
+// WebhookDeliveryAttempt defines model for WebhookDeliveryAttempt.
+type WebhookDeliveryAttempt struct {
+	// Attempt Attempt number, starting from 1
+	Attempt     int       `json:"attempt"`
+	AttemptedAt time.Time `json:"attemptedAt"`
+
+	// DeliveryId Shared by all attempts to deliver the same event
+	DeliveryId openapi_types.UUID `json:"deliveryId"`
+	DurationMs int64              `json:"durationMs"`
+	Error      *string            `json:"error,omitempty"`
+	Event      string             `json:"event"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// StatusCode Response status code, absent if no response was received
+	StatusCode *int `json:"statusCode,omitempty"`
+	Succeeded  bool `json:"succeeded"`
+}
+
+// WebhookDeliveryListResponse defines model for WebhookDeliveryListResponse.
+type WebhookDeliveryListResponse struct {
+	Deliveries []WebhookDeliveryAttempt `json:"deliveries"`
+}
+
+// WebhookInput defines model for WebhookInput.
+type WebhookInput struct {
+	// Events Names of the events to deliver, "*" for all events
+	Events []string `json:"events"`
+
+	// Url Absolute http or https URL receiving the events
+	Url string `json:"url"`
+}
+
+// WebhookListResponse defines model for WebhookListResponse.
+type WebhookListResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// Weekday RFC 5545 weekday code
+type Weekday string
+
+// WeightAnomaly defines model for WeightAnomaly.
+type WeightAnomaly string
+
+// WeightHistory defines model for WeightHistory.
+type WeightHistory struct {
+	AnimalId     openapi_types.UUID  `json:"animalId"`
+	Measurements []WeightMeasurement `json:"measurements"`
+	Trend        WeightTrend         `json:"trend"`
+}
+
+// WeightMeasurement defines model for WeightMeasurement.
+type WeightMeasurement struct {
+	// BodyConditionScore Body condition on the 9-point scale, absent if it was not assessed
+	BodyConditionScore *int      `json:"bodyConditionScore,omitempty"`
+	MeasuredAt         time.Time `json:"measuredAt"`
+	MeasuredBy         *string   `json:"measuredBy,omitempty"`
+
+	// Weight Weight in kilograms
+	Weight float64 `json:"weight"`
+}
+
+// WeightMeasurementInput defines model for WeightMeasurementInput.
+type WeightMeasurementInput struct {
+	// BodyConditionScore Body condition on the 9-point scale, 4 to 6 is ideal
+	BodyConditionScore *int `json:"bodyConditionScore,omitempty"`
+
+	// MeasuredAt Time of the weighing, now by default
+	MeasuredAt *time.Time `json:"measuredAt,omitempty"`
+	MeasuredBy *string    `json:"measuredBy,omitempty"`
+
+	// Weight Weight in kilograms
+	Weight float64 `json:"weight"`
+}
+
+// WeightMeasurementResult defines model for WeightMeasurementResult.
+type WeightMeasurementResult struct {
+	Measurement WeightMeasurement `json:"measurement"`
+	Trend       WeightTrend       `json:"trend"`
+}
+
+// WeightNorms Normal weight of a species. Zero values mean no bound and the default window and change.
+type WeightNorms struct {
+	// MaxChangePercent Largest gain or loss within the window considered normal, in percent; 0 for the default of 10
+	MaxChangePercent *float64 `json:"maxChangePercent,omitempty"`
+
+	// MaxWeight Highest normal weight in kilograms, 0 if unbounded
+	MaxWeight *float64 `json:"maxWeight,omitempty"`
+
+	// MinWeight Lowest normal weight in kilograms, 0 if unbounded
+	MinWeight *float64 `json:"minWeight,omitempty"`
+
+	// WindowDays Length of the rolling window weight changes are measured over; 0 for the default of 30 days
+	WindowDays *float64 `json:"windowDays,omitempty"`
+}
+
+// WeightTrend defines model for WeightTrend.
+type WeightTrend struct {
+	Abnormal  bool               `json:"abnormal"`
+	Anomalies []WeightAnomaly    `json:"anomalies"`
+	Baseline  *WeightMeasurement `json:"baseline,omitempty"`
+
+	// Change Weight change from the baseline to the latest weighing in kilograms
+	Change        float64            `json:"change"`
+	ChangePercent float64            `json:"changePercent"`
+	Latest        *WeightMeasurement `json:"latest,omitempty"`
+
+	// WindowDays Length of the rolling window the change is measured over
+	WindowDays float64 `json:"windowDays"`
+}
+
+// ZooStatistics defines model for ZooStatistics.
+type ZooStatistics struct {
+	CompletedFeedingsToday int `json:"completedFeedingsToday"`
+	FeedingSchedulesCount  int `json:"feedingSchedulesCount"`
+	FreeEnclosures         int `json:"freeEnclosures"`
+	HealthyAnimals         int `json:"healthyAnimals"`
+	PendingFeedingsToday   int `json:"pendingFeedingsToday"`
+
+	// SickAnimals Animals that are ill until cured, that is Sick, Quarantined or Recovering
+	SickAnimals     int `json:"sickAnimals"`
+	TotalAnimals    int `json:"totalAnimals"`
+	TotalEnclosures int `json:"totalEnclosures"`
+}
+
+// GetApiV1AnimalsAnimalIdDosesParams defines parameters for GetApiV1AnimalsAnimalIdDoses.
+type GetApiV1AnimalsAnimalIdDosesParams struct {
+	// Drug Name of the drug
+	Drug string `form:"drug" json:"drug"`
+}
+
+// GetApiV1AnimalsAnimalIdWeightsParams defines parameters for GetApiV1AnimalsAnimalIdWeights.
+type GetApiV1AnimalsAnimalIdWeightsParams struct {
+	// From Start of the range, the first weighing by default
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the range, now by default
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetApiV1EnclosuresEnclosureIdKeepersParams defines parameters for GetApiV1EnclosuresEnclosureIdKeepers.
+type GetApiV1EnclosuresEnclosureIdKeepersParams struct {
+	// At Moment to find the responsible keepers at, now by default
+	At *time.Time `form:"at,omitempty" json:"at,omitempty"`
+}
+
+// GetApiV1EventsParams defines parameters for GetApiV1Events.
+type GetApiV1EventsParams struct {
+	// Type Only return events of these types
+	Type *[]string `form:"type,omitempty" json:"type,omitempty"`
+
+	// AggregateId Only return events concerning this aggregate (animal, enclosure, feeding schedule)
+	AggregateId *string `form:"aggregateId,omitempty" json:"aggregateId,omitempty"`
+
+	// From Only return events recorded at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only return events recorded before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// AfterSequence Only return events with a greater sequence number
+	AfterSequence *int64 `form:"afterSequence,omitempty" json:"afterSequence,omitempty"`
+
+	// Limit Maximum number of events to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiV1EventsStreamParams defines parameters for GetApiV1EventsStream.
+type GetApiV1EventsStreamParams struct {
+	// Type Only stream events of these types
+	Type *[]string `form:"type,omitempty" json:"type,omitempty"`
+
+	// LastEventID ID of the last event received before reconnecting
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// GetApiV1FeedingSchedulesScheduleIdOccurrencesParams defines parameters for GetApiV1FeedingSchedulesScheduleIdOccurrences.
+type GetApiV1FeedingSchedulesScheduleIdOccurrencesParams struct {
+	// From Start of the range, now by default
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the range, a week after its start by default; the range may not exceed a year
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetApiV1KeepersKeeperIdTasksParams defines parameters for GetApiV1KeepersKeeperIdTasks.
+type GetApiV1KeepersKeeperIdTasksParams struct {
+	// Date Day of the task list, today by default
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+
+	// TimeZone IANA time zone the day is counted in, UTC by default
+	TimeZone *string `form:"timeZone,omitempty" json:"timeZone,omitempty"`
+}
+
+// GetApiV1QuarantinesParams defines parameters for GetApiV1Quarantines.
+type GetApiV1QuarantinesParams struct {
+	// Active Only return quarantines that have not been cleared
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+}
+
+// GetApiV1ReportsFoodForecastParams defines parameters for GetApiV1ReportsFoodForecast.
+type GetApiV1ReportsFoodForecastParams struct {
+	// Days Length of the forecast horizon starting now, in days
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+
+	// Format Format of the report
+	Format *GetApiV1ReportsFoodForecastParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetApiV1ReportsFoodForecastParamsFormat defines parameters for GetApiV1ReportsFoodForecast.
+type GetApiV1ReportsFoodForecastParamsFormat string
+
+// GetApiV1VetAppointmentsParams defines parameters for GetApiV1VetAppointments.
+type GetApiV1VetAppointmentsParams struct {
+	// From Start of the range, unbounded by default
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the range, unbounded by default
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Vet Only appointments of the vet
+	Vet *string `form:"vet,omitempty" json:"vet,omitempty"`
+
+	// AnimalId Only appointments of the animal
+	AnimalId *openapi_types.UUID `form:"animalId,omitempty" json:"animalId,omitempty"`
+
+	// Status Only appointments in the status
+	Status *VetAppointmentStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetApiV1VetAppointmentsCalendarParams defines parameters for GetApiV1VetAppointmentsCalendar.
+type GetApiV1VetAppointmentsCalendarParams struct {
+	// From First day of the calendar, today by default
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// Days Number of days in the calendar
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+
+	// Vet Only appointments of the vet
+	Vet *string `form:"vet,omitempty" json:"vet,omitempty"`
+
+	// TimeZone IANA time zone the days are counted in, UTC by default
+	TimeZone *string `form:"timeZone,omitempty" json:"timeZone,omitempty"`
+}
+
+// PostApiV1AnimalsJSONRequestBody defines body for PostApiV1Animals for application/json ContentType.
+type PostApiV1AnimalsJSONRequestBody = AnimalInput
+
+// PostApiV1AnimalsAnimalIdAdministrationsJSONRequestBody defines body for PostApiV1AnimalsAnimalIdAdministrations for application/json ContentType.
+type PostApiV1AnimalsAnimalIdAdministrationsJSONRequestBody = DrugAdministrationInput
+
+// PostApiV1AnimalsAnimalIdDiagnosesJSONRequestBody defines body for PostApiV1AnimalsAnimalIdDiagnoses for application/json ContentType.
+type PostApiV1AnimalsAnimalIdDiagnosesJSONRequestBody = DiagnosisInput
+
+// PostApiV1AnimalsAnimalIdIllnessesJSONRequestBody defines body for PostApiV1AnimalsAnimalIdIllnesses for application/json ContentType.
+type PostApiV1AnimalsAnimalIdIllnessesJSONRequestBody = IllnessInput
+
+// PostApiV1AnimalsAnimalIdMoveJSONRequestBody defines body for PostApiV1AnimalsAnimalIdMove for application/json ContentType.
+type PostApiV1AnimalsAnimalIdMoveJSONRequestBody = MoveAnimalInput
+
+// PostApiV1AnimalsAnimalIdQuarantineJSONRequestBody defines body for PostApiV1AnimalsAnimalIdQuarantine for application/json ContentType.
+type PostApiV1AnimalsAnimalIdQuarantineJSONRequestBody = QuarantineInput
+
+// PostApiV1AnimalsAnimalIdStatusJSONRequestBody defines body for PostApiV1AnimalsAnimalIdStatus for application/json ContentType.
+type PostApiV1AnimalsAnimalIdStatusJSONRequestBody = AnimalStatusInput
+
+// PostApiV1AnimalsAnimalIdTreatJSONRequestBody defines body for PostApiV1AnimalsAnimalIdTreat for application/json ContentType.
+type PostApiV1AnimalsAnimalIdTreatJSONRequestBody = TreatmentInput
+
+// PostApiV1AnimalsAnimalIdWeightsJSONRequestBody defines body for PostApiV1AnimalsAnimalIdWeights for application/json ContentType.
+type PostApiV1AnimalsAnimalIdWeightsJSONRequestBody = WeightMeasurementInput
+
+// PostApiV1CareTasksJSONRequestBody defines body for PostApiV1CareTasks for application/json ContentType.
+type PostApiV1CareTasksJSONRequestBody = CareTaskInput
+
+// PutApiV1CareTasksTaskIdAssigneeJSONRequestBody defines body for PutApiV1CareTasksTaskIdAssignee for application/json ContentType.
+type PutApiV1CareTasksTaskIdAssigneeJSONRequestBody = TaskAssigneeInput
+
+// PostApiV1DrugsJSONRequestBody defines body for PostApiV1Drugs for application/json ContentType.
+type PostApiV1DrugsJSONRequestBody = Drug
+
+// PutApiV1DrugsDrugNameJSONRequestBody defines body for PutApiV1DrugsDrugName for application/json ContentType.
+type PutApiV1DrugsDrugNameJSONRequestBody = DrugRules
+
+// PostApiV1EnclosuresJSONRequestBody defines body for PostApiV1Enclosures for application/json ContentType.
+type PostApiV1EnclosuresJSONRequestBody = EnclosureInput
+
+// PostApiV1EventsReplayJSONRequestBody defines body for PostApiV1EventsReplay for application/json ContentType.
+type PostApiV1EventsReplayJSONRequestBody = ReplayInput
+
+// PostApiV1FeedingSchedulesJSONRequestBody defines body for PostApiV1FeedingSchedules for application/json ContentType.
+type PostApiV1FeedingSchedulesJSONRequestBody = FeedingScheduleInput
+
+// PutApiV1FeedingSchedulesScheduleIdAssigneeJSONRequestBody defines body for PutApiV1FeedingSchedulesScheduleIdAssignee for application/json ContentType.
+type PutApiV1FeedingSchedulesScheduleIdAssigneeJSONRequestBody = TaskAssigneeInput
+
+// PostApiV1FeedingSchedulesScheduleIdOccurrencesCompleteJSONRequestBody defines body for PostApiV1FeedingSchedulesScheduleIdOccurrencesComplete for application/json ContentType.
+type PostApiV1FeedingSchedulesScheduleIdOccurrencesCompleteJSONRequestBody = FeedingOccurrenceInput
+
+// PostApiV1FeedingSchedulesScheduleIdOccurrencesSkipJSONRequestBody defines body for PostApiV1FeedingSchedulesScheduleIdOccurrencesSkip for application/json ContentType.
+type PostApiV1FeedingSchedulesScheduleIdOccurrencesSkipJSONRequestBody = FeedingOccurrenceInput
+
+// PostApiV1FoodStocksJSONRequestBody defines body for PostApiV1FoodStocks for application/json ContentType.
+type PostApiV1FoodStocksJSONRequestBody = FoodStockInput
+
+// PostApiV1FoodStocksFoodDeliveriesJSONRequestBody defines body for PostApiV1FoodStocksFoodDeliveries for application/json ContentType.
+type PostApiV1FoodStocksFoodDeliveriesJSONRequestBody = FoodQuantity
+
+// PostApiV1KeepersJSONRequestBody defines body for PostApiV1Keepers for application/json ContentType.
+type PostApiV1KeepersJSONRequestBody = KeeperInput
+
+// PutApiV1KeepersKeeperIdJSONRequestBody defines body for PutApiV1KeepersKeeperId for application/json ContentType.
+type PutApiV1KeepersKeeperIdJSONRequestBody = KeeperInput
+
+// PostApiV1KeepersKeeperIdAssignmentsJSONRequestBody defines body for PostApiV1KeepersKeeperIdAssignments for application/json ContentType.
+type PostApiV1KeepersKeeperIdAssignmentsJSONRequestBody = KeeperAssignment
+
+// PostApiV1KeepersKeeperIdTasksCompleteJSONRequestBody defines body for PostApiV1KeepersKeeperIdTasksComplete for application/json ContentType.
+type PostApiV1KeepersKeeperIdTasksCompleteJSONRequestBody = KeeperTaskCompletionInput
+
+// PostApiV1PlacementExecuteJSONRequestBody defines body for PostApiV1PlacementExecute for application/json ContentType.
+type PostApiV1PlacementExecuteJSONRequestBody = PlacementExecutionInput
+
+// PostApiV1PlacementPlanJSONRequestBody defines body for PostApiV1PlacementPlan for application/json ContentType.
+type PostApiV1PlacementPlanJSONRequestBody = PlacementRequest
+
+// PostApiV1QuarantinesQuarantineIdClearJSONRequestBody defines body for PostApiV1QuarantinesQuarantineIdClear for application/json ContentType.
+type PostApiV1QuarantinesQuarantineIdClearJSONRequestBody = QuarantineClearanceInput
+
+// PostApiV1ShiftsJSONRequestBody defines body for PostApiV1Shifts for application/json ContentType.
+type PostApiV1ShiftsJSONRequestBody = Shift
+
+// PutApiV1ShiftsShiftNameJSONRequestBody defines body for PutApiV1ShiftsShiftName for application/json ContentType.
+type PutApiV1ShiftsShiftNameJSONRequestBody = ShiftRules
+
+// PostApiV1SpeciesJSONRequestBody defines body for PostApiV1Species for application/json ContentType.
+type PostApiV1SpeciesJSONRequestBody = Species
+
+// PutApiV1SpeciesSpeciesNameJSONRequestBody defines body for PutApiV1SpeciesSpeciesName for application/json ContentType.
+type PutApiV1SpeciesSpeciesNameJSONRequestBody = SpeciesRules
+
+// PostApiV1TransfersJSONRequestBody defines body for PostApiV1Transfers for application/json ContentType.
+type PostApiV1TransfersJSONRequestBody = TransferBatchInput
+
+// PostApiV1VetAppointmentsJSONRequestBody defines body for PostApiV1VetAppointments for application/json ContentType.
+type PostApiV1VetAppointmentsJSONRequestBody = VetAppointmentInput
+
+// PutApiV1VetAppointmentsAppointmentIdJSONRequestBody defines body for PutApiV1VetAppointmentsAppointmentId for application/json ContentType.
+type PutApiV1VetAppointmentsAppointmentIdJSONRequestBody = VetAppointmentRescheduleInput
+
+// PostApiV1VetAppointmentsAppointmentIdCompleteJSONRequestBody defines body for PostApiV1VetAppointmentsAppointmentIdComplete for application/json ContentType.
+type PostApiV1VetAppointmentsAppointmentIdCompleteJSONRequestBody = VetAppointmentOutcomeInput
+
+// PostApiV1WebhooksJSONRequestBody defines body for PostApiV1Webhooks for application/json ContentType.
+type PostApiV1WebhooksJSONRequestBody = WebhookInput
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get all animals
+	// (GET /api/v1/animals)
+	GetApiV1Animals(c *gin.Context)
+	// Add a new animal
+	// (POST /api/v1/animals)
+	PostApiV1Animals(c *gin.Context)
+	// Delete an animal
+	// (DELETE /api/v1/animals/{animalId})
+	DeleteApiV1AnimalsAnimalId(c *gin.Context, animalId openapi_types.UUID)
+	// Get animal by ID
+	// (GET /api/v1/animals/{animalId})
+	GetApiV1AnimalsAnimalId(c *gin.Context, animalId openapi_types.UUID)
+	// Log a drug administration
+	// (POST /api/v1/animals/{animalId}/administrations)
+	PostApiV1AnimalsAnimalIdAdministrations(c *gin.Context, animalId openapi_types.UUID)
+	// Diagnose an animal
+	// (POST /api/v1/animals/{animalId}/diagnoses)
+	PostApiV1AnimalsAnimalIdDiagnoses(c *gin.Context, animalId openapi_types.UUID)
+	// Calculate the dose of a drug for an animal
+	// (GET /api/v1/animals/{animalId}/doses)
+	GetApiV1AnimalsAnimalIdDoses(c *gin.Context, animalId openapi_types.UUID, params GetApiV1AnimalsAnimalIdDosesParams)
+	// Report an illness
+	// (POST /api/v1/animals/{animalId}/illnesses)
+	PostApiV1AnimalsAnimalIdIllnesses(c *gin.Context, animalId openapi_types.UUID)
+	// Get the medical record of an animal
+	// (GET /api/v1/animals/{animalId}/medical-record)
+	GetApiV1AnimalsAnimalIdMedicalRecord(c *gin.Context, animalId openapi_types.UUID)
+	// Move an animal to a new enclosure
+	// (POST /api/v1/animals/{animalId}/move)
+	PostApiV1AnimalsAnimalIdMove(c *gin.Context, animalId openapi_types.UUID)
+	// Quarantine an animal
+	// (POST /api/v1/animals/{animalId}/quarantine)
+	PostApiV1AnimalsAnimalIdQuarantine(c *gin.Context, animalId openapi_types.UUID)
+	// Change the health status of an animal
+	// (POST /api/v1/animals/{animalId}/status)
+	PostApiV1AnimalsAnimalIdStatus(c *gin.Context, animalId openapi_types.UUID)
+	// Get enclosures suitable for an animal
+	// (GET /api/v1/animals/{animalId}/suitable-enclosures)
+	GetApiV1AnimalsAnimalIdSuitableEnclosures(c *gin.Context, animalId openapi_types.UUID)
+	// Treat a sick animal
+	// (POST /api/v1/animals/{animalId}/treat)
+	PostApiV1AnimalsAnimalIdTreat(c *gin.Context, animalId openapi_types.UUID)
+	// Get the weight history of an animal
+	// (GET /api/v1/animals/{animalId}/weights)
+	GetApiV1AnimalsAnimalIdWeights(c *gin.Context, animalId openapi_types.UUID, params GetApiV1AnimalsAnimalIdWeightsParams)
+	// Record a weighing of an animal
+	// (POST /api/v1/animals/{animalId}/weights)
+	PostApiV1AnimalsAnimalIdWeights(c *gin.Context, animalId openapi_types.UUID)
+	// Get all care tasks
+	// (GET /api/v1/care-tasks)
+	GetApiV1CareTasks(c *gin.Context)
+	// Add a care task
+	// (POST /api/v1/care-tasks)
+	PostApiV1CareTasks(c *gin.Context)
+	// Delete a care task
+	// (DELETE /api/v1/care-tasks/{taskId})
+	DeleteApiV1CareTasksTaskId(c *gin.Context, taskId openapi_types.UUID)
+	// Get a care task
+	// (GET /api/v1/care-tasks/{taskId})
+	GetApiV1CareTasksTaskId(c *gin.Context, taskId openapi_types.UUID)
+	// Assign a care task to a keeper
+	// (PUT /api/v1/care-tasks/{taskId}/assignee)
+	PutApiV1CareTasksTaskIdAssignee(c *gin.Context, taskId openapi_types.UUID)
+	// Get all dead letters
+	// (GET /api/v1/dead-letters)
+	GetApiV1DeadLetters(c *gin.Context)
+	// Re-drive a dead letter
+	// (POST /api/v1/dead-letters/{deadLetterId}/redrive)
+	PostApiV1DeadLettersDeadLetterIdRedrive(c *gin.Context, deadLetterId openapi_types.UUID)
+	// Get the drug formulary
+	// (GET /api/v1/drugs)
+	GetApiV1Drugs(c *gin.Context)
+	// Add a drug to the formulary
+	// (POST /api/v1/drugs)
+	PostApiV1Drugs(c *gin.Context)
+	// Remove a drug from the formulary
+	// (DELETE /api/v1/drugs/{drugName})
+	DeleteApiV1DrugsDrugName(c *gin.Context, drugName string)
+	// Get a drug of the formulary
+	// (GET /api/v1/drugs/{drugName})
+	GetApiV1DrugsDrugName(c *gin.Context, drugName string)
+	// Update a drug of the formulary
+	// (PUT /api/v1/drugs/{drugName})
+	PutApiV1DrugsDrugName(c *gin.Context, drugName string)
+	// Get enclosure types
+	// (GET /api/v1/enclosure-types)
+	GetApiV1EnclosureTypes(c *gin.Context)
+	// Get all enclosures
+	// (GET /api/v1/enclosures)
+	GetApiV1Enclosures(c *gin.Context)
+	// Add a new enclosure
 	// (POST /api/v1/enclosures)
 	PostApiV1Enclosures(c *gin.Context)
 	// Delete an enclosure
@@ -199,6 +1665,18 @@ type ServerInterface interface {
 	// Clean an enclosure
 	// (POST /api/v1/enclosures/{enclosureId}/clean)
 	PostApiV1EnclosuresEnclosureIdClean(c *gin.Context, enclosureId openapi_types.UUID)
+	// Get the keepers responsible for an enclosure
+	// (GET /api/v1/enclosures/{enclosureId}/keepers)
+	GetApiV1EnclosuresEnclosureIdKeepers(c *gin.Context, enclosureId openapi_types.UUID, params GetApiV1EnclosuresEnclosureIdKeepersParams)
+	// Get stored events
+	// (GET /api/v1/events)
+	GetApiV1Events(c *gin.Context, params GetApiV1EventsParams)
+	// Replay stored events
+	// (POST /api/v1/events/replay)
+	PostApiV1EventsReplay(c *gin.Context)
+	// Stream live events
+	// (GET /api/v1/events/stream)
+	GetApiV1EventsStream(c *gin.Context, params GetApiV1EventsStreamParams)
 	// Get all feeding schedules
 	// (GET /api/v1/feeding-schedules)
 	GetApiV1FeedingSchedules(c *gin.Context)
@@ -211,25 +1689,1818 @@ type ServerInterface interface {
 	// Get feeding schedule by ID
 	// (GET /api/v1/feeding-schedules/{scheduleId})
 	GetApiV1FeedingSchedulesScheduleId(c *gin.Context, scheduleId openapi_types.UUID)
+	// Assign the feedings of a schedule to a keeper
+	// (PUT /api/v1/feeding-schedules/{scheduleId}/assignee)
+	PutApiV1FeedingSchedulesScheduleIdAssignee(c *gin.Context, scheduleId openapi_types.UUID)
 	// Mark a feeding schedule as completed
 	// (POST /api/v1/feeding-schedules/{scheduleId}/complete)
 	PostApiV1FeedingSchedulesScheduleIdComplete(c *gin.Context, scheduleId openapi_types.UUID)
+	// Get feeding occurrences
+	// (GET /api/v1/feeding-schedules/{scheduleId}/occurrences)
+	GetApiV1FeedingSchedulesScheduleIdOccurrences(c *gin.Context, scheduleId openapi_types.UUID, params GetApiV1FeedingSchedulesScheduleIdOccurrencesParams)
+	// Complete a feeding occurrence
+	// (POST /api/v1/feeding-schedules/{scheduleId}/occurrences/complete)
+	PostApiV1FeedingSchedulesScheduleIdOccurrencesComplete(c *gin.Context, scheduleId openapi_types.UUID)
+	// Skip a feeding occurrence
+	// (POST /api/v1/feeding-schedules/{scheduleId}/occurrences/skip)
+	PostApiV1FeedingSchedulesScheduleIdOccurrencesSkip(c *gin.Context, scheduleId openapi_types.UUID)
+	// Run all due feedings
+	// (POST /api/v1/feedings/run)
+	PostApiV1FeedingsRun(c *gin.Context)
+	// Get the food inventory
+	// (GET /api/v1/food-stocks)
+	GetApiV1FoodStocks(c *gin.Context)
+	// Add a food to the inventory
+	// (POST /api/v1/food-stocks)
+	PostApiV1FoodStocks(c *gin.Context)
+	// Remove a food from the inventory
+	// (DELETE /api/v1/food-stocks/{food})
+	DeleteApiV1FoodStocksFood(c *gin.Context, food string)
+	// Get the stock of a food
+	// (GET /api/v1/food-stocks/{food})
+	GetApiV1FoodStocksFood(c *gin.Context, food string)
+	// Record a food delivery
+	// (POST /api/v1/food-stocks/{food}/deliveries)
+	PostApiV1FoodStocksFoodDeliveries(c *gin.Context, food string)
+	// Get all keepers
+	// (GET /api/v1/keepers)
+	GetApiV1Keepers(c *gin.Context)
+	// Add a keeper
+	// (POST /api/v1/keepers)
+	PostApiV1Keepers(c *gin.Context)
+	// Remove a keeper with their assignments
+	// (DELETE /api/v1/keepers/{keeperId})
+	DeleteApiV1KeepersKeeperId(c *gin.Context, keeperId openapi_types.UUID)
+	// Get a keeper
+	// (GET /api/v1/keepers/{keeperId})
+	GetApiV1KeepersKeeperId(c *gin.Context, keeperId openapi_types.UUID)
+	// Update a keeper
+	// (PUT /api/v1/keepers/{keeperId})
+	PutApiV1KeepersKeeperId(c *gin.Context, keeperId openapi_types.UUID)
+	// Assign a keeper to an enclosure in a shift
+	// (POST /api/v1/keepers/{keeperId}/assignments)
+	PostApiV1KeepersKeeperIdAssignments(c *gin.Context, keeperId openapi_types.UUID)
+	// Unassign a keeper from an enclosure in a shift
+	// (DELETE /api/v1/keepers/{keeperId}/assignments/{shiftName}/{enclosureId})
+	DeleteApiV1KeepersKeeperIdAssignmentsShiftNameEnclosureId(c *gin.Context, keeperId openapi_types.UUID, shiftName string, enclosureId openapi_types.UUID)
+	// Get the daily task list of a keeper
+	// (GET /api/v1/keepers/{keeperId}/tasks)
+	GetApiV1KeepersKeeperIdTasks(c *gin.Context, keeperId openapi_types.UUID, params GetApiV1KeepersKeeperIdTasksParams)
+	// Complete a task of a keeper
+	// (POST /api/v1/keepers/{keeperId}/tasks/complete)
+	PostApiV1KeepersKeeperIdTasksComplete(c *gin.Context, keeperId openapi_types.UUID)
+	// Execute a placement plan
+	// (POST /api/v1/placement/execute)
+	PostApiV1PlacementExecute(c *gin.Context)
+	// Plan the placement of animals
+	// (POST /api/v1/placement/plan)
+	PostApiV1PlacementPlan(c *gin.Context)
+	// Get quarantines
+	// (GET /api/v1/quarantines)
+	GetApiV1Quarantines(c *gin.Context, params GetApiV1QuarantinesParams)
+	// Get quarantine by ID
+	// (GET /api/v1/quarantines/{quarantineId})
+	GetApiV1QuarantinesQuarantineId(c *gin.Context, quarantineId openapi_types.UUID)
+	// Clear a quarantine
+	// (POST /api/v1/quarantines/{quarantineId}/clear)
+	PostApiV1QuarantinesQuarantineIdClear(c *gin.Context, quarantineId openapi_types.UUID)
+	// Get the food demand forecast
+	// (GET /api/v1/reports/food-forecast)
+	GetApiV1ReportsFoodForecast(c *gin.Context, params GetApiV1ReportsFoodForecastParams)
+	// Get the low stock report
+	// (GET /api/v1/reports/low-stock)
+	GetApiV1ReportsLowStock(c *gin.Context)
+	// Get all shifts
+	// (GET /api/v1/shifts)
+	GetApiV1Shifts(c *gin.Context)
+	// Define a shift
+	// (POST /api/v1/shifts)
+	PostApiV1Shifts(c *gin.Context)
+	// Remove a shift
+	// (DELETE /api/v1/shifts/{shiftName})
+	DeleteApiV1ShiftsShiftName(c *gin.Context, shiftName string)
+	// Get a shift
+	// (GET /api/v1/shifts/{shiftName})
+	GetApiV1ShiftsShiftName(c *gin.Context, shiftName string)
+	// Update a shift
+	// (PUT /api/v1/shifts/{shiftName})
+	PutApiV1ShiftsShiftName(c *gin.Context, shiftName string)
+	// Get the species catalog
+	// (GET /api/v1/species)
+	GetApiV1Species(c *gin.Context)
+	// Add a species to the catalog
+	// (POST /api/v1/species)
+	PostApiV1Species(c *gin.Context)
+	// Remove a species from the catalog
+	// (DELETE /api/v1/species/{speciesName})
+	DeleteApiV1SpeciesSpeciesName(c *gin.Context, speciesName string)
+	// Get the rules of a species
+	// (GET /api/v1/species/{speciesName})
+	GetApiV1SpeciesSpeciesName(c *gin.Context, speciesName string)
+	// Update the rules of a species
+	// (PUT /api/v1/species/{speciesName})
+	PutApiV1SpeciesSpeciesName(c *gin.Context, speciesName string)
 	// Get zoo statistics
 	// (GET /api/v1/statistics)
 	GetApiV1Statistics(c *gin.Context)
+	// Transfer animals in bulk
+	// (POST /api/v1/transfers)
+	PostApiV1Transfers(c *gin.Context)
+	// Get vet appointments
+	// (GET /api/v1/vet-appointments)
+	GetApiV1VetAppointments(c *gin.Context, params GetApiV1VetAppointmentsParams)
+	// Schedule a vet appointment
+	// (POST /api/v1/vet-appointments)
+	PostApiV1VetAppointments(c *gin.Context)
+	// Get the vet calendar
+	// (GET /api/v1/vet-appointments/calendar)
+	GetApiV1VetAppointmentsCalendar(c *gin.Context, params GetApiV1VetAppointmentsCalendarParams)
+	// Delete a vet appointment
+	// (DELETE /api/v1/vet-appointments/{appointmentId})
+	DeleteApiV1VetAppointmentsAppointmentId(c *gin.Context, appointmentId openapi_types.UUID)
+	// Get vet appointment by ID
+	// (GET /api/v1/vet-appointments/{appointmentId})
+	GetApiV1VetAppointmentsAppointmentId(c *gin.Context, appointmentId openapi_types.UUID)
+	// Reschedule a vet appointment
+	// (PUT /api/v1/vet-appointments/{appointmentId})
+	PutApiV1VetAppointmentsAppointmentId(c *gin.Context, appointmentId openapi_types.UUID)
+	// Cancel a vet appointment
+	// (POST /api/v1/vet-appointments/{appointmentId}/cancel)
+	PostApiV1VetAppointmentsAppointmentIdCancel(c *gin.Context, appointmentId openapi_types.UUID)
+	// Complete a vet appointment
+	// (POST /api/v1/vet-appointments/{appointmentId}/complete)
+	PostApiV1VetAppointmentsAppointmentIdComplete(c *gin.Context, appointmentId openapi_types.UUID)
+	// Get all webhooks
+	// (GET /api/v1/webhooks)
+	GetApiV1Webhooks(c *gin.Context)
+	// Register a webhook
+	// (POST /api/v1/webhooks)
+	PostApiV1Webhooks(c *gin.Context)
+	// Delete a webhook
+	// (DELETE /api/v1/webhooks/{webhookId})
+	DeleteApiV1WebhooksWebhookId(c *gin.Context, webhookId openapi_types.UUID)
+	// Get webhook by ID
+	// (GET /api/v1/webhooks/{webhookId})
+	GetApiV1WebhooksWebhookId(c *gin.Context, webhookId openapi_types.UUID)
+	// Get webhook delivery attempts
+	// (GET /api/v1/webhooks/{webhookId}/deliveries)
+	GetApiV1WebhooksWebhookIdDeliveries(c *gin.Context, webhookId openapi_types.UUID)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// GetApiV1Animals operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Animals(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1Animals(c)
+}
+
+// PostApiV1Animals operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Animals(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1Animals(c)
+}
+
+// DeleteApiV1AnimalsAnimalId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1AnimalsAnimalId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "animalId" -------------
+	var animalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1AnimalsAnimalId(c, animalId)
+}
+
+// GetApiV1AnimalsAnimalId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AnimalsAnimalId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "animalId" -------------
+	var animalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1AnimalsAnimalId(c, animalId)
+}
+
+// PostApiV1AnimalsAnimalIdAdministrations operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1AnimalsAnimalIdAdministrations(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "animalId" -------------
+	var animalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1AnimalsAnimalIdAdministrations(c, animalId)
+}
+
+// PostApiV1AnimalsAnimalIdDiagnoses operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1AnimalsAnimalIdDiagnoses(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "animalId" -------------
+	var animalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1AnimalsAnimalIdDiagnoses(c, animalId)
+}
+
+// GetApiV1AnimalsAnimalIdDoses operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AnimalsAnimalIdDoses(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "animalId" -------------
+	var animalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1AnimalsAnimalIdDosesParams
+
+	// ------------- Required query parameter "drug" -------------
+
+	if paramValue := c.Query("drug"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument drug is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "drug", c.Request.URL.Query(), &params.Drug)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter drug: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1AnimalsAnimalIdDoses(c, animalId, params)
+}
+
+// PostApiV1AnimalsAnimalIdIllnesses operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1AnimalsAnimalIdIllnesses(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "animalId" -------------
+	var animalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1AnimalsAnimalIdIllnesses(c, animalId)
+}
+
+// GetApiV1AnimalsAnimalIdMedicalRecord operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AnimalsAnimalIdMedicalRecord(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "animalId" -------------
+	var animalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1AnimalsAnimalIdMedicalRecord(c, animalId)
+}
+
+// PostApiV1AnimalsAnimalIdMove operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1AnimalsAnimalIdMove(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "animalId" -------------
+	var animalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1AnimalsAnimalIdMove(c, animalId)
+}
+
+// PostApiV1AnimalsAnimalIdQuarantine operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1AnimalsAnimalIdQuarantine(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "animalId" -------------
+	var animalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1AnimalsAnimalIdQuarantine(c, animalId)
+}
+
+// PostApiV1AnimalsAnimalIdStatus operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1AnimalsAnimalIdStatus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "animalId" -------------
+	var animalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1AnimalsAnimalIdStatus(c, animalId)
+}
+
+// GetApiV1AnimalsAnimalIdSuitableEnclosures operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AnimalsAnimalIdSuitableEnclosures(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "animalId" -------------
+	var animalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1AnimalsAnimalIdSuitableEnclosures(c, animalId)
+}
+
+// PostApiV1AnimalsAnimalIdTreat operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1AnimalsAnimalIdTreat(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "animalId" -------------
+	var animalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1AnimalsAnimalIdTreat(c, animalId)
+}
+
+// GetApiV1AnimalsAnimalIdWeights operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AnimalsAnimalIdWeights(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "animalId" -------------
+	var animalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1AnimalsAnimalIdWeightsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1AnimalsAnimalIdWeights(c, animalId, params)
+}
+
+// PostApiV1AnimalsAnimalIdWeights operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1AnimalsAnimalIdWeights(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "animalId" -------------
+	var animalId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1AnimalsAnimalIdWeights(c, animalId)
+}
+
+// GetApiV1CareTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1CareTasks(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1CareTasks(c)
+}
+
+// PostApiV1CareTasks operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1CareTasks(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1CareTasks(c)
+}
+
+// DeleteApiV1CareTasksTaskId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1CareTasksTaskId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", c.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter taskId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1CareTasksTaskId(c, taskId)
+}
+
+// GetApiV1CareTasksTaskId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1CareTasksTaskId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", c.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter taskId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1CareTasksTaskId(c, taskId)
+}
+
+// PutApiV1CareTasksTaskIdAssignee operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1CareTasksTaskIdAssignee(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", c.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter taskId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiV1CareTasksTaskIdAssignee(c, taskId)
+}
+
+// GetApiV1DeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1DeadLetters(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1DeadLetters(c)
+}
+
+// PostApiV1DeadLettersDeadLetterIdRedrive operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1DeadLettersDeadLetterIdRedrive(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "deadLetterId" -------------
+	var deadLetterId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deadLetterId", c.Param("deadLetterId"), &deadLetterId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter deadLetterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1DeadLettersDeadLetterIdRedrive(c, deadLetterId)
+}
+
+// GetApiV1Drugs operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Drugs(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1Drugs(c)
+}
+
+// PostApiV1Drugs operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Drugs(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1Drugs(c)
+}
+
+// DeleteApiV1DrugsDrugName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1DrugsDrugName(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "drugName" -------------
+	var drugName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "drugName", c.Param("drugName"), &drugName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter drugName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1DrugsDrugName(c, drugName)
+}
+
+// GetApiV1DrugsDrugName operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1DrugsDrugName(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "drugName" -------------
+	var drugName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "drugName", c.Param("drugName"), &drugName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter drugName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1DrugsDrugName(c, drugName)
+}
+
+// PutApiV1DrugsDrugName operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1DrugsDrugName(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "drugName" -------------
+	var drugName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "drugName", c.Param("drugName"), &drugName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter drugName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiV1DrugsDrugName(c, drugName)
+}
+
+// GetApiV1EnclosureTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1EnclosureTypes(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1EnclosureTypes(c)
+}
+
+// GetApiV1Enclosures operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Enclosures(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1Enclosures(c)
+}
+
+// PostApiV1Enclosures operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Enclosures(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1Enclosures(c)
+}
+
+// DeleteApiV1EnclosuresEnclosureId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1EnclosuresEnclosureId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "enclosureId" -------------
+	var enclosureId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "enclosureId", c.Param("enclosureId"), &enclosureId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter enclosureId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1EnclosuresEnclosureId(c, enclosureId)
+}
+
+// GetApiV1EnclosuresEnclosureId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1EnclosuresEnclosureId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "enclosureId" -------------
+	var enclosureId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "enclosureId", c.Param("enclosureId"), &enclosureId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter enclosureId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1EnclosuresEnclosureId(c, enclosureId)
+}
+
+// PostApiV1EnclosuresEnclosureIdClean operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1EnclosuresEnclosureIdClean(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "enclosureId" -------------
+	var enclosureId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "enclosureId", c.Param("enclosureId"), &enclosureId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter enclosureId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1EnclosuresEnclosureIdClean(c, enclosureId)
+}
+
+// GetApiV1EnclosuresEnclosureIdKeepers operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1EnclosuresEnclosureIdKeepers(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "enclosureId" -------------
+	var enclosureId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "enclosureId", c.Param("enclosureId"), &enclosureId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter enclosureId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1EnclosuresEnclosureIdKeepersParams
+
+	// ------------- Optional query parameter "at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "at", c.Request.URL.Query(), &params.At)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter at: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1EnclosuresEnclosureIdKeepers(c, enclosureId, params)
+}
+
+// GetApiV1Events operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Events(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1EventsParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", c.Request.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "aggregateId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "aggregateId", c.Request.URL.Query(), &params.AggregateId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter aggregateId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "afterSequence" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "afterSequence", c.Request.URL.Query(), &params.AfterSequence)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter afterSequence: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1Events(c, params)
+}
+
+// PostApiV1EventsReplay operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1EventsReplay(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1EventsReplay(c)
+}
+
+// GetApiV1EventsStream operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1EventsStream(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1EventsStreamParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", c.Request.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Last-Event-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Last-Event-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1EventsStream(c, params)
+}
+
+// GetApiV1FeedingSchedules operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1FeedingSchedules(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1FeedingSchedules(c)
+}
+
+// PostApiV1FeedingSchedules operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1FeedingSchedules(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1FeedingSchedules(c)
+}
+
+// DeleteApiV1FeedingSchedulesScheduleId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1FeedingSchedulesScheduleId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1FeedingSchedulesScheduleId(c, scheduleId)
+}
+
+// GetApiV1FeedingSchedulesScheduleId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1FeedingSchedulesScheduleId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1FeedingSchedulesScheduleId(c, scheduleId)
+}
+
+// PutApiV1FeedingSchedulesScheduleIdAssignee operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1FeedingSchedulesScheduleIdAssignee(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiV1FeedingSchedulesScheduleIdAssignee(c, scheduleId)
+}
+
+// PostApiV1FeedingSchedulesScheduleIdComplete operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1FeedingSchedulesScheduleIdComplete(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1FeedingSchedulesScheduleIdComplete(c, scheduleId)
+}
+
+// GetApiV1FeedingSchedulesScheduleIdOccurrences operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1FeedingSchedulesScheduleIdOccurrences(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1FeedingSchedulesScheduleIdOccurrencesParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1FeedingSchedulesScheduleIdOccurrences(c, scheduleId, params)
+}
+
+// PostApiV1FeedingSchedulesScheduleIdOccurrencesComplete operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1FeedingSchedulesScheduleIdOccurrencesComplete(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1FeedingSchedulesScheduleIdOccurrencesComplete(c, scheduleId)
+}
+
+// PostApiV1FeedingSchedulesScheduleIdOccurrencesSkip operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1FeedingSchedulesScheduleIdOccurrencesSkip(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1FeedingSchedulesScheduleIdOccurrencesSkip(c, scheduleId)
+}
+
+// PostApiV1FeedingsRun operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1FeedingsRun(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1FeedingsRun(c)
+}
+
+// GetApiV1FoodStocks operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1FoodStocks(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1FoodStocks(c)
+}
+
+// PostApiV1FoodStocks operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1FoodStocks(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1FoodStocks(c)
+}
+
+// DeleteApiV1FoodStocksFood operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1FoodStocksFood(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "food" -------------
+	var food string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "food", c.Param("food"), &food, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter food: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1FoodStocksFood(c, food)
+}
+
+// GetApiV1FoodStocksFood operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1FoodStocksFood(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "food" -------------
+	var food string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "food", c.Param("food"), &food, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter food: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1FoodStocksFood(c, food)
+}
+
+// PostApiV1FoodStocksFoodDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1FoodStocksFoodDeliveries(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "food" -------------
+	var food string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "food", c.Param("food"), &food, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter food: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1FoodStocksFoodDeliveries(c, food)
+}
+
+// GetApiV1Keepers operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Keepers(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1Keepers(c)
+}
+
+// PostApiV1Keepers operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Keepers(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1Keepers(c)
+}
+
+// DeleteApiV1KeepersKeeperId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1KeepersKeeperId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "keeperId" -------------
+	var keeperId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keeperId", c.Param("keeperId"), &keeperId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter keeperId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1KeepersKeeperId(c, keeperId)
+}
+
+// GetApiV1KeepersKeeperId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1KeepersKeeperId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "keeperId" -------------
+	var keeperId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keeperId", c.Param("keeperId"), &keeperId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter keeperId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1KeepersKeeperId(c, keeperId)
+}
+
+// PutApiV1KeepersKeeperId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1KeepersKeeperId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "keeperId" -------------
+	var keeperId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keeperId", c.Param("keeperId"), &keeperId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter keeperId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiV1KeepersKeeperId(c, keeperId)
+}
+
+// PostApiV1KeepersKeeperIdAssignments operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1KeepersKeeperIdAssignments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "keeperId" -------------
+	var keeperId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keeperId", c.Param("keeperId"), &keeperId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter keeperId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1KeepersKeeperIdAssignments(c, keeperId)
+}
+
+// DeleteApiV1KeepersKeeperIdAssignmentsShiftNameEnclosureId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1KeepersKeeperIdAssignmentsShiftNameEnclosureId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "keeperId" -------------
+	var keeperId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keeperId", c.Param("keeperId"), &keeperId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter keeperId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "shiftName" -------------
+	var shiftName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shiftName", c.Param("shiftName"), &shiftName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter shiftName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "enclosureId" -------------
+	var enclosureId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "enclosureId", c.Param("enclosureId"), &enclosureId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter enclosureId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1KeepersKeeperIdAssignmentsShiftNameEnclosureId(c, keeperId, shiftName, enclosureId)
+}
+
+// GetApiV1KeepersKeeperIdTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1KeepersKeeperIdTasks(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "keeperId" -------------
+	var keeperId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keeperId", c.Param("keeperId"), &keeperId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter keeperId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1KeepersKeeperIdTasksParams
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", c.Request.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "timeZone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeZone", c.Request.URL.Query(), &params.TimeZone)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timeZone: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1KeepersKeeperIdTasks(c, keeperId, params)
+}
+
+// PostApiV1KeepersKeeperIdTasksComplete operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1KeepersKeeperIdTasksComplete(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "keeperId" -------------
+	var keeperId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keeperId", c.Param("keeperId"), &keeperId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter keeperId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1KeepersKeeperIdTasksComplete(c, keeperId)
+}
+
+// PostApiV1PlacementExecute operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1PlacementExecute(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1PlacementExecute(c)
+}
+
+// PostApiV1PlacementPlan operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1PlacementPlan(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1PlacementPlan(c)
+}
+
+// GetApiV1Quarantines operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Quarantines(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1QuarantinesParams
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", c.Request.URL.Query(), &params.Active)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter active: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1Quarantines(c, params)
+}
+
+// GetApiV1QuarantinesQuarantineId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1QuarantinesQuarantineId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "quarantineId" -------------
+	var quarantineId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quarantineId", c.Param("quarantineId"), &quarantineId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter quarantineId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1QuarantinesQuarantineId(c, quarantineId)
+}
+
+// PostApiV1QuarantinesQuarantineIdClear operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1QuarantinesQuarantineIdClear(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "quarantineId" -------------
+	var quarantineId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quarantineId", c.Param("quarantineId"), &quarantineId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter quarantineId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1QuarantinesQuarantineIdClear(c, quarantineId)
+}
+
+// GetApiV1ReportsFoodForecast operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ReportsFoodForecast(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1ReportsFoodForecastParams
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", c.Request.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter days: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1ReportsFoodForecast(c, params)
+}
+
+// GetApiV1ReportsLowStock operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ReportsLowStock(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1ReportsLowStock(c)
+}
+
+// GetApiV1Shifts operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Shifts(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1Shifts(c)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+// PostApiV1Shifts operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Shifts(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1Shifts(c)
 }
 
-type MiddlewareFunc func(c *gin.Context)
+// DeleteApiV1ShiftsShiftName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1ShiftsShiftName(c *gin.Context) {
 
-// GetApiV1Animals operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1Animals(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "shiftName" -------------
+	var shiftName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shiftName", c.Param("shiftName"), &shiftName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter shiftName: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -238,11 +3509,22 @@ func (siw *ServerInterfaceWrapper) GetApiV1Animals(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiV1Animals(c)
+	siw.Handler.DeleteApiV1ShiftsShiftName(c, shiftName)
 }
 
-// PostApiV1Animals operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1Animals(c *gin.Context) {
+// GetApiV1ShiftsShiftName operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ShiftsShiftName(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "shiftName" -------------
+	var shiftName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shiftName", c.Param("shiftName"), &shiftName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter shiftName: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -251,20 +3533,20 @@ func (siw *ServerInterfaceWrapper) PostApiV1Animals(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostApiV1Animals(c)
+	siw.Handler.GetApiV1ShiftsShiftName(c, shiftName)
 }
 
-// DeleteApiV1AnimalsAnimalId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteApiV1AnimalsAnimalId(c *gin.Context) {
+// PutApiV1ShiftsShiftName operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1ShiftsShiftName(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "animalId" -------------
-	var animalId openapi_types.UUID
+	// ------------- Path parameter "shiftName" -------------
+	var shiftName string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "shiftName", c.Param("shiftName"), &shiftName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter shiftName: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -275,20 +3557,46 @@ func (siw *ServerInterfaceWrapper) DeleteApiV1AnimalsAnimalId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteApiV1AnimalsAnimalId(c, animalId)
+	siw.Handler.PutApiV1ShiftsShiftName(c, shiftName)
 }
 
-// GetApiV1AnimalsAnimalId operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1AnimalsAnimalId(c *gin.Context) {
+// GetApiV1Species operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Species(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1Species(c)
+}
+
+// PostApiV1Species operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Species(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1Species(c)
+}
+
+// DeleteApiV1SpeciesSpeciesName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1SpeciesSpeciesName(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "animalId" -------------
-	var animalId openapi_types.UUID
+	// ------------- Path parameter "speciesName" -------------
+	var speciesName string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "speciesName", c.Param("speciesName"), &speciesName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter speciesName: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -299,20 +3607,20 @@ func (siw *ServerInterfaceWrapper) GetApiV1AnimalsAnimalId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiV1AnimalsAnimalId(c, animalId)
+	siw.Handler.DeleteApiV1SpeciesSpeciesName(c, speciesName)
 }
 
-// PostApiV1AnimalsAnimalIdMove operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1AnimalsAnimalIdMove(c *gin.Context) {
+// GetApiV1SpeciesSpeciesName operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1SpeciesSpeciesName(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "animalId" -------------
-	var animalId openapi_types.UUID
+	// ------------- Path parameter "speciesName" -------------
+	var speciesName string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "speciesName", c.Param("speciesName"), &speciesName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter speciesName: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -323,20 +3631,20 @@ func (siw *ServerInterfaceWrapper) PostApiV1AnimalsAnimalIdMove(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PostApiV1AnimalsAnimalIdMove(c, animalId)
+	siw.Handler.GetApiV1SpeciesSpeciesName(c, speciesName)
 }
 
-// PostApiV1AnimalsAnimalIdTreat operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1AnimalsAnimalIdTreat(c *gin.Context) {
+// PutApiV1SpeciesSpeciesName operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1SpeciesSpeciesName(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "animalId" -------------
-	var animalId openapi_types.UUID
+	// ------------- Path parameter "speciesName" -------------
+	var speciesName string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "animalId", c.Param("animalId"), &animalId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "speciesName", c.Param("speciesName"), &speciesName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter speciesName: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -347,11 +3655,11 @@ func (siw *ServerInterfaceWrapper) PostApiV1AnimalsAnimalIdTreat(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PostApiV1AnimalsAnimalIdTreat(c, animalId)
+	siw.Handler.PutApiV1SpeciesSpeciesName(c, speciesName)
 }
 
-// GetApiV1Enclosures operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1Enclosures(c *gin.Context) {
+// GetApiV1Statistics operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Statistics(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -360,11 +3668,11 @@ func (siw *ServerInterfaceWrapper) GetApiV1Enclosures(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiV1Enclosures(c)
+	siw.Handler.GetApiV1Statistics(c)
 }
 
-// PostApiV1Enclosures operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1Enclosures(c *gin.Context) {
+// PostApiV1Transfers operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Transfers(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -373,20 +3681,54 @@ func (siw *ServerInterfaceWrapper) PostApiV1Enclosures(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostApiV1Enclosures(c)
+	siw.Handler.PostApiV1Transfers(c)
 }
 
-// DeleteApiV1EnclosuresEnclosureId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteApiV1EnclosuresEnclosureId(c *gin.Context) {
+// GetApiV1VetAppointments operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1VetAppointments(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "enclosureId" -------------
-	var enclosureId openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1VetAppointmentsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "enclosureId", c.Param("enclosureId"), &enclosureId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter enclosureId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "vet" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vet", c.Request.URL.Query(), &params.Vet)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vet: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "animalId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "animalId", c.Request.URL.Query(), &params.AnimalId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter animalId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -397,20 +3739,59 @@ func (siw *ServerInterfaceWrapper) DeleteApiV1EnclosuresEnclosureId(c *gin.Conte
 		}
 	}
 
-	siw.Handler.DeleteApiV1EnclosuresEnclosureId(c, enclosureId)
+	siw.Handler.GetApiV1VetAppointments(c, params)
 }
 
-// GetApiV1EnclosuresEnclosureId operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1EnclosuresEnclosureId(c *gin.Context) {
+// PostApiV1VetAppointments operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1VetAppointments(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1VetAppointments(c)
+}
+
+// GetApiV1VetAppointmentsCalendar operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1VetAppointmentsCalendar(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "enclosureId" -------------
-	var enclosureId openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1VetAppointmentsCalendarParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "enclosureId", c.Param("enclosureId"), &enclosureId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter enclosureId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", c.Request.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter days: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "vet" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vet", c.Request.URL.Query(), &params.Vet)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vet: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "timeZone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeZone", c.Request.URL.Query(), &params.TimeZone)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timeZone: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -421,20 +3802,20 @@ func (siw *ServerInterfaceWrapper) GetApiV1EnclosuresEnclosureId(c *gin.Context)
 		}
 	}
 
-	siw.Handler.GetApiV1EnclosuresEnclosureId(c, enclosureId)
+	siw.Handler.GetApiV1VetAppointmentsCalendar(c, params)
 }
 
-// PostApiV1EnclosuresEnclosureIdClean operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1EnclosuresEnclosureIdClean(c *gin.Context) {
+// DeleteApiV1VetAppointmentsAppointmentId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1VetAppointmentsAppointmentId(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "enclosureId" -------------
-	var enclosureId openapi_types.UUID
+	// ------------- Path parameter "appointmentId" -------------
+	var appointmentId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "enclosureId", c.Param("enclosureId"), &enclosureId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "appointmentId", c.Param("appointmentId"), &appointmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter enclosureId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter appointmentId: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -445,11 +3826,22 @@ func (siw *ServerInterfaceWrapper) PostApiV1EnclosuresEnclosureIdClean(c *gin.Co
 		}
 	}
 
-	siw.Handler.PostApiV1EnclosuresEnclosureIdClean(c, enclosureId)
+	siw.Handler.DeleteApiV1VetAppointmentsAppointmentId(c, appointmentId)
 }
 
-// GetApiV1FeedingSchedules operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1FeedingSchedules(c *gin.Context) {
+// GetApiV1VetAppointmentsAppointmentId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1VetAppointmentsAppointmentId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "appointmentId" -------------
+	var appointmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appointmentId", c.Param("appointmentId"), &appointmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter appointmentId: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -458,11 +3850,22 @@ func (siw *ServerInterfaceWrapper) GetApiV1FeedingSchedules(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiV1FeedingSchedules(c)
+	siw.Handler.GetApiV1VetAppointmentsAppointmentId(c, appointmentId)
 }
 
-// PostApiV1FeedingSchedules operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1FeedingSchedules(c *gin.Context) {
+// PutApiV1VetAppointmentsAppointmentId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1VetAppointmentsAppointmentId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "appointmentId" -------------
+	var appointmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appointmentId", c.Param("appointmentId"), &appointmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter appointmentId: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -471,20 +3874,20 @@ func (siw *ServerInterfaceWrapper) PostApiV1FeedingSchedules(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostApiV1FeedingSchedules(c)
+	siw.Handler.PutApiV1VetAppointmentsAppointmentId(c, appointmentId)
 }
 
-// DeleteApiV1FeedingSchedulesScheduleId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteApiV1FeedingSchedulesScheduleId(c *gin.Context) {
+// PostApiV1VetAppointmentsAppointmentIdCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1VetAppointmentsAppointmentIdCancel(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "scheduleId" -------------
-	var scheduleId openapi_types.UUID
+	// ------------- Path parameter "appointmentId" -------------
+	var appointmentId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "appointmentId", c.Param("appointmentId"), &appointmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter appointmentId: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -495,20 +3898,20 @@ func (siw *ServerInterfaceWrapper) DeleteApiV1FeedingSchedulesScheduleId(c *gin.
 		}
 	}
 
-	siw.Handler.DeleteApiV1FeedingSchedulesScheduleId(c, scheduleId)
+	siw.Handler.PostApiV1VetAppointmentsAppointmentIdCancel(c, appointmentId)
 }
 
-// GetApiV1FeedingSchedulesScheduleId operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1FeedingSchedulesScheduleId(c *gin.Context) {
+// PostApiV1VetAppointmentsAppointmentIdComplete operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1VetAppointmentsAppointmentIdComplete(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "scheduleId" -------------
-	var scheduleId openapi_types.UUID
+	// ------------- Path parameter "appointmentId" -------------
+	var appointmentId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "appointmentId", c.Param("appointmentId"), &appointmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter appointmentId: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -519,20 +3922,46 @@ func (siw *ServerInterfaceWrapper) GetApiV1FeedingSchedulesScheduleId(c *gin.Con
 		}
 	}
 
-	siw.Handler.GetApiV1FeedingSchedulesScheduleId(c, scheduleId)
+	siw.Handler.PostApiV1VetAppointmentsAppointmentIdComplete(c, appointmentId)
 }
 
-// PostApiV1FeedingSchedulesScheduleIdComplete operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1FeedingSchedulesScheduleIdComplete(c *gin.Context) {
+// GetApiV1Webhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Webhooks(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1Webhooks(c)
+}
+
+// PostApiV1Webhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Webhooks(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1Webhooks(c)
+}
+
+// DeleteApiV1WebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1WebhooksWebhookId(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "scheduleId" -------------
-	var scheduleId openapi_types.UUID
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookId: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -543,11 +3972,22 @@ func (siw *ServerInterfaceWrapper) PostApiV1FeedingSchedulesScheduleIdComplete(c
 		}
 	}
 
-	siw.Handler.PostApiV1FeedingSchedulesScheduleIdComplete(c, scheduleId)
+	siw.Handler.DeleteApiV1WebhooksWebhookId(c, webhookId)
 }
 
-// GetApiV1Statistics operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1Statistics(c *gin.Context) {
+// GetApiV1WebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1WebhooksWebhookId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookId: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -556,7 +3996,31 @@ func (siw *ServerInterfaceWrapper) GetApiV1Statistics(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiV1Statistics(c)
+	siw.Handler.GetApiV1WebhooksWebhookId(c, webhookId)
+}
+
+// GetApiV1WebhooksWebhookIdDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1WebhooksWebhookIdDeliveries(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1WebhooksWebhookIdDeliveries(c, webhookId)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -590,17 +4054,94 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/api/v1/animals", wrapper.PostApiV1Animals)
 	router.DELETE(options.BaseURL+"/api/v1/animals/:animalId", wrapper.DeleteApiV1AnimalsAnimalId)
 	router.GET(options.BaseURL+"/api/v1/animals/:animalId", wrapper.GetApiV1AnimalsAnimalId)
+	router.POST(options.BaseURL+"/api/v1/animals/:animalId/administrations", wrapper.PostApiV1AnimalsAnimalIdAdministrations)
+	router.POST(options.BaseURL+"/api/v1/animals/:animalId/diagnoses", wrapper.PostApiV1AnimalsAnimalIdDiagnoses)
+	router.GET(options.BaseURL+"/api/v1/animals/:animalId/doses", wrapper.GetApiV1AnimalsAnimalIdDoses)
+	router.POST(options.BaseURL+"/api/v1/animals/:animalId/illnesses", wrapper.PostApiV1AnimalsAnimalIdIllnesses)
+	router.GET(options.BaseURL+"/api/v1/animals/:animalId/medical-record", wrapper.GetApiV1AnimalsAnimalIdMedicalRecord)
 	router.POST(options.BaseURL+"/api/v1/animals/:animalId/move", wrapper.PostApiV1AnimalsAnimalIdMove)
+	router.POST(options.BaseURL+"/api/v1/animals/:animalId/quarantine", wrapper.PostApiV1AnimalsAnimalIdQuarantine)
+	router.POST(options.BaseURL+"/api/v1/animals/:animalId/status", wrapper.PostApiV1AnimalsAnimalIdStatus)
+	router.GET(options.BaseURL+"/api/v1/animals/:animalId/suitable-enclosures", wrapper.GetApiV1AnimalsAnimalIdSuitableEnclosures)
 	router.POST(options.BaseURL+"/api/v1/animals/:animalId/treat", wrapper.PostApiV1AnimalsAnimalIdTreat)
+	router.GET(options.BaseURL+"/api/v1/animals/:animalId/weights", wrapper.GetApiV1AnimalsAnimalIdWeights)
+	router.POST(options.BaseURL+"/api/v1/animals/:animalId/weights", wrapper.PostApiV1AnimalsAnimalIdWeights)
+	router.GET(options.BaseURL+"/api/v1/care-tasks", wrapper.GetApiV1CareTasks)
+	router.POST(options.BaseURL+"/api/v1/care-tasks", wrapper.PostApiV1CareTasks)
+	router.DELETE(options.BaseURL+"/api/v1/care-tasks/:taskId", wrapper.DeleteApiV1CareTasksTaskId)
+	router.GET(options.BaseURL+"/api/v1/care-tasks/:taskId", wrapper.GetApiV1CareTasksTaskId)
+	router.PUT(options.BaseURL+"/api/v1/care-tasks/:taskId/assignee", wrapper.PutApiV1CareTasksTaskIdAssignee)
+	router.GET(options.BaseURL+"/api/v1/dead-letters", wrapper.GetApiV1DeadLetters)
+	router.POST(options.BaseURL+"/api/v1/dead-letters/:deadLetterId/redrive", wrapper.PostApiV1DeadLettersDeadLetterIdRedrive)
+	router.GET(options.BaseURL+"/api/v1/drugs", wrapper.GetApiV1Drugs)
+	router.POST(options.BaseURL+"/api/v1/drugs", wrapper.PostApiV1Drugs)
+	router.DELETE(options.BaseURL+"/api/v1/drugs/:drugName", wrapper.DeleteApiV1DrugsDrugName)
+	router.GET(options.BaseURL+"/api/v1/drugs/:drugName", wrapper.GetApiV1DrugsDrugName)
+	router.PUT(options.BaseURL+"/api/v1/drugs/:drugName", wrapper.PutApiV1DrugsDrugName)
+	router.GET(options.BaseURL+"/api/v1/enclosure-types", wrapper.GetApiV1EnclosureTypes)
 	router.GET(options.BaseURL+"/api/v1/enclosures", wrapper.GetApiV1Enclosures)
 	router.POST(options.BaseURL+"/api/v1/enclosures", wrapper.PostApiV1Enclosures)
 	router.DELETE(options.BaseURL+"/api/v1/enclosures/:enclosureId", wrapper.DeleteApiV1EnclosuresEnclosureId)
 	router.GET(options.BaseURL+"/api/v1/enclosures/:enclosureId", wrapper.GetApiV1EnclosuresEnclosureId)
 	router.POST(options.BaseURL+"/api/v1/enclosures/:enclosureId/clean", wrapper.PostApiV1EnclosuresEnclosureIdClean)
+	router.GET(options.BaseURL+"/api/v1/enclosures/:enclosureId/keepers", wrapper.GetApiV1EnclosuresEnclosureIdKeepers)
+	router.GET(options.BaseURL+"/api/v1/events", wrapper.GetApiV1Events)
+	router.POST(options.BaseURL+"/api/v1/events/replay", wrapper.PostApiV1EventsReplay)
+	router.GET(options.BaseURL+"/api/v1/events/stream", wrapper.GetApiV1EventsStream)
 	router.GET(options.BaseURL+"/api/v1/feeding-schedules", wrapper.GetApiV1FeedingSchedules)
 	router.POST(options.BaseURL+"/api/v1/feeding-schedules", wrapper.PostApiV1FeedingSchedules)
 	router.DELETE(options.BaseURL+"/api/v1/feeding-schedules/:scheduleId", wrapper.DeleteApiV1FeedingSchedulesScheduleId)
 	router.GET(options.BaseURL+"/api/v1/feeding-schedules/:scheduleId", wrapper.GetApiV1FeedingSchedulesScheduleId)
+	router.PUT(options.BaseURL+"/api/v1/feeding-schedules/:scheduleId/assignee", wrapper.PutApiV1FeedingSchedulesScheduleIdAssignee)
 	router.POST(options.BaseURL+"/api/v1/feeding-schedules/:scheduleId/complete", wrapper.PostApiV1FeedingSchedulesScheduleIdComplete)
+	router.GET(options.BaseURL+"/api/v1/feeding-schedules/:scheduleId/occurrences", wrapper.GetApiV1FeedingSchedulesScheduleIdOccurrences)
+	router.POST(options.BaseURL+"/api/v1/feeding-schedules/:scheduleId/occurrences/complete", wrapper.PostApiV1FeedingSchedulesScheduleIdOccurrencesComplete)
+	router.POST(options.BaseURL+"/api/v1/feeding-schedules/:scheduleId/occurrences/skip", wrapper.PostApiV1FeedingSchedulesScheduleIdOccurrencesSkip)
+	router.POST(options.BaseURL+"/api/v1/feedings/run", wrapper.PostApiV1FeedingsRun)
+	router.GET(options.BaseURL+"/api/v1/food-stocks", wrapper.GetApiV1FoodStocks)
+	router.POST(options.BaseURL+"/api/v1/food-stocks", wrapper.PostApiV1FoodStocks)
+	router.DELETE(options.BaseURL+"/api/v1/food-stocks/:food", wrapper.DeleteApiV1FoodStocksFood)
+	router.GET(options.BaseURL+"/api/v1/food-stocks/:food", wrapper.GetApiV1FoodStocksFood)
+	router.POST(options.BaseURL+"/api/v1/food-stocks/:food/deliveries", wrapper.PostApiV1FoodStocksFoodDeliveries)
+	router.GET(options.BaseURL+"/api/v1/keepers", wrapper.GetApiV1Keepers)
+	router.POST(options.BaseURL+"/api/v1/keepers", wrapper.PostApiV1Keepers)
+	router.DELETE(options.BaseURL+"/api/v1/keepers/:keeperId", wrapper.DeleteApiV1KeepersKeeperId)
+	router.GET(options.BaseURL+"/api/v1/keepers/:keeperId", wrapper.GetApiV1KeepersKeeperId)
+	router.PUT(options.BaseURL+"/api/v1/keepers/:keeperId", wrapper.PutApiV1KeepersKeeperId)
+	router.POST(options.BaseURL+"/api/v1/keepers/:keeperId/assignments", wrapper.PostApiV1KeepersKeeperIdAssignments)
+	router.DELETE(options.BaseURL+"/api/v1/keepers/:keeperId/assignments/:shiftName/:enclosureId", wrapper.DeleteApiV1KeepersKeeperIdAssignmentsShiftNameEnclosureId)
+	router.GET(options.BaseURL+"/api/v1/keepers/:keeperId/tasks", wrapper.GetApiV1KeepersKeeperIdTasks)
+	router.POST(options.BaseURL+"/api/v1/keepers/:keeperId/tasks/complete", wrapper.PostApiV1KeepersKeeperIdTasksComplete)
+	router.POST(options.BaseURL+"/api/v1/placement/execute", wrapper.PostApiV1PlacementExecute)
+	router.POST(options.BaseURL+"/api/v1/placement/plan", wrapper.PostApiV1PlacementPlan)
+	router.GET(options.BaseURL+"/api/v1/quarantines", wrapper.GetApiV1Quarantines)
+	router.GET(options.BaseURL+"/api/v1/quarantines/:quarantineId", wrapper.GetApiV1QuarantinesQuarantineId)
+	router.POST(options.BaseURL+"/api/v1/quarantines/:quarantineId/clear", wrapper.PostApiV1QuarantinesQuarantineIdClear)
+	router.GET(options.BaseURL+"/api/v1/reports/food-forecast", wrapper.GetApiV1ReportsFoodForecast)
+	router.GET(options.BaseURL+"/api/v1/reports/low-stock", wrapper.GetApiV1ReportsLowStock)
+	router.GET(options.BaseURL+"/api/v1/shifts", wrapper.GetApiV1Shifts)
+	router.POST(options.BaseURL+"/api/v1/shifts", wrapper.PostApiV1Shifts)
+	router.DELETE(options.BaseURL+"/api/v1/shifts/:shiftName", wrapper.DeleteApiV1ShiftsShiftName)
+	router.GET(options.BaseURL+"/api/v1/shifts/:shiftName", wrapper.GetApiV1ShiftsShiftName)
+	router.PUT(options.BaseURL+"/api/v1/shifts/:shiftName", wrapper.PutApiV1ShiftsShiftName)
+	router.GET(options.BaseURL+"/api/v1/species", wrapper.GetApiV1Species)
+	router.POST(options.BaseURL+"/api/v1/species", wrapper.PostApiV1Species)
+	router.DELETE(options.BaseURL+"/api/v1/species/:speciesName", wrapper.DeleteApiV1SpeciesSpeciesName)
+	router.GET(options.BaseURL+"/api/v1/species/:speciesName", wrapper.GetApiV1SpeciesSpeciesName)
+	router.PUT(options.BaseURL+"/api/v1/species/:speciesName", wrapper.PutApiV1SpeciesSpeciesName)
 	router.GET(options.BaseURL+"/api/v1/statistics", wrapper.GetApiV1Statistics)
+	router.POST(options.BaseURL+"/api/v1/transfers", wrapper.PostApiV1Transfers)
+	router.GET(options.BaseURL+"/api/v1/vet-appointments", wrapper.GetApiV1VetAppointments)
+	router.POST(options.BaseURL+"/api/v1/vet-appointments", wrapper.PostApiV1VetAppointments)
+	router.GET(options.BaseURL+"/api/v1/vet-appointments/calendar", wrapper.GetApiV1VetAppointmentsCalendar)
+	router.DELETE(options.BaseURL+"/api/v1/vet-appointments/:appointmentId", wrapper.DeleteApiV1VetAppointmentsAppointmentId)
+	router.GET(options.BaseURL+"/api/v1/vet-appointments/:appointmentId", wrapper.GetApiV1VetAppointmentsAppointmentId)
+	router.PUT(options.BaseURL+"/api/v1/vet-appointments/:appointmentId", wrapper.PutApiV1VetAppointmentsAppointmentId)
+	router.POST(options.BaseURL+"/api/v1/vet-appointments/:appointmentId/cancel", wrapper.PostApiV1VetAppointmentsAppointmentIdCancel)
+	router.POST(options.BaseURL+"/api/v1/vet-appointments/:appointmentId/complete", wrapper.PostApiV1VetAppointmentsAppointmentIdComplete)
+	router.GET(options.BaseURL+"/api/v1/webhooks", wrapper.GetApiV1Webhooks)
+	router.POST(options.BaseURL+"/api/v1/webhooks", wrapper.PostApiV1Webhooks)
+	router.DELETE(options.BaseURL+"/api/v1/webhooks/:webhookId", wrapper.DeleteApiV1WebhooksWebhookId)
+	router.GET(options.BaseURL+"/api/v1/webhooks/:webhookId", wrapper.GetApiV1WebhooksWebhookId)
+	router.GET(options.BaseURL+"/api/v1/webhooks/:webhookId/deliveries", wrapper.GetApiV1WebhooksWebhookIdDeliveries)
 }
@@ -0,0 +1,397 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	_ Dispatcher      = (*AsyncDispatcher)(nil)
+	_ HandlerSource   = (*AsyncDispatcher)(nil)
+	_ DeadLetterQueue = (*AsyncDispatcher)(nil)
+)
+
+var ErrDispatcherClosed = errors.New("dispatcher is shut down")
+
+// HandlerOptions configures the worker pool of a single handler.
+type HandlerOptions struct {
+	Workers     int
+	QueueSize   int
+	RetryPolicy RetryPolicy
+}
+
+func DefaultHandlerOptions() HandlerOptions {
+	return HandlerOptions{
+		Workers:     1,
+		QueueSize:   100,
+		RetryPolicy: DefaultRetryPolicy(),
+	}
+}
+
+// AsyncDispatcher delivers events to every handler through its own bounded queue served by
+// a pool of workers. Failed deliveries are retried according to the handler's RetryPolicy;
+// events that exhaust their retries are moved to the dead-letter store. Deliveries someone
+// waits for (see Handlers) are tried once, their source retries and dead-letters them.
+type AsyncDispatcher struct {
+	pools       map[string][]*handlerPool
+	mu          sync.RWMutex
+	options     HandlerOptions
+	deadLetters DeadLetterStore
+	registry    *Registry
+	// ctx is cancelled by Shutdown, interrupting handlers and pending retries.
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewAsyncDispatcher(deadLetters DeadLetterStore, registry *Registry, options HandlerOptions) *AsyncDispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &AsyncDispatcher{
+		pools:       make(map[string][]*handlerPool),
+		options:     options,
+		deadLetters: deadLetters,
+		registry:    registry,
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+type delivery struct {
+	ctx     context.Context
+	release func()
+	event   Event
+	// result receives the outcome of a delivery someone waits for: the error of the only
+	// attempt, nil if it has succeeded. Nil for fire-and-forget deliveries.
+	result chan<- error
+}
+
+// finish releases the context of the delivery and reports its outcome.
+func (job delivery) finish(err error) {
+	job.release()
+
+	if job.result != nil {
+		job.result <- err
+	}
+}
+
+type handlerPool struct {
+	name    string
+	handler EventHandler
+	options HandlerOptions
+	queue   chan delivery
+}
+
+// RegisterHandler registers a handler with the dispatcher's default options.
+func (d *AsyncDispatcher) RegisterHandler(eventType string, handler EventHandler) {
+	d.RegisterHandlerWithOptions(eventType, handler, d.options)
+}
+
+// RegisterHandlerWithOptions registers a handler and starts its worker pool.
+func (d *AsyncDispatcher) RegisterHandlerWithOptions(eventType string, handler EventHandler, options HandlerOptions) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	handlers := make([]EventHandler, 0, len(d.pools[eventType])+1)
+	for _, pool := range d.pools[eventType] {
+		handlers = append(handlers, pool.handler)
+	}
+
+	handlers = append(handlers, handler)
+	names := handlerNames(handlers)
+
+	pool := &handlerPool{
+		name:    names[len(names)-1],
+		handler: handler,
+		options: options,
+		queue:   make(chan delivery, options.QueueSize),
+	}
+
+	d.pools[eventType] = append(d.pools[eventType], pool)
+
+	for range max(options.Workers, 1) {
+		d.wg.Add(1)
+
+		go d.work(pool)
+	}
+}
+
+// Dispatch enqueues the event for every handler registered for it. When a handler's queue
+// is full Dispatch waits for free space; if ctx is cancelled first, the event is dead-lettered.
+func (d *AsyncDispatcher) Dispatch(ctx context.Context, event Event) {
+	for _, pool := range d.handlerPools(event.Name()) {
+		if err := d.enqueue(ctx, pool, event, nil); err != nil {
+			_ = d.deadLetter(ctx, pool, event, err, 0)
+		}
+	}
+}
+
+// Handlers returns handlers that pass events to the pools of the registered handlers and wait
+// for the outcome of a single attempt. They keep the names of the original handlers, so per-handler
+// delivery tracking (e.g. by OutboxRelay) treats an event as delivered only once its handler
+// has succeeded. Retrying failed events and dead-lettering them is left to the caller.
+func (d *AsyncDispatcher) Handlers(eventType string) []EventHandler {
+	pools := d.handlerPools(eventType)
+
+	handlers := make([]EventHandler, len(pools))
+	for i, pool := range pools {
+		handlers[i] = &queuedHandler{dispatcher: d, pool: pool}
+	}
+
+	return handlers
+}
+
+func (d *AsyncDispatcher) DeadLetters(ctx context.Context) ([]*DeadLetter, error) {
+	return d.deadLetters.GetAllDeadLetters(ctx)
+}
+
+// Redrive enqueues a dead letter for its handler again and removes it from the dead-letter store.
+func (d *AsyncDispatcher) Redrive(ctx context.Context, id uuid.UUID) error {
+	letter, err := d.deadLetters.GetDeadLetter(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	event, err := d.registry.Decode(letter.EventType, letter.Payload)
+	if err != nil {
+		return fmt.Errorf("redriving dead letter %s: %w", id, err)
+	}
+
+	var target *handlerPool
+
+	for _, pool := range d.handlerPools(letter.EventType) {
+		if pool.name == letter.Handler {
+			target = pool
+			break
+		}
+	}
+
+	if target == nil {
+		return fmt.Errorf("redriving dead letter %s: %w: %s is not registered for %s", id, ErrHandlerNotFound, letter.Handler, letter.EventType)
+	}
+
+	if err := d.enqueue(ctx, target, event, nil); err != nil {
+		return fmt.Errorf("redriving dead letter %s: %w", id, err)
+	}
+
+	if err := d.deadLetters.DeleteDeadLetter(ctx, id); err != nil {
+		return fmt.Errorf("deleting redriven dead letter %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// Shutdown stops the workers, interrupting running handlers and pending retries, and dead-letters
+// every event that has not been handled yet. Events someone waits for are not dead-lettered:
+// the waiter is told the dispatcher is closed, so their source (e.g. the outbox) keeps them.
+func (d *AsyncDispatcher) Shutdown(ctx context.Context) error {
+	d.cancel()
+
+	stopped := make(chan struct{})
+
+	go func() {
+		d.wg.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for event workers: %w", ctx.Err())
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	for _, pools := range d.pools {
+		for _, pool := range pools {
+			d.drain(pool)
+		}
+	}
+
+	return nil
+}
+
+func (d *AsyncDispatcher) drain(pool *handlerPool) {
+	for {
+		select {
+		case job := <-pool.queue:
+			d.abandon(pool, job, ErrDispatcherClosed, 0)
+		default:
+			return
+		}
+	}
+}
+
+// handlerPools returns the pools of handlers registered for eventType followed by those registered for AllEvents.
+func (d *AsyncDispatcher) handlerPools(eventType string) []*handlerPool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	pools := make([]*handlerPool, 0, len(d.pools[eventType])+len(d.pools[AllEvents]))
+	pools = append(pools, d.pools[eventType]...)
+
+	if eventType != AllEvents {
+		pools = append(pools, d.pools[AllEvents]...)
+	}
+
+	return pools
+}
+
+func (d *AsyncDispatcher) enqueue(ctx context.Context, pool *handlerPool, event Event, result chan<- error) error {
+	// Handlers run after the caller has returned, so they must not inherit its cancellation,
+	// but Shutdown must still be able to interrupt them.
+	jobCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(d.ctx, cancel)
+
+	job := delivery{
+		ctx:     jobCtx,
+		release: func() { stop(); cancel() },
+		event:   event,
+		result:  result,
+	}
+
+	if d.ctx.Err() != nil {
+		job.release()
+		return ErrDispatcherClosed
+	}
+
+	select {
+	case pool.queue <- job:
+		return nil
+	case <-d.ctx.Done():
+		job.release()
+		return ErrDispatcherClosed
+	case <-ctx.Done():
+		job.release()
+		return fmt.Errorf("queue of handler %s is full: %w", pool.name, ctx.Err())
+	}
+}
+
+func (d *AsyncDispatcher) work(pool *handlerPool) {
+	defer d.wg.Done()
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case job := <-pool.queue:
+			d.deliver(pool, job)
+		}
+	}
+}
+
+func (d *AsyncDispatcher) deliver(pool *handlerPool, job delivery) {
+	policy := pool.options.RetryPolicy
+
+	for attempt := 1; ; attempt++ {
+		err := pool.handler.Handle(job.ctx, job.event)
+		if err == nil {
+			job.finish(nil)
+			return
+		}
+
+		if d.ctx.Err() != nil {
+			d.abandon(pool, job, err, attempt)
+			return
+		}
+
+		// The waiter retries the event on its own schedule and dead-letters it when it gives up
+		if job.result != nil {
+			job.finish(err)
+			return
+		}
+
+		if attempt >= policy.MaxAttempts {
+			job.finish(d.deadLetter(job.ctx, pool, job.event, err, attempt))
+			return
+		}
+
+		timer := time.NewTimer(policy.Backoff(attempt))
+
+		select {
+		case <-timer.C:
+		case <-d.ctx.Done():
+			timer.Stop()
+			d.abandon(pool, job, err, attempt)
+
+			return
+		}
+	}
+}
+
+// abandon gives up on a delivery interrupted by Shutdown. Waiters get ErrDispatcherClosed
+// and redeliver the event later; other events are dead-lettered.
+func (d *AsyncDispatcher) abandon(pool *handlerPool, job delivery, cause error, attempts int) {
+	if job.result != nil {
+		job.finish(fmt.Errorf("%w: %w", ErrDispatcherClosed, cause))
+		return
+	}
+
+	job.finish(d.deadLetter(job.ctx, pool, job.event, cause, attempts))
+}
+
+// deadLetter stores the event as a dead letter of the handler. It is stored even if ctx
+// has been cancelled by Shutdown.
+func (d *AsyncDispatcher) deadLetter(ctx context.Context, pool *handlerPool, event Event, cause error, attempts int) error {
+	payload, err := Encode(event)
+	if err != nil {
+		log.Printf("Dropping %s event for handler %s: %v", event.Name(), pool.name, err)
+		return fmt.Errorf("encoding dead letter: %w", err)
+	}
+
+	letter := &DeadLetter{
+		ID:        uuid.New(),
+		EventType: event.Name(),
+		Handler:   pool.name,
+		Payload:   payload,
+		Error:     cause.Error(),
+		Attempts:  attempts,
+		FailedAt:  time.Now(),
+	}
+
+	if err := d.deadLetters.AddDeadLetter(context.WithoutCancel(ctx), letter); err != nil {
+		log.Printf("Dropping %s event for handler %s: storing dead letter: %v", event.Name(), pool.name, err)
+		return fmt.Errorf("storing dead letter: %w", err)
+	}
+
+	log.Printf("Handler %s gave up on %s event after %d attempts: %v", pool.name, event.Name(), attempts, cause)
+
+	return nil
+}
+
+// queuedHandler hands events over to the worker pool of a registered handler.
+type queuedHandler struct {
+	dispatcher *AsyncDispatcher
+	pool       *handlerPool
+}
+
+// Handle waits until the handler has made an attempt to process the event or ctx is cancelled.
+func (h *queuedHandler) Handle(ctx context.Context, event Event) error {
+	result := make(chan error, 1)
+
+	if err := h.dispatcher.enqueue(ctx, h.pool, event, result); err != nil {
+		return err
+	}
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (h *queuedHandler) HandlerName() string {
+	return h.pool.name
+}
+
+func (h *queuedHandler) Replayable() bool {
+	return IsReplayable(h.pool.handler)
+}
@@ -0,0 +1,196 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+const subscriptionBufferSize = 64
+
+// StreamEvent is an event numbered by its sequence number in the event store.
+type StreamEvent struct {
+	ID        uint64
+	EventType string
+	Payload   json.RawMessage
+}
+
+// Broadcaster fans events appended to a Store out to live subscribers. It is fed by a Recorder,
+// so stream IDs are the sequence numbers of the store and stay valid across restarts.
+// The most recent events are kept in a ring buffer; subscribers resuming from an older
+// event, e.g. after a restart, get the events they missed from the store.
+type Broadcaster struct {
+	store       Store
+	mu          sync.Mutex
+	buffer      []*StreamEvent
+	start       int
+	subscribers map[*Subscription]struct{}
+	closed      bool
+}
+
+func NewBroadcaster(store Store, bufferSize int) *Broadcaster {
+	return &Broadcaster{
+		store:       store,
+		buffer:      make([]*StreamEvent, 0, max(bufferSize, 1)),
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscription receives broadcast events until it is closed. Its channel is closed
+// when the subscriber falls too far behind or the broadcaster is closed.
+type Subscription struct {
+	broadcaster *Broadcaster
+	eventTypes  []string
+	events      chan *StreamEvent
+	// after is the ID of the last event delivered from the backlog; later events
+	// published with lower IDs have already been delivered.
+	after uint64
+}
+
+func (s *Subscription) Events() <-chan *StreamEvent {
+	return s.events
+}
+
+func (s *Subscription) Close() {
+	s.broadcaster.mu.Lock()
+	defer s.broadcaster.mu.Unlock()
+
+	s.broadcaster.unsubscribe(s)
+}
+
+func (s *Subscription) matches(event *StreamEvent) bool {
+	return len(s.eventTypes) == 0 || slices.Contains(s.eventTypes, event.EventType)
+}
+
+// Publish broadcasts an event appended to the store.
+func (b *Broadcaster) Publish(stored *StoredEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	streamEvent := &StreamEvent{
+		ID:        stored.Sequence,
+		EventType: stored.EventType,
+		Payload:   stored.Payload,
+	}
+
+	if len(b.buffer) < cap(b.buffer) {
+		b.buffer = append(b.buffer, streamEvent)
+	} else {
+		b.buffer[b.start] = streamEvent
+		b.start = (b.start + 1) % len(b.buffer)
+	}
+
+	for subscription := range b.subscribers {
+		if streamEvent.ID <= subscription.after || !subscription.matches(streamEvent) {
+			continue
+		}
+
+		select {
+		case subscription.events <- streamEvent:
+		default:
+			// The subscriber is too slow; it can reconnect and resume where it stopped.
+			b.unsubscribe(subscription)
+		}
+	}
+}
+
+// Subscribe returns a subscription to events of the given types (all events if none are given).
+// If lastEventID is not zero, events that came after it are delivered first.
+func (b *Broadcaster) Subscribe(ctx context.Context, lastEventID uint64, eventTypes []string) (*Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	subscription := &Subscription{
+		broadcaster: b,
+		eventTypes:  eventTypes,
+		after:       lastEventID,
+	}
+
+	var backlog []*StreamEvent
+
+	if lastEventID > 0 {
+		var err error
+
+		backlog, err = b.backlog(ctx, subscription)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(backlog) > 0 {
+		subscription.after = backlog[len(backlog)-1].ID
+	}
+
+	subscription.events = make(chan *StreamEvent, len(backlog)+subscriptionBufferSize)
+	for _, event := range backlog {
+		subscription.events <- event
+	}
+
+	if b.closed {
+		close(subscription.events)
+		return subscription, nil
+	}
+
+	b.subscribers[subscription] = struct{}{}
+
+	return subscription, nil
+}
+
+// backlog returns the events the subscription missed, from the buffer if it still holds
+// all of them and from the store otherwise. It must be called with the lock held.
+func (b *Broadcaster) backlog(ctx context.Context, subscription *Subscription) ([]*StreamEvent, error) {
+	var backlog []*StreamEvent
+
+	if len(b.buffer) > 0 && b.buffer[b.start].ID <= subscription.after+1 {
+		for i := range b.buffer {
+			event := b.buffer[(b.start+i)%len(b.buffer)]
+			if event.ID > subscription.after && subscription.matches(event) {
+				backlog = append(backlog, event)
+			}
+		}
+
+		return backlog, nil
+	}
+
+	stored, err := b.store.Query(ctx, EventQuery{
+		EventTypes:    subscription.eventTypes,
+		AfterSequence: subscription.after,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("querying missed events: %w", err)
+	}
+
+	for _, event := range stored {
+		backlog = append(backlog, &StreamEvent{
+			ID:        event.Sequence,
+			EventType: event.EventType,
+			Payload:   event.Payload,
+		})
+	}
+
+	return backlog, nil
+}
+
+// Close ends every subscription. Events handled afterwards are still buffered.
+func (b *Broadcaster) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+
+	for subscription := range b.subscribers {
+		b.unsubscribe(subscription)
+	}
+}
+
+// unsubscribe must be called with the lock held.
+func (b *Broadcaster) unsubscribe(subscription *Subscription) {
+	if _, ok := b.subscribers[subscription]; !ok {
+		return
+	}
+
+	delete(b.subscribers, subscription)
+	close(subscription.events)
+}
@@ -0,0 +1,94 @@
+package events_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/maklybae/ddd-zoo/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEvent struct {
+	N int `json:"n"`
+}
+
+func (testEvent) Name() string {
+	return "test.event"
+}
+
+func receive(t *testing.T, subscription *events.Subscription) *events.StreamEvent {
+	t.Helper()
+
+	select {
+	case event := <-subscription.Events():
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return nil
+	}
+}
+
+func TestBroadcasterResumesAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+
+	store, err := events.OpenFileStore(path)
+	require.NoError(t, err)
+
+	recorder := events.NewRecorder(store, events.NewBroadcaster(store, 10))
+	for n := 1; n <= 3; n++ {
+		require.NoError(t, recorder.Handle(ctx, testEvent{N: n}))
+	}
+
+	require.NoError(t, store.Close())
+
+	// After a restart the buffer is empty, so the missed events come from the store
+	store, err = events.OpenFileStore(path)
+	require.NoError(t, err)
+	defer store.Close()
+
+	broadcaster := events.NewBroadcaster(store, 10)
+	recorder = events.NewRecorder(store, broadcaster)
+
+	subscription, err := broadcaster.Subscribe(ctx, 1, nil)
+	require.NoError(t, err)
+	defer subscription.Close()
+
+	require.NoError(t, recorder.Handle(ctx, testEvent{N: 4}))
+
+	for _, id := range []uint64{2, 3, 4} {
+		event := receive(t, subscription)
+		assert.Equal(t, id, event.ID)
+		assert.Equal(t, "test.event", event.EventType)
+	}
+}
+
+func TestBroadcasterResumesFromBuffer(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := events.OpenFileStore(filepath.Join(t.TempDir(), "events.jsonl"))
+	require.NoError(t, err)
+	defer store.Close()
+
+	broadcaster := events.NewBroadcaster(store, 2)
+	recorder := events.NewRecorder(store, broadcaster)
+
+	for n := 1; n <= 4; n++ {
+		require.NoError(t, recorder.Handle(ctx, testEvent{N: n}))
+	}
+
+	// Event 3 is still buffered, event 2 only in the store
+	for _, lastEventID := range []uint64{2, 1} {
+		subscription, err := broadcaster.Subscribe(ctx, lastEventID, nil)
+		require.NoError(t, err)
+
+		for id := lastEventID + 1; id <= 4; id++ {
+			assert.Equal(t, id, receive(t, subscription).ID)
+		}
+
+		subscription.Close()
+	}
+}
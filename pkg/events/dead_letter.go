@@ -0,0 +1,65 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// DeadLetter is an event a handler failed to process after exhausting its retries.
+type DeadLetter struct {
+	ID        uuid.UUID
+	EventType string
+	Handler   string
+	Payload   []byte
+	Error     string
+	Attempts  int
+	FailedAt  time.Time
+}
+
+// DeadLetterStore persists dead letters until they are re-driven.
+type DeadLetterStore interface {
+	AddDeadLetter(ctx context.Context, letter *DeadLetter) error
+	// GetDeadLetter returns ErrDeadLetterNotFound if there is no dead letter with the given id.
+	GetDeadLetter(ctx context.Context, id uuid.UUID) (*DeadLetter, error)
+	// GetAllDeadLetters returns dead letters, oldest first.
+	GetAllDeadLetters(ctx context.Context) ([]*DeadLetter, error)
+	DeleteDeadLetter(ctx context.Context, id uuid.UUID) error
+}
+
+// DeadLetterQueue lists dead letters and hands them back to their handlers.
+type DeadLetterQueue interface {
+	DeadLetters(ctx context.Context) ([]*DeadLetter, error)
+	Redrive(ctx context.Context, id uuid.UUID) error
+}
+
+// DeadLetterQueues combines the queues of dispatchers sharing a DeadLetterStore. Dead letters are
+// listed by the first queue and re-driven by the queue whose dispatcher has their handler.
+type DeadLetterQueues []DeadLetterQueue
+
+var _ DeadLetterQueue = DeadLetterQueues(nil)
+
+func (qs DeadLetterQueues) DeadLetters(ctx context.Context) ([]*DeadLetter, error) {
+	if len(qs) == 0 {
+		return nil, nil
+	}
+
+	return qs[0].DeadLetters(ctx)
+}
+
+func (qs DeadLetterQueues) Redrive(ctx context.Context, id uuid.UUID) error {
+	err := fmt.Errorf("redriving dead letter %s: %w", id, ErrHandlerNotFound)
+
+	for _, q := range qs {
+		if err = q.Redrive(ctx, id); !errors.Is(err, ErrHandlerNotFound) {
+			return err
+		}
+	}
+
+	return err
+}
@@ -6,11 +6,20 @@ import (
 	"sync"
 )
 
+// AllEvents can be passed to RegisterHandler to subscribe a handler to every event.
+const AllEvents = "*"
+
 type Dispatcher interface {
 	RegisterHandler(eventType string, handler EventHandler)
 	Dispatch(ctx context.Context, event Event)
 }
 
+// Static check that the interfaces are implemented.
+var (
+	_ Dispatcher    = (*EventDispatcher)(nil)
+	_ HandlerSource = (*EventDispatcher)(nil)
+)
+
 type EventDispatcher struct {
 	handlers map[string][]EventHandler
 	mu       sync.RWMutex
@@ -37,11 +46,29 @@ func (d *EventDispatcher) Dispatch(ctx context.Context, event Event) {
 	d.mu.RLock()
 	defer d.mu.RUnlock()
 
-	if handlers, ok := d.handlers[event.Name()]; ok {
-		for _, handler := range handlers {
-			if err := handler.Handle(ctx, event); err != nil {
-				fmt.Println("Error handling event:", err)
-			}
+	for _, handler := range d.handlersFor(event.Name()) {
+		if err := handler.Handle(ctx, event); err != nil {
+			fmt.Println("Error handling event:", err)
 		}
 	}
 }
+
+func (d *EventDispatcher) Handlers(eventType string) []EventHandler {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.handlersFor(eventType)
+}
+
+// handlersFor returns handlers registered for eventType followed by those registered for AllEvents.
+// The caller must hold the read lock.
+func (d *EventDispatcher) handlersFor(eventType string) []EventHandler {
+	handlers := make([]EventHandler, 0, len(d.handlers[eventType])+len(d.handlers[AllEvents]))
+	handlers = append(handlers, d.handlers[eventType]...)
+
+	if eventType != AllEvents {
+		handlers = append(handlers, d.handlers[AllEvents]...)
+	}
+
+	return handlers
+}
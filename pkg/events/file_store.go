@@ -0,0 +1,190 @@
+package events
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"os"
+	"sync"
+	"time"
+)
+
+var _ Store = (*FileStore)(nil)
+
+// FileStore keeps events in a JSON Lines file, one stored event per line. Queries stream
+// the file from disk, only the position of its end and the last sequence number are kept
+// in memory. Appends are synced to disk before returning.
+type FileStore struct {
+	file *os.File
+	// size is the length of the complete lines of the file, the part queries read.
+	size         int64
+	lastSequence uint64
+	mu           sync.RWMutex
+}
+
+// OpenFileStore opens (creating if necessary) the event log at path.
+// A trailing incomplete line left by an interrupted write is discarded.
+func OpenFileStore(path string) (*FileStore, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("opening event store: %w", err)
+	}
+
+	store := &FileStore{file: file}
+
+	if err := store.load(); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *FileStore) load() error {
+	size, err := s.scan(math.MaxInt64, func(event *StoredEvent) bool {
+		s.lastSequence = event.Sequence
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	s.size = size
+
+	if err := s.file.Truncate(size); err != nil {
+		return fmt.Errorf("truncating event store: %w", err)
+	}
+
+	if _, err := s.file.Seek(size, io.SeekStart); err != nil {
+		return fmt.Errorf("seeking event store: %w", err)
+	}
+
+	return nil
+}
+
+// scan decodes the events in the first limit bytes of the file and passes them to yield
+// until it returns false. It returns the length of the complete lines it has read.
+func (s *FileStore) scan(limit int64, yield func(event *StoredEvent) bool) (int64, error) {
+	reader := bufio.NewReader(io.NewSectionReader(s.file, 0, limit))
+
+	var offset int64
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(bytes.TrimSpace(line)) > 0 {
+				log.Printf("Discarding incomplete event at offset %d", offset)
+			}
+
+			return offset, nil
+		}
+
+		if err != nil {
+			return offset, fmt.Errorf("reading event store: %w", err)
+		}
+
+		var event StoredEvent
+		if err := json.Unmarshal(line, &event); err != nil {
+			return offset, fmt.Errorf("decoding event at offset %d: %w", offset, err)
+		}
+
+		offset += int64(len(line))
+
+		if !yield(&event) {
+			return offset, nil
+		}
+	}
+}
+
+func (s *FileStore) Append(ctx context.Context, event Event, recordedAt time.Time) (*StoredEvent, error) {
+	payload, err := Encode(event)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := &StoredEvent{
+		EventType:  event.Name(),
+		Payload:    payload,
+		RecordedAt: recordedAt.UTC(),
+	}
+
+	if aggregate, ok := event.(AggregateEvent); ok {
+		stored.AggregateIDs = aggregate.AggregateIDs()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored.Sequence = s.lastSequence + 1
+
+	line, err := json.Marshal(stored)
+	if err != nil {
+		return nil, fmt.Errorf("encoding stored event: %w", err)
+	}
+
+	line = append(line, '\n')
+
+	if _, err := s.file.Write(line); err != nil {
+		// A partially written line would corrupt the next append
+		s.rollback()
+
+		return nil, fmt.Errorf("writing event store: %w", err)
+	}
+
+	if err := s.file.Sync(); err != nil {
+		// The event is not durable, so it is not appended: otherwise the next append
+		// would reuse its sequence number
+		s.rollback()
+
+		return nil, fmt.Errorf("syncing event store: %w", err)
+	}
+
+	s.size += int64(len(line))
+	s.lastSequence = stored.Sequence
+
+	return stored, nil
+}
+
+// rollback discards whatever the failed append has written. It must be called with the lock held.
+func (s *FileStore) rollback() {
+	_ = s.file.Truncate(s.size)
+	_, _ = s.file.Seek(s.size, io.SeekStart)
+}
+
+// Query reads the events appended so far. Appends made while it runs are not returned.
+func (s *FileStore) Query(ctx context.Context, query EventQuery) ([]*StoredEvent, error) {
+	s.mu.RLock()
+	size := s.size
+	s.mu.RUnlock()
+
+	var result []*StoredEvent
+
+	_, err := s.scan(size, func(event *StoredEvent) bool {
+		if !query.Matches(event) {
+			return ctx.Err() == nil
+		}
+
+		result = append(result, event)
+
+		return query.Limit <= 0 || len(result) < query.Limit
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *FileStore) Close() error {
+	return s.file.Close()
+}
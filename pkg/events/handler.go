@@ -2,9 +2,62 @@ package events
 
 import (
 	"context"
+	"fmt"
 )
 
 // EventHandler is an interface that should be implemented by event handlers.
 type EventHandler interface {
 	Handle(ctx context.Context, event Event) error
 }
+
+// NamedHandler can be implemented by handlers to give them a stable name.
+// Names identify handlers when tracking per-handler delivery state.
+type NamedHandler interface {
+	HandlerName() string
+}
+
+// ReplayableHandler can be implemented by handlers that must not receive replayed events,
+// e.g. because they record the events or pass them outside the application.
+type ReplayableHandler interface {
+	Replayable() bool
+}
+
+// IsReplayable reports whether stored events can be replayed to the handler.
+func IsReplayable(handler EventHandler) bool {
+	if replayable, ok := handler.(ReplayableHandler); ok {
+		return replayable.Replayable()
+	}
+
+	return true
+}
+
+// HandlerName returns the name of a handler: its own name if it implements NamedHandler,
+// otherwise the name of its type.
+func HandlerName(handler EventHandler) string {
+	if named, ok := handler.(NamedHandler); ok {
+		return named.HandlerName()
+	}
+
+	return fmt.Sprintf("%T", handler)
+}
+
+// handlerNames returns unique names for handlers registered for the same event,
+// disambiguating handlers of the same type by their registration order.
+func handlerNames(handlers []EventHandler) []string {
+	names := make([]string, len(handlers))
+	seen := make(map[string]int, len(handlers))
+
+	for i, handler := range handlers {
+		name := HandlerName(handler)
+
+		if n := seen[name]; n > 0 {
+			names[i] = fmt.Sprintf("%s#%d", name, n)
+		} else {
+			names[i] = name
+		}
+
+		seen[name]++
+	}
+
+	return names
+}
@@ -0,0 +1,36 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxMessage is an event recorded together with the state change that produced it.
+type OutboxMessage struct {
+	ID         uuid.UUID
+	EventType  string
+	Payload    []byte
+	OccurredAt time.Time
+	// Attempts is the number of relay runs that failed to deliver the message to every handler.
+	Attempts int
+	// Delivered holds the names of handlers that have already processed the message.
+	Delivered map[string]struct{}
+}
+
+// Outbox records events inside a unit of work.
+type Outbox interface {
+	Record(ctx context.Context, event Event, occurredAt time.Time) error
+}
+
+// OutboxStore gives the relay access to recorded messages and their delivery state.
+type OutboxStore interface {
+	// PendingMessages returns up to limit messages not yet delivered to every handler, oldest first.
+	PendingMessages(ctx context.Context, limit int) ([]*OutboxMessage, error)
+	MarkDelivered(ctx context.Context, messageID uuid.UUID, handler string) error
+	// RecordAttempt increments the number of failed delivery attempts of the message.
+	RecordAttempt(ctx context.Context, messageID uuid.UUID) error
+	// MarkProcessed excludes the message from PendingMessages.
+	MarkProcessed(ctx context.Context, messageID uuid.UUID) error
+}
@@ -0,0 +1,55 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Registry knows how to restore events from their serialized form.
+type Registry struct {
+	factories map[string]func() Event
+	mu        sync.RWMutex
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: make(map[string]func() Event),
+	}
+}
+
+// Register makes events with the given name decodable; factory must return a pointer to a zero event.
+func (r *Registry) Register(eventType string, factory func() Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.factories[eventType] = factory
+}
+
+// Encode serializes an event to JSON.
+func Encode(event Event) ([]byte, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("encoding event %s: %w", event.Name(), err)
+	}
+
+	return payload, nil
+}
+
+// Decode restores an event of the given type from its JSON payload.
+func (r *Registry) Decode(eventType string, payload []byte) (Event, error) {
+	r.mu.RLock()
+	factory, ok := r.factories[eventType]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown event type %s", eventType)
+	}
+
+	event := factory()
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, fmt.Errorf("decoding event %s: %w", eventType, err)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,181 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const defaultRelayBatchSize = 100
+
+// HandlerSource exposes the handlers registered for an event type.
+type HandlerSource interface {
+	Handlers(eventType string) []EventHandler
+}
+
+// OutboxRelay delivers outbox messages to event handlers with at-least-once semantics.
+// A message is delivered to every handler separately and retried on later runs
+// until each of them succeeds. After maxAttempts failed runs the message is moved to the
+// dead-letter store for the handlers that still fail; messages that cannot be decoded
+// are moved there right away.
+type OutboxRelay struct {
+	store       OutboxStore
+	handlers    HandlerSource
+	registry    *Registry
+	deadLetters DeadLetterStore
+	interval    time.Duration
+	maxAttempts int
+	batchSize   int
+}
+
+func NewOutboxRelay(
+	store OutboxStore,
+	handlers HandlerSource,
+	registry *Registry,
+	deadLetters DeadLetterStore,
+	interval time.Duration,
+	maxAttempts int,
+) *OutboxRelay {
+	return &OutboxRelay{
+		store:       store,
+		handlers:    handlers,
+		registry:    registry,
+		deadLetters: deadLetters,
+		interval:    interval,
+		maxAttempts: max(maxAttempts, 1),
+		batchSize:   defaultRelayBatchSize,
+	}
+}
+
+// Run relays pending messages on every tick until ctx is cancelled.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := r.RelayPending(ctx); err != nil {
+				log.Printf("Outbox relay failed: %v", err)
+			}
+		}
+	}
+}
+
+// RelayPending delivers one batch of pending messages and returns how many were fully processed.
+func (r *OutboxRelay) RelayPending(ctx context.Context) (int, error) {
+	messages, err := r.store.PendingMessages(ctx, r.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("getting pending outbox messages: %w", err)
+	}
+
+	processed := 0
+
+	for _, message := range messages {
+		done, err := r.relay(ctx, message)
+		if err != nil {
+			return processed, err
+		}
+
+		if done {
+			processed++
+		}
+	}
+
+	return processed, nil
+}
+
+func (r *OutboxRelay) relay(ctx context.Context, message *OutboxMessage) (bool, error) {
+	handlers := r.handlers.Handlers(message.EventType)
+	names := handlerNames(handlers)
+
+	event, err := r.registry.Decode(message.EventType, message.Payload)
+	if err != nil {
+		// Decoding never succeeds on a later run, so the message is not retried
+		log.Printf("Outbox message %s of type %s cannot be decoded: %v", message.ID, message.EventType, err)
+
+		failures := make(map[string]error, len(names))
+		for _, name := range names {
+			if _, delivered := message.Delivered[name]; !delivered {
+				failures[name] = err
+			}
+		}
+
+		if err := r.giveUp(ctx, message, failures); err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	failures := make(map[string]error)
+
+	for i, handler := range handlers {
+		if _, delivered := message.Delivered[names[i]]; delivered {
+			continue
+		}
+
+		if err := handler.Handle(ctx, event); err != nil {
+			log.Printf("Handler %s failed on %s event %s: %v", names[i], message.EventType, message.ID, err)
+
+			failures[names[i]] = err
+
+			continue
+		}
+
+		if err := r.store.MarkDelivered(ctx, message.ID, names[i]); err != nil {
+			return false, fmt.Errorf("marking outbox message %s delivered: %w", message.ID, err)
+		}
+	}
+
+	// Deliveries interrupted by the relay being stopped do not count as attempts
+	if len(failures) > 0 && ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	if len(failures) > 0 && message.Attempts+1 < r.maxAttempts {
+		if err := r.store.RecordAttempt(ctx, message.ID); err != nil {
+			return false, fmt.Errorf("recording attempt of outbox message %s: %w", message.ID, err)
+		}
+
+		return false, nil
+	}
+
+	if err := r.giveUp(ctx, message, failures); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// giveUp moves the message to the dead-letter store for every failed handler and marks it processed.
+func (r *OutboxRelay) giveUp(ctx context.Context, message *OutboxMessage, failures map[string]error) error {
+	for handler, cause := range failures {
+		letter := &DeadLetter{
+			ID:        uuid.New(),
+			EventType: message.EventType,
+			Handler:   handler,
+			Payload:   message.Payload,
+			Error:     cause.Error(),
+			Attempts:  message.Attempts + 1,
+			FailedAt:  time.Now(),
+		}
+
+		if err := r.deadLetters.AddDeadLetter(ctx, letter); err != nil {
+			return fmt.Errorf("dead-lettering outbox message %s for handler %s: %w", message.ID, handler, err)
+		}
+
+		log.Printf("Outbox relay gave up on %s event %s for handler %s: %v", message.EventType, message.ID, handler, cause)
+	}
+
+	if err := r.store.MarkProcessed(ctx, message.ID); err != nil {
+		return fmt.Errorf("marking outbox message %s processed: %w", message.ID, err)
+	}
+
+	return nil
+}
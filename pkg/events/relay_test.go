@@ -0,0 +1,186 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maklybae/ddd-zoo/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryOutbox keeps outbox messages in a slice.
+type memoryOutbox struct {
+	mu        sync.Mutex
+	messages  []*events.OutboxMessage
+	processed map[uuid.UUID]bool
+}
+
+func (o *memoryOutbox) PendingMessages(ctx context.Context, limit int) ([]*events.OutboxMessage, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var pending []*events.OutboxMessage
+
+	for _, message := range o.messages {
+		if !o.processed[message.ID] && len(pending) < limit {
+			pending = append(pending, message)
+		}
+	}
+
+	return pending, nil
+}
+
+func (o *memoryOutbox) MarkDelivered(ctx context.Context, messageID uuid.UUID, handler string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, message := range o.messages {
+		if message.ID == messageID {
+			message.Delivered[handler] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (o *memoryOutbox) RecordAttempt(ctx context.Context, messageID uuid.UUID) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, message := range o.messages {
+		if message.ID == messageID {
+			message.Attempts++
+		}
+	}
+
+	return nil
+}
+
+func (o *memoryOutbox) MarkProcessed(ctx context.Context, messageID uuid.UUID) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.processed[messageID] = true
+
+	return nil
+}
+
+// memoryDeadLetters keeps dead letters in a slice.
+type memoryDeadLetters struct {
+	mu      sync.Mutex
+	letters []*events.DeadLetter
+}
+
+func (s *memoryDeadLetters) AddDeadLetter(ctx context.Context, letter *events.DeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.letters = append(s.letters, letter)
+
+	return nil
+}
+
+func (s *memoryDeadLetters) GetDeadLetter(ctx context.Context, id uuid.UUID) (*events.DeadLetter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, letter := range s.letters {
+		if letter.ID == id {
+			return letter, nil
+		}
+	}
+
+	return nil, events.ErrDeadLetterNotFound
+}
+
+func (s *memoryDeadLetters) GetAllDeadLetters(ctx context.Context) ([]*events.DeadLetter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*events.DeadLetter(nil), s.letters...), nil
+}
+
+func (s *memoryDeadLetters) DeleteDeadLetter(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+// failingHandler fails every event.
+type failingHandler struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (h *failingHandler) Handle(ctx context.Context, event events.Event) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.calls++
+
+	return errors.New("projection is down")
+}
+
+func (h *failingHandler) HandlerName() string {
+	return "failing"
+}
+
+func TestRelayDeadLettersEventsOfAlwaysFailingAsyncHandler(t *testing.T) {
+	ctx := context.Background()
+
+	registry := events.NewRegistry()
+	registry.Register("test.event", func() events.Event { return &testEvent{} })
+
+	deadLetters := &memoryDeadLetters{}
+	dispatcher := events.NewAsyncDispatcher(deadLetters, registry, events.HandlerOptions{
+		Workers:   1,
+		QueueSize: 10,
+		RetryPolicy: events.RetryPolicy{
+			MaxAttempts:    5,
+			InitialBackoff: time.Millisecond,
+			Multiplier:     1,
+		},
+	})
+	defer dispatcher.Shutdown(ctx)
+
+	handler := &failingHandler{}
+	dispatcher.RegisterHandler("test.event", handler)
+
+	outbox := &memoryOutbox{
+		messages: []*events.OutboxMessage{{
+			ID:         uuid.New(),
+			EventType:  "test.event",
+			Payload:    []byte(`{"n":1}`),
+			OccurredAt: time.Now(),
+			Delivered:  make(map[string]struct{}),
+		}},
+		processed: make(map[uuid.UUID]bool),
+	}
+
+	const maxAttempts = 3
+
+	relay := events.NewOutboxRelay(outbox, dispatcher, registry, deadLetters, time.Second, maxAttempts)
+
+	for run := 1; run <= maxAttempts; run++ {
+		processed, err := relay.RelayPending(ctx)
+		require.NoError(t, err)
+
+		// Every run is a single attempt, the message is given up on after the last one
+		assert.Equal(t, run, handler.calls)
+		assert.Equal(t, run == maxAttempts, processed == 1)
+	}
+
+	letters, err := deadLetters.GetAllDeadLetters(ctx)
+	require.NoError(t, err)
+	require.Len(t, letters, 1)
+	assert.Equal(t, "failing", letters[0].Handler)
+	assert.Equal(t, maxAttempts, letters[0].Attempts)
+
+	processed, err := relay.RelayPending(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, processed)
+	assert.Equal(t, maxAttempts, handler.calls)
+}
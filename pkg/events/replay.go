@@ -0,0 +1,80 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrHandlerNotFound      = errors.New("handler not found")
+	ErrHandlerNotReplayable = errors.New("handler does not accept replayed events")
+)
+
+// Replayer re-feeds stored events to a single handler, e.g. to rebuild a projection.
+type Replayer struct {
+	store    Store
+	registry *Registry
+	handlers HandlerSource
+}
+
+func NewReplayer(store Store, registry *Registry, handlers HandlerSource) *Replayer {
+	return &Replayer{
+		store:    store,
+		registry: registry,
+		handlers: handlers,
+	}
+}
+
+// Replay passes every event matching query to the handler with the given name, in sequence order,
+// skipping events the handler is not registered for. It returns the number of replayed events,
+// or ErrHandlerNotFound if matching events exist but none of them has such a handler.
+// Handlers that are not replayable are refused with ErrHandlerNotReplayable.
+func (r *Replayer) Replay(ctx context.Context, handlerName string, query EventQuery) (int, error) {
+	stored, err := r.store.Query(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("querying events to replay: %w", err)
+	}
+
+	replayed := 0
+
+	for _, storedEvent := range stored {
+		handler := r.findHandler(storedEvent.EventType, handlerName)
+		if handler == nil {
+			continue
+		}
+
+		if !IsReplayable(handler) {
+			return 0, fmt.Errorf("%w: %s", ErrHandlerNotReplayable, handlerName)
+		}
+
+		event, err := r.registry.Decode(storedEvent.EventType, storedEvent.Payload)
+		if err != nil {
+			return replayed, fmt.Errorf("replaying event %d: %w", storedEvent.Sequence, err)
+		}
+
+		if err := handler.Handle(ctx, event); err != nil {
+			return replayed, fmt.Errorf("replaying event %d to %s: %w", storedEvent.Sequence, handlerName, err)
+		}
+
+		replayed++
+	}
+
+	if replayed == 0 && len(stored) > 0 {
+		return 0, fmt.Errorf("%w: %s", ErrHandlerNotFound, handlerName)
+	}
+
+	return replayed, nil
+}
+
+func (r *Replayer) findHandler(eventType, name string) EventHandler {
+	handlers := r.handlers.Handlers(eventType)
+
+	for i, handlerName := range handlerNames(handlers) {
+		if handlerName == name {
+			return handlers[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package events_test
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/maklybae/ddd-zoo/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type projection struct {
+	mu   sync.Mutex
+	seen []int
+}
+
+func (p *projection) Handle(ctx context.Context, event events.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.seen = append(p.seen, event.(*testEvent).N)
+
+	return nil
+}
+
+func (p *projection) HandlerName() string {
+	return "projection"
+}
+
+func TestReplayDoesNotReachLiveStreams(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := events.OpenFileStore(filepath.Join(t.TempDir(), "events.jsonl"))
+	require.NoError(t, err)
+	defer store.Close()
+
+	registry := events.NewRegistry()
+	registry.Register("test.event", func() events.Event { return &testEvent{} })
+
+	broadcaster := events.NewBroadcaster(store, 10)
+	target := &projection{}
+
+	dispatcher := events.NewEventDispatcher()
+	dispatcher.RegisterHandler(events.AllEvents, events.NewRecorder(store, broadcaster))
+	dispatcher.RegisterHandler("test.event", target)
+
+	for n := 1; n <= 2; n++ {
+		dispatcher.Dispatch(ctx, &testEvent{N: n})
+	}
+
+	subscription, err := broadcaster.Subscribe(ctx, 0, nil)
+	require.NoError(t, err)
+	defer subscription.Close()
+
+	replayer := events.NewReplayer(store, registry, dispatcher)
+
+	replayed, err := replayer.Replay(ctx, "projection", events.EventQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, replayed)
+	assert.Equal(t, []int{1, 2, 1, 2}, target.seen)
+
+	_, err = replayer.Replay(ctx, "events.Recorder", events.EventQuery{})
+	require.ErrorIs(t, err, events.ErrHandlerNotReplayable)
+
+	// Neither the replay nor the refused one have been recorded or streamed
+	stored, err := store.Query(ctx, events.EventQuery{})
+	require.NoError(t, err)
+	assert.Len(t, stored, 2)
+	assert.Empty(t, subscription.Events())
+}
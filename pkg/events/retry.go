@@ -0,0 +1,39 @@
+package events
+
+import (
+	"math"
+	"time"
+)
+
+// RetryPolicy describes how often a failing handler is retried and how long to wait between attempts.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Multiplier grows the backoff after every failed attempt.
+	Multiplier float64
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+	}
+}
+
+// Backoff returns the delay before the next attempt after the given number of failed attempts.
+func (p RetryPolicy) Backoff(failedAttempts int) time.Duration {
+	if failedAttempts < 1 {
+		return 0
+	}
+
+	backoff := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(failedAttempts-1))
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+
+	return time.Duration(backoff)
+}
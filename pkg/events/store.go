@@ -0,0 +1,111 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// AggregateEvent can be implemented by events to name the aggregates they concern,
+// which makes them searchable by aggregate in a Store.
+type AggregateEvent interface {
+	AggregateIDs() []string
+}
+
+// TimedEvent can be implemented by events to tell when they occurred. Stores record
+// such events at that time rather than at the time they are handled.
+type TimedEvent interface {
+	OccurredAt() time.Time
+}
+
+// StoredEvent is an event appended to a Store.
+type StoredEvent struct {
+	Sequence     uint64          `json:"sequence"`
+	EventType    string          `json:"type"`
+	AggregateIDs []string        `json:"aggregateIds,omitempty"`
+	Payload      json.RawMessage `json:"payload"`
+	RecordedAt   time.Time       `json:"recordedAt"`
+}
+
+// EventQuery selects stored events. Zero fields do not restrict the result.
+type EventQuery struct {
+	EventTypes    []string
+	AggregateID   string
+	From          time.Time // inclusive
+	To            time.Time // exclusive
+	AfterSequence uint64
+	Limit         int
+}
+
+// Matches reports whether the stored event satisfies every filter of the query except Limit.
+func (q EventQuery) Matches(event *StoredEvent) bool {
+	if event.Sequence <= q.AfterSequence {
+		return false
+	}
+
+	if len(q.EventTypes) > 0 && !slices.Contains(q.EventTypes, event.EventType) {
+		return false
+	}
+
+	if q.AggregateID != "" && !slices.Contains(event.AggregateIDs, q.AggregateID) {
+		return false
+	}
+
+	if !q.From.IsZero() && event.RecordedAt.Before(q.From) {
+		return false
+	}
+
+	if !q.To.IsZero() && !event.RecordedAt.Before(q.To) {
+		return false
+	}
+
+	return true
+}
+
+// Store is an append-only log of events. Sequence numbers are assigned by the store and grow monotonically.
+type Store interface {
+	Append(ctx context.Context, event Event, recordedAt time.Time) (*StoredEvent, error)
+	// Query returns matching events ordered by sequence number.
+	Query(ctx context.Context, query EventQuery) ([]*StoredEvent, error)
+}
+
+// Recorder is a handler that appends every event it receives to a Store and, if it has
+// a Broadcaster, publishes the appended events to it. Register it for AllEvents to keep
+// a complete history. Replaying the history into the Recorder would append it again,
+// so it does not take part in replays.
+type Recorder struct {
+	store       Store
+	broadcaster *Broadcaster
+}
+
+// NewRecorder creates a recorder; broadcaster may be nil.
+func NewRecorder(store Store, broadcaster *Broadcaster) *Recorder {
+	return &Recorder{store: store, broadcaster: broadcaster}
+}
+
+func (r *Recorder) Handle(ctx context.Context, event Event) error {
+	recordedAt := time.Now()
+	if timed, ok := event.(TimedEvent); ok && !timed.OccurredAt().IsZero() {
+		recordedAt = timed.OccurredAt()
+	}
+
+	stored, err := r.store.Append(ctx, event, recordedAt)
+	if err != nil {
+		return err
+	}
+
+	if r.broadcaster != nil {
+		r.broadcaster.Publish(stored)
+	}
+
+	return nil
+}
+
+func (r *Recorder) HandlerName() string {
+	return "events.Recorder"
+}
+
+func (r *Recorder) Replayable() bool {
+	return false
+}